@@ -11,7 +11,11 @@ type Activity struct {
 	BlockHeight                uint64
 	Type                       ActivityType
 	MaybeTxID                  *string
+	MaybeTxStatus              *TransactionStatus
+	MaybeTxLog                 *string
+	MaybeTxCodespace           *string
 	MaybeEventPosition         *uint32
+	MaybeEventPhase            *EventPhase
 	MaybeFee                   *big.Int
 	MaybeTxInputs              []TxInput
 	MaybeOutputCount           *uint32
@@ -53,6 +57,15 @@ const (
 	TRANSACTION_UNJAIL
 )
 
+// TransactionStatus is the execution result of a transaction. A failed
+// transaction is included in the block but has no effect on the chain state
+type TransactionStatus = uint8
+
+const (
+	TRANSACTION_STATUS_SUCCESS TransactionStatus = iota
+	TRANSACTION_STATUS_FAILED
+)
+
 type EventType = int8
 
 const (
@@ -61,6 +74,15 @@ const (
 	EVENT_JAIL
 )
 
+// EventPhase is the stage of block execution emitting an event. Each phase
+// has its own event position space
+type EventPhase = uint8
+
+const (
+	EVENT_PHASE_BEGIN_BLOCK EventPhase = iota
+	EVENT_PHASE_END_BLOCK
+)
+
 type PunishmentKind = uint8
 
 const (
@@ -68,6 +90,12 @@ const (
 	PUNISHMENT_KIND_BYZANTINE_FAULT
 )
 
+// IsFailedTransaction returns true when the activity is a transaction failed
+// to be executed
+func (activity *Activity) IsFailedTransaction() bool {
+	return activity.MaybeTxStatus != nil && *activity.MaybeTxStatus == TRANSACTION_STATUS_FAILED
+}
+
 func (activity *Activity) String() string {
 	return render.Render(activity)
 }
@@ -190,6 +190,88 @@ func IsValidEventType(eventType string) bool {
 	return false
 }
 
+func IsValidTransactionStatus(transactionStatus string) bool {
+	switch transactionStatus {
+	case "success":
+		fallthrough
+	case "failed":
+		return true
+	}
+	return false
+}
+
+func OptTransactionStatusToString(transactionStatus *chainindex.TransactionStatus) *string {
+	if transactionStatus == nil {
+		return nil
+	}
+	str := TransactionStatusToString(*transactionStatus)
+
+	return &str
+}
+
+func TransactionStatusToString(transactionStatus chainindex.TransactionStatus) string {
+	switch transactionStatus {
+	case chainindex.TRANSACTION_STATUS_SUCCESS:
+		return "success"
+	case chainindex.TRANSACTION_STATUS_FAILED:
+		return "failed"
+	default:
+		panic("unsupported transaction status")
+	}
+}
+
+func TransactionStatusFromString(transactionStatus string) chainindex.TransactionStatus {
+	switch transactionStatus {
+	case "success":
+		return chainindex.TRANSACTION_STATUS_SUCCESS
+	case "failed":
+		return chainindex.TRANSACTION_STATUS_FAILED
+	default:
+		panic("unsupported transaction status")
+	}
+}
+
+func IsValidEventPhase(eventPhase string) bool {
+	switch eventPhase {
+	case "begin_block":
+		fallthrough
+	case "end_block":
+		return true
+	}
+	return false
+}
+
+func OptEventPhaseToString(eventPhase *chainindex.EventPhase) *string {
+	if eventPhase == nil {
+		return nil
+	}
+	str := EventPhaseToString(*eventPhase)
+
+	return &str
+}
+
+func EventPhaseToString(eventPhase chainindex.EventPhase) string {
+	switch eventPhase {
+	case chainindex.EVENT_PHASE_BEGIN_BLOCK:
+		return "begin_block"
+	case chainindex.EVENT_PHASE_END_BLOCK:
+		return "end_block"
+	default:
+		panic("unsupported event phase")
+	}
+}
+
+func EventPhaseFromString(eventPhase string) chainindex.EventPhase {
+	switch eventPhase {
+	case "begin_block":
+		return chainindex.EVENT_PHASE_BEGIN_BLOCK
+	case "end_block":
+		return chainindex.EVENT_PHASE_END_BLOCK
+	default:
+		panic("unsupported event phase")
+	}
+}
+
 func OptPunishmentKindToString(punishmentKind *chainindex.PunishmentKind) *string {
 	if punishmentKind == nil {
 		return nil
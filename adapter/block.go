@@ -1,6 +1,12 @@
 package adapter
 
-import "github.com/luci/go-render/render"
+import (
+	"time"
+
+	"github.com/luci/go-render/render"
+
+	"github.com/crypto-com/chainindex"
+)
 
 type RDbBlockCommittedCouncilNodeRow struct {
 	BlockHeight        uint64 `json:"-"`
@@ -14,3 +20,27 @@ type RDbBlockCommittedCouncilNodeRow struct {
 func (row *RDbBlockCommittedCouncilNodeRow) String() string {
 	return render.Render(row)
 }
+
+// RDbBlockEvidenceVoteRow is the JSON representation of a conflicting vote of
+// an evidence
+type RDbBlockEvidenceVoteRow struct {
+	Type           uint8     `json:"type"`
+	Height         uint64    `json:"height"`
+	Round          uint64    `json:"round"`
+	BlockHash      string    `json:"block_hash"`
+	Timestamp      time.Time `json:"timestamp"`
+	ValidatorIndex uint64    `json:"validator_index"`
+	Signature      string    `json:"signature"`
+}
+
+func BlockEvidenceVoteToRDbBlockEvidenceVoteRow(vote *chainindex.BlockEvidenceVote) *RDbBlockEvidenceVoteRow {
+	return &RDbBlockEvidenceVoteRow{
+		Type:           vote.Type,
+		Height:         vote.Height,
+		Round:          vote.Round,
+		BlockHash:      vote.BlockHash,
+		Timestamp:      vote.Timestamp,
+		ValidatorIndex: vote.ValidatorIndex,
+		Signature:      vote.Signature,
+	}
+}
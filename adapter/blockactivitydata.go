@@ -19,6 +19,7 @@ type RDbBlockActivityDataRepo interface {
 	InsertWithdrawTransaction(tx RDbTx, activity *chainindex.Activity) error
 	InsertNodeJoinTransaction(tx RDbTx, activity *chainindex.Activity) error
 	InsertUnjailTransaction(tx RDbTx, activity *chainindex.Activity) error
+	InsertFailedTransaction(tx RDbTx, activity *chainindex.Activity) error
 	InsertRewardEvent(tx RDbTx, activity *chainindex.Activity) error
 	InsertSlashEvent(tx RDbTx, activity *chainindex.Activity) error
 	InsertJailEvent(tx RDbTx, activity *chainindex.Activity) error
@@ -142,27 +143,35 @@ func (repo *DefaultRDbBlockActivityDataRepo) updateUsedPrevTxOutputs(tx RDbTx, a
 	return nil
 }
 
+// Insert all transaction outputs with a multi-row insert
 func (repo *DefaultRDbBlockActivityDataRepo) insertTransactionOutputs(tx RDbTx, activity *chainindex.Activity) error {
 	var err error
 
-	sql, _, err := repo.stmtBuilder.Insert(
+	outputCount := *activity.MaybeOutputCount
+	if outputCount == 0 {
+		return nil
+	}
+
+	stmtBuilder := repo.stmtBuilder.Insert(
 		"transaction_outputs",
 	).Columns(
 		"txid",
 		"index",
-	).Values("?", "?").ToSql()
+	)
+	for i := uint32(0); i < outputCount; i += 1 {
+		stmtBuilder = stmtBuilder.Values(activity.MaybeTxID, i)
+	}
+	sql, sqlArgs, err := stmtBuilder.ToSql()
 	if err != nil {
 		return fmt.Errorf("error building transaction outputs insert SQL: %v: %w", err, ErrBuildSQLStmt)
 	}
 
-	for i, l := uint32(0), *activity.MaybeOutputCount; i < l; i += 1 {
-		result, err := tx.Exec(sql, activity.MaybeTxID, i)
-		if err != nil {
-			return fmt.Errorf("error inserting transaction output into table: %v: %w", err, ErrRepoWrite)
-		}
-		if result.RowsAffected() != 1 {
-			return fmt.Errorf("error insertion transaction output into table: no row updated: %w", ErrRepoWrite)
-		}
+	result, err := tx.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting transaction output into table: %v: %w", err, ErrRepoWrite)
+	}
+	if result.RowsAffected() != int64(outputCount) {
+		return fmt.Errorf("error insertion transaction output into table: mismatched number of rows inserted: %w", ErrRepoWrite)
 	}
 
 	return nil
@@ -263,7 +272,7 @@ func (repo *DefaultRDbBlockActivityDataRepo) InsertUnbondTransaction(tx RDbTx, a
 		return err
 	}
 	if accountRow == nil {
-		panic("staking account of unbond activity does not exist")
+		return newStakingAccountNotExistError("unbond", activity)
 	}
 
 	accountRow.IncrementNonce()
@@ -297,7 +306,7 @@ func (repo *DefaultRDbBlockActivityDataRepo) InsertWithdrawTransaction(tx RDbTx,
 		return err
 	}
 	if accountRow == nil {
-		panic("staking account of withdraw activity does not exist")
+		return newStakingAccountNotExistError("withdraw", activity)
 	}
 
 	accountRow.IncrementNonce()
@@ -332,7 +341,7 @@ func (repo *DefaultRDbBlockActivityDataRepo) InsertNodeJoinTransaction(tx RDbTx,
 		return err
 	}
 	if accountRow == nil {
-		panic("staking account of node join activity does not exist")
+		return newStakingAccountNotExistError("node join", activity)
 	}
 
 	lastAddressCouncilNode, err := repo.findLastCouncilNodeByAddress(tx, activity.MaybeCouncilNodeMeta.Address)
@@ -553,7 +562,7 @@ func (repo *DefaultRDbBlockActivityDataRepo) InsertUnjailTransaction(tx RDbTx, a
 		return err
 	}
 	if accountRow == nil {
-		panic("staking account of unjail activity does not exist")
+		return newStakingAccountNotExistError("unjail", activity)
 	}
 
 	accountRow.IncrementNonce()
@@ -577,6 +586,12 @@ func (repo *DefaultRDbBlockActivityDataRepo) InsertUnjailTransaction(tx RDbTx, a
 	return nil
 }
 
+// InsertFailedTransaction records the failed transaction without applying any
+// of its effects on transaction outputs, staking accounts and council nodes
+func (repo *DefaultRDbBlockActivityDataRepo) InsertFailedTransaction(tx RDbTx, activity *chainindex.Activity) error {
+	return repo.insertActivity(tx, activity)
+}
+
 func (repo *DefaultRDbBlockActivityDataRepo) InsertRewardEvent(tx RDbTx, activity *chainindex.Activity) error {
 	var err error
 
@@ -587,7 +602,7 @@ func (repo *DefaultRDbBlockActivityDataRepo) InsertRewardEvent(tx RDbTx, activit
 		return err
 	}
 	if accountRow == nil {
-		panic("staking account of unjail activity does not exist")
+		return newStakingAccountNotExistError("reward", activity)
 	}
 
 	accountRow.AddBonded(activity.MaybeBonded)
@@ -617,7 +632,7 @@ func (repo *DefaultRDbBlockActivityDataRepo) InsertSlashEvent(tx RDbTx, activity
 		return err
 	}
 	if accountRow == nil {
-		panic("staking account of unjail activity does not exist")
+		return newStakingAccountNotExistError("slash", activity)
 	}
 
 	accountRow.AddBonded(activity.MaybeBonded)
@@ -648,7 +663,7 @@ func (repo *DefaultRDbBlockActivityDataRepo) InsertJailEvent(tx RDbTx, activity
 		return err
 	}
 	if accountRow == nil {
-		panic("staking account of unjail activity does not exist")
+		return newStakingAccountNotExistError("jail", activity)
 	}
 
 	accountRow.JailedUntil = activity.MaybeJailedUntil
@@ -712,6 +727,18 @@ func (repo *DefaultRDbBlockActivityDataRepo) findCurrentCouncilNodeByStakingAcco
 	return &councilNode, nil
 }
 
+// newStakingAccountNotExistError returns the error of an activity updating a
+// staking account which has never been created by the preceding blocks
+func newStakingAccountNotExistError(activityName string, activity *chainindex.Activity) error {
+	address := "<nil>"
+	if activity.MaybeStakingAccountAddress != nil {
+		address = *activity.MaybeStakingAccountAddress
+	}
+	return fmt.Errorf(
+		"error inserting %s activity: staking account %s does not exist: %w", activityName, address, ErrInconsistentBlockData,
+	)
+}
+
 func (repo *DefaultRDbBlockActivityDataRepo) findStakingAccount(tx RDbTx, address *string) (*RDbStakingAccountRow, error) {
 	var err error
 
@@ -800,7 +827,11 @@ func (repo *DefaultRDbBlockActivityDataRepo) insertActivity(tx RDbTx, activity *
 		"block_height",
 		"type",
 		"txid",
+		"tx_status",
+		"tx_log",
+		"tx_codespace",
 		"event_position",
+		"event_phase",
 		"fee",
 		"inputs",
 		"output_count",
@@ -815,7 +846,9 @@ func (repo *DefaultRDbBlockActivityDataRepo) insertActivity(tx RDbTx, activity *
 		"affected_council_node_id",
 		"jailed_until",
 		"punishment_kind",
-	).Values("?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?").ToSql()
+	).Values(
+		"?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?",
+	).ToSql()
 	if err != nil {
 		return fmt.Errorf("error building activity insertion SQL: %v: %w", err, ErrBuildSQLStmt)
 	}
@@ -858,7 +891,11 @@ func (repo *DefaultRDbBlockActivityDataRepo) insertActivity(tx RDbTx, activity *
 		activity.BlockHeight,
 		ActivityTypeToString(activity.Type),
 		activity.MaybeTxID,
+		OptTransactionStatusToString(activity.MaybeTxStatus),
+		activity.MaybeTxLog,
+		activity.MaybeTxCodespace,
 		activity.MaybeEventPosition,
+		OptEventPhaseToString(activity.MaybeEventPhase),
 		bignum.OptItoa(activity.MaybeFee),
 		transferInputsJSON,
 		activity.MaybeOutputCount,
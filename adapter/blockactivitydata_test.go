@@ -2,6 +2,7 @@ package adapter_test
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -20,9 +21,9 @@ import (
 )
 
 const (
-	SQL_ACTIVITY_INSERT                                     = "INSERT INTO activities (block_height,type,txid,event_position,fee,inputs,output_count,staking_account_address,staking_account_nonce,bonded,unbonded,unbonded_from,joined_council_node,joined_council_node_id,affected_council_node,affected_council_node_id,jailed_until,punishment_kind) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)"
+	SQL_ACTIVITY_INSERT                                     = "INSERT INTO activities (block_height,type,txid,tx_status,tx_log,tx_codespace,event_position,event_phase,fee,inputs,output_count,staking_account_address,staking_account_nonce,bonded,unbonded,unbonded_from,joined_council_node,joined_council_node_id,affected_council_node,affected_council_node_id,jailed_until,punishment_kind) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)"
 	SQL_TRANSFER_OUTPUT_UPDATE                              = "UPDATE transaction_outputs SET spent_at_txid = ? WHERE txid = ? AND index = ?"
-	SQL_TRANSFER_OUTPUT_INSERT                              = "INSERT INTO transaction_outputs (txid,index) VALUES (?,?)"
+	SQL_TRANSFER_OUTPUT_INSERT                              = "INSERT INTO transaction_outputs (txid,index) VALUES "
 	SQL_STAKING_ACCOUNT_INSERT                              = "INSERT INTO staking_accounts (address,nonce,bonded,unbonded,unbonded_from,jailed_until,punishment_kind,current_council_node_id) VALUES (?,?,?,?,?,?,?,?)"
 	SQL_STAKING_ACCOUNT_SELECT                              = "SELECT address,nonce,bonded,unbonded,unbonded_from,punishment_kind,jailed_until,current_council_node_id FROM staking_accounts WHERE address = ?"
 	SQL_STAKING_ACCOUNT_UPDATE                              = "UPDATE staking_accounts SET bonded = ?, current_council_node_id = ?, jailed_until = ?, nonce = ?, punishment_kind = ?, unbonded = ?, unbonded_from = ? WHERE address = ?"
@@ -188,7 +189,11 @@ var _ = Describe("BlockActivityData", func() {
 				anyGenesisActivity.BlockHeight, // block_height
 				"genesis",                      // type
 				(*string)(nil),                 // txid
+				(*string)(nil),                 // tx_status
+				(*string)(nil),                 // tx_log
+				(*string)(nil),                 // tx_codespace
 				(*uint32)(nil),                 // event_position
+				(*string)(nil),                 // event_phase
 				(*string)(nil),                 // fee
 				(*string)(nil),                 // inputs
 				(*uint32)(nil),                 // output_count
@@ -244,14 +249,20 @@ var _ = Describe("BlockActivityData", func() {
 				execResult := new(MockRDbExecResult)
 				execResult.On("RowsAffected").Return(int64(1))
 				OnTxUpdateAnyTransaferInput(tx).Return(execResult, nil)
-				OnTxInsertAnyTransaferOutput(tx).Return(execResult, nil)
+				outputsExecResult := new(MockRDbExecResult)
+				outputsExecResult.On("RowsAffected").Return(int64(3))
+				OnTxInsertAnyTransaferOutputs(tx, 3).Return(outputsExecResult, nil)
 
 				tx.On("Exec",
 					SQL_ACTIVITY_INSERT,
-					anyTransferActivity.BlockHeight,              // block_height
-					"transfer",                                   // type
-					anyTransferActivity.MaybeTxID,                // txid
-					(*uint32)(nil),                               // event_position
+					anyTransferActivity.BlockHeight, // block_height
+					"transfer",                      // type
+					anyTransferActivity.MaybeTxID,   // txid
+					primptr.String("success"),       // tx_status
+					(*string)(nil),                  // tx_log
+					(*string)(nil),                  // tx_codespace
+					(*uint32)(nil),                  // event_position
+					(*string)(nil),                  // event_phase
 					bignum.OptItoa(anyTransferActivity.MaybeFee), // fee
 					primptr.String(JsonMustMarshal(
 						adapter.TxInputsToRDbTransferInputs(anyTransferActivity.MaybeTxInputs),
@@ -282,7 +293,9 @@ var _ = Describe("BlockActivityData", func() {
 				execResult.On("RowsAffected").Return(int64(1))
 
 				OnTxInsertAnyActivity(tx).Return(execResult, nil)
-				OnTxInsertAnyTransaferOutput(tx).Return(execResult, nil)
+				outputsExecResult := new(MockRDbExecResult)
+				outputsExecResult.On("RowsAffected").Return(int64(3))
+				OnTxInsertAnyTransaferOutputs(tx, 3).Return(outputsExecResult, nil)
 
 				OnTxUpdateTransaferInput(tx,
 					anyTransferActivity.MaybeTxID, anyTransferActivity.MaybeTxInputs[0],
@@ -307,15 +320,11 @@ var _ = Describe("BlockActivityData", func() {
 				OnTxUpdateAnyTransaferInput(tx).Return(execResult, nil)
 				OnTxInsertAnyActivity(tx).Return(execResult, nil)
 
-				OnTxInsertTransferOutput(tx,
-					anyTransferActivity.MaybeTxID, 0,
-				).Once().Return(execResult, nil)
-				OnTxInsertTransferOutput(tx,
-					anyTransferActivity.MaybeTxID, 1,
-				).Once().Return(execResult, nil)
-				OnTxInsertTransferOutput(tx,
-					anyTransferActivity.MaybeTxID, 2,
-				).Once().Return(execResult, nil)
+				insertOutputsExecResult := new(MockRDbExecResult)
+				insertOutputsExecResult.On("RowsAffected").Return(int64(3))
+				OnTxInsertTransferOutputs(tx,
+					anyTransferActivity.MaybeTxID, 3,
+				).Once().Return(insertOutputsExecResult, nil)
 
 				err := inserter.InsertTransferTransaction(tx, &anyTransferActivity)
 				Expect(err).To(BeNil())
@@ -425,15 +434,19 @@ var _ = Describe("BlockActivityData", func() {
 
 					tx.On("Exec",
 						SQL_ACTIVITY_INSERT,
-						anyDepositActivity.BlockHeight,                // block_height
-						"deposit",                                     // type
-						anyDepositActivity.MaybeTxID,                  // txid
-						(*uint32)(nil),                                // event_position
-						bignum.OptItoa(anyDepositActivity.MaybeFee),   // fee
-						(*string)(nil),                                // inputs
-						(*uint32)(nil),                                // output_count
-						anyDepositActivity.MaybeStakingAccountAddress, // staking_account_address
-						primptr.Uint64(uint64(0)),                     // staking_account_nonce
+						anyDepositActivity.BlockHeight, // block_height
+						"deposit",                      // type
+						anyDepositActivity.MaybeTxID,   // txid
+						primptr.String("success"),      // tx_status
+						(*string)(nil),                 // tx_log
+						(*string)(nil),                 // tx_codespace
+						(*uint32)(nil),                 // event_position
+						(*string)(nil),                 // event_phase
+						bignum.OptItoa(anyDepositActivity.MaybeFee), // fee
+						(*string)(nil), // inputs
+						(*uint32)(nil), // output_count
+						anyDepositActivity.MaybeStakingAccountAddress,           // staking_account_address
+						primptr.Uint64(uint64(0)),                               // staking_account_nonce
 						primptr.String(anyDepositActivity.MaybeBonded.String()), // bonded
 						(*string)(nil), // unbonded
 						nil,            // unbonded_from
@@ -467,15 +480,19 @@ var _ = Describe("BlockActivityData", func() {
 
 				tx.On("Exec",
 					SQL_ACTIVITY_INSERT,
-					anyDepositActivity.BlockHeight,                // block_height
-					"deposit",                                     // type
-					anyDepositActivity.MaybeTxID,                  // txid
-					(*uint32)(nil),                                // event_position
-					bignum.OptItoa(anyDepositActivity.MaybeFee),   // fee
-					(*string)(nil),                                // inputs
-					(*uint32)(nil),                                // output_count
-					anyDepositActivity.MaybeStakingAccountAddress, // staking_account_address
-					primptr.Uint64(uint64(0)),                     // staking_account_nonce
+					anyDepositActivity.BlockHeight, // block_height
+					"deposit",                      // type
+					anyDepositActivity.MaybeTxID,   // txid
+					primptr.String("success"),      // tx_status
+					(*string)(nil),                 // tx_log
+					(*string)(nil),                 // tx_codespace
+					(*uint32)(nil),                 // event_position
+					(*string)(nil),                 // event_phase
+					bignum.OptItoa(anyDepositActivity.MaybeFee), // fee
+					(*string)(nil), // inputs
+					(*uint32)(nil), // output_count
+					anyDepositActivity.MaybeStakingAccountAddress,           // staking_account_address
+					primptr.Uint64(uint64(0)),                               // staking_account_nonce
 					primptr.String(anyDepositActivity.MaybeBonded.String()), // bonded
 					(*string)(nil), // unbonded
 					nil,            // unbonded_from
@@ -510,9 +527,8 @@ var _ = Describe("BlockActivityData", func() {
 			WhenStakingAccountDoesNotExist(tx, anyUnbondActivity.MaybeStakingAccountAddress)
 			WhenStakingAccountHasNotJoinedCouncilNode(tx, *anyUnbondActivity.MaybeStakingAccountAddress)
 
-			Expect(func() {
-				_ = inserter.InsertUnbondTransaction(tx, &anyUnbondActivity)
-			}).To(Panic())
+			err := inserter.InsertUnbondTransaction(tx, &anyUnbondActivity)
+			Expect(errors.Is(err, adapter.ErrInconsistentBlockData)).To(BeTrue())
 		})
 
 		Context("When staking account exist", func() {
@@ -584,15 +600,19 @@ var _ = Describe("BlockActivityData", func() {
 
 					tx.On("Exec",
 						SQL_ACTIVITY_INSERT,
-						anyUnbondActivity.BlockHeight,                // block_height
-						"unbond",                                     // type
-						anyUnbondActivity.MaybeTxID,                  // txid
-						(*uint32)(nil),                               // event_position
-						bignum.OptItoa(anyUnbondActivity.MaybeFee),   // fee
-						(*string)(nil),                               // inputs
-						(*uint32)(nil),                               // output_count
-						anyUnbondActivity.MaybeStakingAccountAddress, // staking_account_address
-						primptr.Uint64(uint64(2)),                    // staking_account_nonce
+						anyUnbondActivity.BlockHeight, // block_height
+						"unbond",                      // type
+						anyUnbondActivity.MaybeTxID,   // txid
+						primptr.String("success"),     // tx_status
+						(*string)(nil),                // tx_log
+						(*string)(nil),                // tx_codespace
+						(*uint32)(nil),                // event_position
+						(*string)(nil),                // event_phase
+						bignum.OptItoa(anyUnbondActivity.MaybeFee), // fee
+						(*string)(nil), // inputs
+						(*uint32)(nil), // output_count
+						anyUnbondActivity.MaybeStakingAccountAddress,             // staking_account_address
+						primptr.Uint64(uint64(2)),                                // staking_account_nonce
 						primptr.String(anyUnbondActivity.MaybeBonded.String()),   // bonded
 						primptr.String(anyUnbondActivity.MaybeUnbonded.String()), // unbonded
 						nil,            // unbonded_from
@@ -627,15 +647,19 @@ var _ = Describe("BlockActivityData", func() {
 
 				tx.On("Exec",
 					SQL_ACTIVITY_INSERT,
-					anyUnbondActivity.BlockHeight,                // block_height
-					"unbond",                                     // type
-					anyUnbondActivity.MaybeTxID,                  // txid
-					(*uint32)(nil),                               // event_position
-					bignum.OptItoa(anyUnbondActivity.MaybeFee),   // fee
-					(*string)(nil),                               // inputs
-					(*uint32)(nil),                               // output_count
-					anyUnbondActivity.MaybeStakingAccountAddress, // staking_account_address
-					primptr.Uint64(uint64(2)),                    // staking_account_nonce
+					anyUnbondActivity.BlockHeight, // block_height
+					"unbond",                      // type
+					anyUnbondActivity.MaybeTxID,   // txid
+					primptr.String("success"),     // tx_status
+					(*string)(nil),                // tx_log
+					(*string)(nil),                // tx_codespace
+					(*uint32)(nil),                // event_position
+					(*string)(nil),                // event_phase
+					bignum.OptItoa(anyUnbondActivity.MaybeFee), // fee
+					(*string)(nil), // inputs
+					(*uint32)(nil), // output_count
+					anyUnbondActivity.MaybeStakingAccountAddress,             // staking_account_address
+					primptr.Uint64(uint64(2)),                                // staking_account_nonce
 					primptr.String(anyUnbondActivity.MaybeBonded.String()),   // bonded
 					primptr.String(anyUnbondActivity.MaybeUnbonded.String()), // unbonded
 					nil,            // unbonded_from
@@ -670,9 +694,8 @@ var _ = Describe("BlockActivityData", func() {
 			WhenStakingAccountDoesNotExist(tx, anyWithdrawActivity.MaybeStakingAccountAddress)
 			WhenStakingAccountHasNotJoinedCouncilNode(tx, *anyWithdrawActivity.MaybeStakingAccountAddress)
 
-			Expect(func() {
-				_ = inserter.InsertWithdrawTransaction(tx, &anyWithdrawActivity)
-			}).To(Panic())
+			err := inserter.InsertWithdrawTransaction(tx, &anyWithdrawActivity)
+			Expect(errors.Is(err, adapter.ErrInconsistentBlockData)).To(BeTrue())
 		})
 
 		Context("When staking account exist", func() {
@@ -713,7 +736,9 @@ var _ = Describe("BlockActivityData", func() {
 					execResult := new(MockRDbExecResult)
 					execResult.On("RowsAffected").Return(int64(1))
 					OnTxInsertAnyActivity(tx).Return(execResult, nil)
-					OnTxInsertAnyTransaferOutput(tx).Return(execResult, nil)
+					outputsExecResult := new(MockRDbExecResult)
+					outputsExecResult.On("RowsAffected").Return(int64(3))
+					OnTxInsertAnyTransaferOutputs(tx, 3).Return(outputsExecResult, nil)
 
 					tx.On("Exec",
 						SQL_STAKING_ACCOUNT_UPDATE,
@@ -740,15 +765,11 @@ var _ = Describe("BlockActivityData", func() {
 					OnTxUpdateAnyStakingAccount(tx).Return(execResult, nil)
 					OnTxInsertAnyActivity(tx).Return(execResult, nil)
 
-					OnTxInsertTransferOutput(tx,
-						anyWithdrawActivity.MaybeTxID, 0,
-					).Once().Return(execResult, nil)
-					OnTxInsertTransferOutput(tx,
-						anyWithdrawActivity.MaybeTxID, 1,
-					).Once().Return(execResult, nil)
-					OnTxInsertTransferOutput(tx,
-						anyWithdrawActivity.MaybeTxID, 2,
-					).Once().Return(execResult, nil)
+					insertOutputsExecResult := new(MockRDbExecResult)
+					insertOutputsExecResult.On("RowsAffected").Return(int64(3))
+					OnTxInsertTransferOutputs(tx,
+						anyWithdrawActivity.MaybeTxID, 3,
+					).Once().Return(insertOutputsExecResult, nil)
 
 					err := inserter.InsertWithdrawTransaction(tx, &anyWithdrawActivity)
 					Expect(err).To(BeNil())
@@ -764,20 +785,26 @@ var _ = Describe("BlockActivityData", func() {
 					execResult := new(MockRDbExecResult)
 					execResult.On("RowsAffected").Return(int64(1))
 					OnTxUpdateAnyStakingAccount(tx).Return(execResult, nil)
-					OnTxInsertAnyTransaferOutput(tx).Return(execResult, nil)
+					outputsExecResult := new(MockRDbExecResult)
+					outputsExecResult.On("RowsAffected").Return(int64(3))
+					OnTxInsertAnyTransaferOutputs(tx, 3).Return(outputsExecResult, nil)
 
 					tx.On("Exec",
 						SQL_ACTIVITY_INSERT,
-						anyWithdrawActivity.BlockHeight,                // block_height
-						"withdraw",                                     // type
-						anyWithdrawActivity.MaybeTxID,                  // txid
-						(*uint32)(nil),                                 // event_position
-						bignum.OptItoa(anyWithdrawActivity.MaybeFee),   // fee
-						(*string)(nil),                                 // inputs
-						anyWithdrawActivity.MaybeOutputCount,           // output_count
-						anyWithdrawActivity.MaybeStakingAccountAddress, // staking_account_address
-						primptr.Uint64(uint64(2)),                      // staking_account_nonce
-						(*string)(nil),                                 // bonded
+						anyWithdrawActivity.BlockHeight, // block_height
+						"withdraw",                      // type
+						anyWithdrawActivity.MaybeTxID,   // txid
+						primptr.String("success"),       // tx_status
+						(*string)(nil),                  // tx_log
+						(*string)(nil),                  // tx_codespace
+						(*uint32)(nil),                  // event_position
+						(*string)(nil),                  // event_phase
+						bignum.OptItoa(anyWithdrawActivity.MaybeFee), // fee
+						(*string)(nil),                                             // inputs
+						anyWithdrawActivity.MaybeOutputCount,                       // output_count
+						anyWithdrawActivity.MaybeStakingAccountAddress,             // staking_account_address
+						primptr.Uint64(uint64(2)),                                  // staking_account_nonce
+						(*string)(nil),                                             // bonded
 						primptr.String(anyWithdrawActivity.MaybeUnbonded.String()), // unbonded
 						nil,            // unbonded_from
 						(*string)(nil), // joined_council_node
@@ -972,13 +999,17 @@ var _ = Describe("BlockActivityData", func() {
 			joinedCouncilNode.Id = &councilNodeId
 			tx.On("Exec",
 				SQL_ACTIVITY_INSERT,
-				anyNodeJoinActivity.BlockHeight,                // block_height
-				"nodejoin",                                     // type
-				anyNodeJoinActivity.MaybeTxID,                  // txid
-				(*uint32)(nil),                                 // event_position
-				bignum.OptItoa(anyNodeJoinActivity.MaybeFee),   // fee
-				(*string)(nil),                                 // inputs
-				(*uint32)(nil),                                 // output_count
+				anyNodeJoinActivity.BlockHeight, // block_height
+				"nodejoin",                      // type
+				anyNodeJoinActivity.MaybeTxID,   // txid
+				primptr.String("success"),       // tx_status
+				(*string)(nil),                  // tx_log
+				(*string)(nil),                  // tx_codespace
+				(*uint32)(nil),                  // event_position
+				(*string)(nil),                  // event_phase
+				bignum.OptItoa(anyNodeJoinActivity.MaybeFee), // fee
+				(*string)(nil), // inputs
+				(*uint32)(nil), // output_count
 				anyNodeJoinActivity.MaybeStakingAccountAddress, // staking_account_address
 				primptr.Uint64(uint64(2)),                      // staking_account_nonce
 				(*string)(nil),                                 // bonded
@@ -999,6 +1030,53 @@ var _ = Describe("BlockActivityData", func() {
 			tx.AssertExpectations(GinkgoT())
 		})
 	})
+
+	Describe("InsertFailedTransaction", func() {
+		It("should insert activity into the table without touching staking account", func() {
+			inserter := adapter.NewDefaultRDbBlockActivityDataRepo(sq.StatementBuilder, new(PrimRDbTypeConv))
+
+			tx := new(MockRDbTx)
+
+			anyFailedActivity := RandomDepositActivity()
+			anyFailedActivity.MaybeTxStatus = primptr.Uint8(chainindex.TRANSACTION_STATUS_FAILED)
+			anyFailedActivity.MaybeTxLog = primptr.String("verification failed")
+			anyFailedActivity.MaybeTxCodespace = primptr.String("")
+			anyFailedActivity.MaybeBonded = nil
+
+			execResult := new(MockRDbExecResult)
+			execResult.On("RowsAffected").Return(int64(1))
+			tx.On("Exec",
+				SQL_ACTIVITY_INSERT,
+				anyFailedActivity.BlockHeight,                // block_height
+				"deposit",                                    // type
+				anyFailedActivity.MaybeTxID,                  // txid
+				primptr.String("failed"),                     // tx_status
+				primptr.String("verification failed"),        // tx_log
+				primptr.String(""),                           // tx_codespace
+				(*uint32)(nil),                               // event_position
+				(*string)(nil),                               // event_phase
+				bignum.OptItoa(anyFailedActivity.MaybeFee),   // fee
+				(*string)(nil),                               // inputs
+				(*uint32)(nil),                               // output_count
+				anyFailedActivity.MaybeStakingAccountAddress, // staking_account_address
+				(*uint64)(nil),                               // staking_account_nonce
+				(*string)(nil),                               // bonded
+				(*string)(nil),                               // unbonded
+				nil,                                          // unbdoned_from
+				(*string)(nil),                               // joined_council_node
+				(*uint64)(nil),                               // joined_council_node_id
+				(*string)(nil),                               // affected_council_node
+				(*uint64)(nil),                               // affected_council_node_id
+				nil,                                          // jailed_until
+				(*string)(nil),                               // punishment_kind
+			).Once().Return(execResult, nil)
+
+			err := inserter.InsertFailedTransaction(tx, &anyFailedActivity)
+			Expect(err).To(BeNil())
+			tx.AssertExpectations(GinkgoT())
+			tx.AssertNumberOfCalls(GinkgoT(), "Exec", 1)
+		})
+	})
 })
 
 func WhenCouncilNodeJoinIsNew(tx *MockRDbTx) {
@@ -1008,7 +1086,7 @@ func WhenCouncilNodeJoinIsNew(tx *MockRDbTx) {
 
 func OnTxInsertAnyActivity(tx *MockRDbTx) *mock.Call {
 	return tx.On("Exec",
-		MockSQLWithAnyArgs(SQL_ACTIVITY_INSERT, 18)...,
+		MockSQLWithAnyArgs(SQL_ACTIVITY_INSERT, 22)...,
 	)
 }
 
@@ -1027,17 +1105,21 @@ func OnTxUpdateAnyTransaferInput(tx *MockRDbTx) *mock.Call {
 	)
 }
 
-func OnTxInsertTransferOutput(tx *MockRDbTx, txId *string, index uint32) *mock.Call {
-	return tx.On("Exec",
-		SQL_TRANSFER_OUTPUT_INSERT,
-		txId,
-		index,
-	)
+func SQLTransferOutputsInsertOfSize(size uint32) string {
+	return SQL_TRANSFER_OUTPUT_INSERT + strings.TrimSuffix(strings.Repeat("(?,?),", int(size)), ",")
+}
+
+func OnTxInsertTransferOutputs(tx *MockRDbTx, txId *string, outputCount uint32) *mock.Call {
+	args := []interface{}{SQLTransferOutputsInsertOfSize(outputCount)}
+	for i := uint32(0); i < outputCount; i += 1 {
+		args = append(args, txId, i)
+	}
+	return tx.On("Exec", args...)
 }
 
-func OnTxInsertAnyTransaferOutput(tx *MockRDbTx) *mock.Call {
+func OnTxInsertAnyTransaferOutputs(tx *MockRDbTx, outputCount uint32) *mock.Call {
 	return tx.On("Exec",
-		MockSQLWithAnyArgs(SQL_TRANSFER_OUTPUT_INSERT, 2)...,
+		MockSQLWithAnyArgs(SQLTransferOutputsInsertOfSize(outputCount), 2*int(outputCount))...,
 	)
 }
 
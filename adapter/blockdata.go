@@ -3,6 +3,8 @@ package adapter
 import (
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	jsoniter "github.com/json-iterator/go"
@@ -35,7 +37,17 @@ func NewRDbBlockDataRepo(
 	}
 }
 
+// Store stores the block data. Storing a block already stored with the same
+// hash has no effect, so that the same block can be delivered more than once.
+// A different block stored at the same height fails with ErrBlockConflict
 func (repo *RDbBlockDataRepo) Store(blockData *usecase.BlockData) error {
+	return repo.StoreBatch([]*usecase.BlockData{blockData})
+}
+
+// StoreBatch stores consecutive block data in a single transaction. Either
+// all of them are committed or none of them. Already stored blocks are
+// skipped in the same way as Store
+func (repo *RDbBlockDataRepo) StoreBatch(blockDataList []*usecase.BlockData) error {
 	// FIXME: Persist block data into event store and create projection
 	var err error
 
@@ -48,16 +60,433 @@ func (repo *RDbBlockDataRepo) Store(blockData *usecase.BlockData) error {
 		_ = tx.Rollback()
 	}()
 
-	var committedCouncilNodes []RDbBlockCommittedCouncilNodeRow
-	if committedCouncilNodes, err = repo.parseSignaturesToCommittedCouncilNodeRows(tx, blockData.Block.Height, blockData.Signatures); err != nil {
+	for _, blockData := range blockDataList {
+		if err = repo.storeBlockData(tx, blockData); err != nil {
+			return err
+		}
+	}
+
+	if len(blockDataList) > 0 {
+		if err = repo.checkpointSyncState(tx, newSyncStateCheckpoint(blockDataList), time.Now()); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error commiting block data: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+// Reset truncates blocks and all the tables projected from them, and rewinds
+// the sync state progress to height 0. The raw block archive, quarantined
+// blocks and the recorded chain id and errors are kept untouched. It returns
+// ErrIndexBootstrapped when the index is seeded from a state snapshot, as the
+// snapshot cannot be rebuilt from the blocks
+func (repo *RDbBlockDataRepo) Reset() error {
+	var err error
+
+	tx, err := repo.conn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v: %w", err, ErrRepoOpen)
+	}
+	defer func() {
+		// Calling rollback on committed transaction has no effect
+		_ = tx.Rollback()
+	}()
+
+	if err = repo.ensureNotBootstrapped(tx); err != nil {
 		return err
 	}
 
-	if err = repo.insertBlock(tx, &blockData.Block, committedCouncilNodes); err != nil {
+	// index_bootstrap references blocks, so it has to be truncated in the same
+	// statement even though it is empty
+	if _, err = tx.Exec(
+		"TRUNCATE " + strings.Join(RDB_BLOCK_DATA_TABLES, ", ") + ", index_bootstrap",
+	); err != nil {
+		return fmt.Errorf("error truncating block data tables: %v: %w", err, ErrRepoWrite)
+	}
+
+	if err = repo.resetSyncState(tx, time.Now()); err != nil {
 		return err
 	}
 
-	if err = repo.insertBlockCommittedCouncilNodes(tx, committedCouncilNodes); err != nil {
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error commiting block data reset: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+// Tables of block data and the projections derived from them
+var RDB_BLOCK_DATA_TABLES = []string{
+	"blocks",
+	"activities",
+	"transaction_outputs",
+	"staking_accounts",
+	"council_nodes",
+	"council_node_power_changes",
+	"block_rewards",
+	"block_committed_council_nodes",
+	"block_missed_council_nodes",
+	"block_evidences",
+	"chain_params",
+}
+
+func (repo *RDbBlockDataRepo) ensureNotBootstrapped(tx RDbTx) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Select(
+		"height",
+	).From(
+		"index_bootstrap",
+	).Limit(1).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building index bootstrap select SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	var height uint64
+	if err = tx.QueryRow(sql, sqlArgs...).Scan(&height); err != nil {
+		if err == ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("error querying index bootstrap: %v: %w", err, ErrRepoQuery)
+	}
+
+	return fmt.Errorf(
+		"error removing block data: index is bootstrapped at height %d: %w", height, ErrIndexBootstrapped,
+	)
+}
+
+// Rewind removes the stored block data from the height onward and rolls the
+// projections back to the previous height, so that the blocks can be stored
+// again. Staking accounts and council nodes are recomputed from the activities
+// and the council node power changes kept before the height. The signatures of
+// the previous block are removed as well, as they are carried by the block at
+// the height. It returns ErrIndexBootstrapped when the index is seeded from a
+// state snapshot, as the snapshot cannot be recomputed from the activities
+func (repo *RDbBlockDataRepo) Rewind(fromHeight uint64) error {
+	var err error
+
+	if fromHeight <= GENESIS_BLOCK_HEIGHT {
+		return fmt.Errorf(
+			"error rewinding block data: height %d is not after the genesis block, reset all block data instead", fromHeight,
+		)
+	}
+
+	tx, err := repo.conn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v: %w", err, ErrRepoOpen)
+	}
+	defer func() {
+		// Calling rollback on committed transaction has no effect
+		_ = tx.Rollback()
+	}()
+
+	if err = repo.ensureNotBootstrapped(tx); err != nil {
+		return err
+	}
+
+	// Transaction outputs are keyed by transactions, which are removed next
+	if err = repo.rewindTransactionOutputs(tx, fromHeight); err != nil {
+		return err
+	}
+
+	for _, table := range RDB_BLOCK_HEIGHT_TABLES {
+		if err = repo.deleteFromBlockHeight(tx, table, fromHeight); err != nil {
+			return err
+		}
+	}
+	for _, table := range RDB_BLOCK_COMMIT_TABLES {
+		if err = repo.deleteFromBlockHeight(tx, table, fromHeight-1); err != nil {
+			return err
+		}
+	}
+	if err = repo.clearBlockCommittedCouncilNodes(tx, fromHeight-1); err != nil {
+		return err
+	}
+
+	if err = repo.rewindCouncilNodesLastLeftAtBlockHeight(tx); err != nil {
+		return err
+	}
+	if err = repo.rewindStakingAccounts(tx); err != nil {
+		return err
+	}
+	if err = repo.deleteCouncilNodesCreatedFromBlockHeight(tx, fromHeight); err != nil {
+		return err
+	}
+
+	if err = repo.deleteBlocksFromHeight(tx, fromHeight); err != nil {
+		return err
+	}
+
+	if err = repo.rewindSyncState(tx, fromHeight-1, time.Now()); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error commiting block data rewind: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+// Tables of block data keyed by the height of the block carrying them
+var RDB_BLOCK_HEIGHT_TABLES = []string{
+	"activities",
+	"block_rewards",
+	"block_evidences",
+	"council_node_power_changes",
+}
+
+// Tables of block commits keyed by the height of the signed block, which is
+// the block before the one carrying them
+var RDB_BLOCK_COMMIT_TABLES = []string{
+	"block_committed_council_nodes",
+	"block_missed_council_nodes",
+}
+
+// rewindTransactionOutputs releases the outputs spent by transactions from
+// the height onward and removes the outputs created by them
+func (repo *RDbBlockDataRepo) rewindTransactionOutputs(tx RDbTx, fromHeight uint64) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Update(
+		"transaction_outputs",
+	).Set(
+		"spent_at_txid", nil,
+	).Where(
+		"spent_at_txid IN (SELECT txid FROM activities WHERE block_height >= ?)", fromHeight,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building transaction outputs spent update SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+	if _, err = tx.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error releasing spent transaction outputs: %v: %w", err, ErrRepoWrite)
+	}
+
+	sql, sqlArgs, err = repo.stmtBuilder.Delete(
+		"transaction_outputs",
+	).Where(
+		"txid IN (SELECT txid FROM activities WHERE block_height >= ?)", fromHeight,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building transaction outputs delete SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+	if _, err = tx.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error deleting transaction outputs: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+func (repo *RDbBlockDataRepo) deleteFromBlockHeight(tx RDbTx, table string, fromHeight uint64) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Delete(
+		table,
+	).Where(
+		"block_height >= ?", fromHeight,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building %s delete SQL: %v: %w", table, err, ErrBuildSQLStmt)
+	}
+
+	if _, err = tx.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error deleting %s: %v: %w", table, err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+// clearBlockCommittedCouncilNodes restores the denormalized committed council
+// nodes of the block to before its commit is seen
+func (repo *RDbBlockDataRepo) clearBlockCommittedCouncilNodes(tx RDbTx, blockHeight uint64) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Update(
+		"blocks",
+	).Set(
+		"committed_council_nodes", nil,
+	).Where(
+		"height = ?", blockHeight,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building block committed council nodes clear SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	if _, err = tx.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error clearing block committed council nodes: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+// rewindCouncilNodesLastLeftAtBlockHeight recomputes when each council node
+// has last left from the remaining council node power changes, where leaving
+// is recorded with power 0. Joining again with the same council node clears
+// it. Activities and power changes after the rewound height are removed
+// beforehand
+func (repo *RDbBlockDataRepo) rewindCouncilNodesLastLeftAtBlockHeight(tx RDbTx) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Update(
+		"council_nodes",
+	).Set(
+		"last_left_at_block_height", sq.Expr(
+			"(SELECT pc.block_height FROM council_node_power_changes pc "+
+				"WHERE pc.council_node_id = council_nodes.id AND pc.power = 0 "+
+				"AND NOT EXISTS ("+
+				"SELECT 1 FROM activities a "+
+				"WHERE a.type = 'nodejoin' AND a.tx_status IS DISTINCT FROM 'failed' "+
+				"AND a.joined_council_node_id = council_nodes.id AND a.block_height > pc.block_height"+
+				") "+
+				"ORDER BY pc.block_height DESC LIMIT 1)",
+		),
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building council nodes last left at block height rewind SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	if _, err = tx.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error rewinding council nodes last left at block height: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+// rewindStakingAccounts recomputes the staking accounts by replaying the
+// effects of the remaining successful activities in their order, in the same
+// way as DefaultRDbBlockActivityDataRepo applies them. Staking accounts created
+// after the rewound height are removed
+func (repo *RDbBlockDataRepo) rewindStakingAccounts(tx RDbTx) error {
+	var err error
+
+	const stakingAccountActivities = "FROM activities a " +
+		"WHERE a.staking_account_address = staking_accounts.address AND a.tx_status IS DISTINCT FROM 'failed'"
+	const lastActivityFirst = "ORDER BY a.block_height DESC, a.id DESC LIMIT 1"
+
+	sql, sqlArgs, err := repo.stmtBuilder.Delete(
+		"staking_accounts",
+	).Where(
+		"NOT EXISTS (SELECT 1 " + stakingAccountActivities + " AND a.type IN ('genesis', 'deposit'))",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building staking accounts delete SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+	if _, err = tx.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error deleting staking accounts: %v: %w", err, ErrRepoWrite)
+	}
+
+	sql, sqlArgs, err = repo.stmtBuilder.Update(
+		"staking_accounts",
+	).SetMap(sq.Eq{
+		// Activities without nonce do not change it
+		"nonce": sq.Expr(
+			"COALESCE((SELECT a.staking_account_nonce " + stakingAccountActivities +
+				" AND a.staking_account_nonce IS NOT NULL " + lastActivityFirst + "), 0)",
+		),
+		// Activities record the amount changes, except genesis which records
+		// the initial amounts. Only the activity types applying them to the
+		// staking account are summed up
+		"bonded": sq.Expr(
+			"COALESCE((SELECT SUM(a.bonded) " + stakingAccountActivities +
+				" AND a.type IN ('genesis', 'deposit', 'unbond', 'reward', 'slash')), 0)",
+		),
+		"unbonded": sq.Expr(
+			"COALESCE((SELECT SUM(a.unbonded) " + stakingAccountActivities +
+				" AND a.type IN ('genesis', 'unbond', 'withdraw', 'slash')), 0)",
+		),
+		"jailed_until": sq.Expr(
+			"(SELECT CASE WHEN a.type = 'jail' THEN a.jailed_until END " + stakingAccountActivities +
+				" AND a.type IN ('jail', 'unjail') " + lastActivityFirst + ")",
+		),
+		"punishment_kind": sq.Expr(
+			"(SELECT CASE WHEN a.type = 'jail' THEN a.punishment_kind END " + stakingAccountActivities +
+				" AND a.type IN ('jail', 'unjail') " + lastActivityFirst + ")",
+		),
+		// The last joined council node, unless it has left since
+		"current_council_node_id": sq.Expr(
+			"(SELECT CASE WHEN c.last_left_at_block_height IS NULL THEN c.id END " +
+				"FROM activities a INNER JOIN council_nodes c ON c.id = a.joined_council_node_id " +
+				"WHERE a.staking_account_address = staking_accounts.address AND a.tx_status IS DISTINCT FROM 'failed' " +
+				"AND a.type IN ('genesis', 'nodejoin') " + lastActivityFirst + ")",
+		),
+	}).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building staking accounts rewind SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+	if _, err = tx.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error rewinding staking accounts: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+func (repo *RDbBlockDataRepo) deleteCouncilNodesCreatedFromBlockHeight(tx RDbTx, fromHeight uint64) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Delete(
+		"council_nodes",
+	).Where(
+		"created_at_block_height >= ?", fromHeight,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building council nodes delete SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	if _, err = tx.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error deleting council nodes: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+func (repo *RDbBlockDataRepo) deleteBlocksFromHeight(tx RDbTx, fromHeight uint64) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Delete(
+		"blocks",
+	).Where(
+		"height >= ?", fromHeight,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building blocks delete SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	if _, err = tx.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error deleting blocks: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+func (repo *RDbBlockDataRepo) storeBlockData(tx RDbTx, blockData *usecase.BlockData) error {
+	var err error
+
+	if err = repo.ensureParentBlockHash(tx, &blockData.Block); err != nil {
+		return err
+	}
+
+	maybeProposerCouncilNodeId, err := repo.findProposerCouncilNodeId(tx, blockData.Block.ProposerAddress)
+	if err != nil {
+		return err
+	}
+
+	isInserted, err := repo.insertBlock(tx, &blockData.Block, maybeProposerCouncilNodeId)
+	if err != nil {
+		return err
+	}
+	if !isInserted {
+		return repo.ensureStoredBlockHash(tx, &blockData.Block)
+	}
+
+	if err = repo.storeBlockCommit(tx, blockData.Signatures); err != nil {
+		return err
+	}
+
+	if err = repo.insertBlockEvidences(tx, blockData.Evidences); err != nil {
 		return err
 	}
 
@@ -75,32 +504,123 @@ func (repo *RDbBlockDataRepo) Store(blockData *usecase.BlockData) error {
 		return err
 	}
 
-	if err = tx.Commit(); err != nil {
-		return fmt.Errorf("error commiting block data: %v: %w", err, ErrRepoWrite)
+	if blockData.ChainParams != nil {
+		if err = repo.insertChainParams(tx, blockData.ChainParams); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func (repo *RDbBlockDataRepo) parseSignaturesToCommittedCouncilNodeRows(
-	tx RDbTx,
-	blockHeight uint64,
-	signatures []chainindex.BlockSignature,
-) ([]RDbBlockCommittedCouncilNodeRow, error) {
-	if signatures == nil {
+// findProposerCouncilNodeId returns nil when the proposer is not a known
+// council node
+func (repo *RDbBlockDataRepo) findProposerCouncilNodeId(tx RDbTx, proposerAddress string) (*uint64, error) {
+	if proposerAddress == "" {
 		return nil, nil
 	}
 
-	sort.SliceStable(signatures, func(i, j int) bool {
-		if signatures[i].IsProposer {
-			return true
-		} else if signatures[j].IsProposer {
-			return false
-		} else {
-			return i < j
+	councilNodeId, _, err := repo.findLatestCouncilNodeByAddress(tx, proposerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error querying council node by proposer address: %v", err)
+	}
+	if councilNodeId == uint64(0) {
+		return nil, nil
+	}
+
+	return &councilNodeId, nil
+}
+
+// storeBlockCommit stores the signatures of the previous block carried by a
+// block, together with the council nodes in the validator set of the previous
+// block which have missed signing it. The commit of a block which is not
+// stored, i.e. before the bootstrapped state snapshot, is discarded
+func (repo *RDbBlockDataRepo) storeBlockCommit(tx RDbTx, signatures []chainindex.BlockSignature) error {
+	var err error
+
+	if len(signatures) == 0 {
+		return nil
+	}
+	blockHeight := signatures[0].BlockHeight
+
+	isBlockStored, maybeProposerCouncilNodeId, err := repo.findBlockProposerCouncilNodeId(tx, blockHeight)
+	if err != nil {
+		return err
+	}
+	if !isBlockStored {
+		return nil
+	}
+
+	var committedCouncilNodes []RDbBlockCommittedCouncilNodeRow
+	if committedCouncilNodes, err = repo.parseSignaturesToCommittedCouncilNodeRows(
+		tx, blockHeight, maybeProposerCouncilNodeId, signatures,
+	); err != nil {
+		return err
+	}
+
+	if err = repo.insertBlockCommittedCouncilNodes(tx, committedCouncilNodes); err != nil {
+		return err
+	}
+
+	if err = repo.updateBlockCommittedCouncilNodes(tx, blockHeight, committedCouncilNodes); err != nil {
+		return err
+	}
+
+	validatorCouncilNodeIds, err := repo.listValidatorCouncilNodeIds(tx, blockHeight)
+	if err != nil {
+		return err
+	}
+	committedCouncilNodeIds := make(map[uint64]bool, len(committedCouncilNodes))
+	for _, committedCouncilNode := range committedCouncilNodes {
+		committedCouncilNodeIds[committedCouncilNode.ID] = true
+	}
+	missedCouncilNodeIds := make([]uint64, 0)
+	for _, councilNodeId := range validatorCouncilNodeIds {
+		if !committedCouncilNodeIds[councilNodeId] {
+			missedCouncilNodeIds = append(missedCouncilNodeIds, councilNodeId)
 		}
-	})
+	}
 
+	if err = repo.insertBlockMissedCouncilNodes(tx, blockHeight, missedCouncilNodeIds); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// findBlockProposerCouncilNodeId returns whether the block is stored and the
+// id of its proposer council node if any
+func (repo *RDbBlockDataRepo) findBlockProposerCouncilNodeId(tx RDbTx, blockHeight uint64) (bool, *uint64, error) {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Select(
+		"proposer_council_node_id",
+	).From(
+		"blocks",
+	).Where(
+		"height = ?", blockHeight,
+	).ToSql()
+	if err != nil {
+		return false, nil, fmt.Errorf("error building block proposer select SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	var maybeProposerCouncilNodeId *uint64
+	if err = tx.QueryRow(sql, sqlArgs...).Scan(&maybeProposerCouncilNodeId); err != nil {
+		if err == ErrNoRows {
+			return false, nil, nil
+		}
+		return false, nil, fmt.Errorf("error querying block proposer: %v: %w", err, ErrRepoQuery)
+	}
+
+	return true, maybeProposerCouncilNodeId, nil
+}
+
+func (repo *RDbBlockDataRepo) parseSignaturesToCommittedCouncilNodeRows(
+	tx RDbTx,
+	blockHeight uint64,
+	maybeProposerCouncilNodeId *uint64,
+	signatures []chainindex.BlockSignature,
+) ([]RDbBlockCommittedCouncilNodeRow, error) {
 	rows := make([]RDbBlockCommittedCouncilNodeRow, 0, len(signatures))
 	for _, signature := range signatures {
 		councilNodeId, councilNodeName, err := repo.findLatestCouncilNodeByAddress(tx, signature.CouncilNodeAddress)
@@ -113,14 +633,25 @@ func (repo *RDbBlockDataRepo) parseSignaturesToCommittedCouncilNodeRows(
 			Name:               councilNodeName,
 			CouncilNodeAddress: signature.CouncilNodeAddress,
 			Signature:          signature.Signature,
-			IsProposer:         signature.IsProposer,
+			IsProposer:         maybeProposerCouncilNodeId != nil && councilNodeId == *maybeProposerCouncilNodeId,
 		})
 	}
 
+	// Proposer goes first and the rest keep the commit order
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].IsProposer && !rows[j].IsProposer
+	})
+
 	return rows, nil
 }
 
-func (repo *RDbBlockDataRepo) insertBlock(tx RDbTx, block *chainindex.Block, committedCouncilNodes []RDbBlockCommittedCouncilNodeRow) error {
+// insertBlock returns false without inserting the block when a block is
+// already stored at the same height
+func (repo *RDbBlockDataRepo) insertBlock(
+	tx RDbTx,
+	block *chainindex.Block,
+	maybeProposerCouncilNodeId *uint64,
+) (bool, error) {
 	var err error
 
 	sql, _, err := repo.stmtBuilder.Insert(
@@ -130,72 +661,322 @@ func (repo *RDbBlockDataRepo) insertBlock(tx RDbTx, block *chainindex.Block, com
 		"hash",
 		"time",
 		"app_hash",
-		"committed_council_nodes",
-	).Values("?", "?", "?", "?", "?").ToSql()
+		"proposer_council_node_id",
+		"last_block_hash",
+	).Values("?", "?", "?", "?", "?", "?").Suffix(
+		"ON CONFLICT (height) DO NOTHING",
+	).ToSql()
 	if err != nil {
-		return fmt.Errorf("error building block insert SQL: %v: %w", err, ErrBuildSQLStmt)
+		return false, fmt.Errorf("error building block insert SQL: %v: %w", err, ErrBuildSQLStmt)
 	}
 
-	var committedCoundcilNodesJSON *string
-	if len(committedCouncilNodes) > 0 {
-		var jsonStr string
-		if jsonStr, err = jsoniter.MarshalToString(committedCouncilNodes); err != nil {
-			return fmt.Errorf("error building committed council nodes JSON for insertion: %v: %w", err, ErrBuildSQLStmt)
-		}
-		committedCoundcilNodesJSON = &jsonStr
+	var maybeLastBlockHash *string
+	if block.LastBlockHash != "" {
+		maybeLastBlockHash = &block.LastBlockHash
 	}
-
 	result, err := tx.Exec(sql,
 		block.Height,
 		block.Hash,
 		repo.typeConv.Tton(&block.Time),
 		block.AppHash,
-		committedCoundcilNodesJSON,
+		maybeProposerCouncilNodeId,
+		maybeLastBlockHash,
 	)
 	if err != nil {
-		return fmt.Errorf("error inserting block into the table: %v: %w", err, ErrRepoWrite)
+		return false, fmt.Errorf("error inserting block into the table: %v: %w", err, ErrRepoWrite)
 	}
-	if result.RowsAffected() != 1 {
-		return fmt.Errorf("error inserting block into the table: %w", err)
+	switch result.RowsAffected() {
+	case 0:
+		return false, nil
+	case 1:
+		return true, nil
+	default:
+		return false, fmt.Errorf("error inserting block into the table: mismatched number of rows inserted: %w", ErrRepoWrite)
+	}
+}
+
+// ensureParentBlockHash returns ErrParentBlockMismatch when the previous
+// block is stored with a hash other than the parent hash of the block. The
+// first block has no parent hash, and blocks after an unstored height (e.g.
+// a skipped quarantined block) cannot be checked
+func (repo *RDbBlockDataRepo) ensureParentBlockHash(tx RDbTx, block *chainindex.Block) error {
+	if block.Height <= uint64(1) || block.LastBlockHash == "" {
+		return nil
+	}
+
+	maybeParentHash, err := repo.findStoredBlockHash(tx, block.Height-1)
+	if err != nil {
+		return err
+	}
+	if maybeParentHash == nil {
+		return nil
+	}
+
+	if *maybeParentHash != block.LastBlockHash {
+		return fmt.Errorf(
+			"error storing block %d: parent hash %s differs from stored hash %s of block %d: %w",
+			block.Height, block.LastBlockHash, *maybeParentHash, block.Height-1, ErrParentBlockMismatch,
+		)
 	}
 
 	return nil
 }
 
-func (repo *RDbBlockDataRepo) insertBlockCommittedCouncilNodes(tx RDbTx, rows []RDbBlockCommittedCouncilNodeRow) error {
-	for _, row := range rows {
-		var err error
+// findStoredBlockHash returns nil when no block is stored at the height
+func (repo *RDbBlockDataRepo) findStoredBlockHash(tx RDbTx, height uint64) (*string, error) {
+	var err error
 
-		// nolint:gosec,scopelint
-		if err = repo.insertBlockCommittedCouncilNodeRow(tx, &row); err != nil {
-			return err
+	sql, sqlArgs, err := repo.stmtBuilder.Select(
+		"hash",
+	).From(
+		"blocks",
+	).Where(
+		"height = ?", height,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building stored block hash select SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	var storedHash string
+	if err = tx.QueryRow(sql, sqlArgs...).Scan(&storedHash); err != nil {
+		if err == ErrNoRows {
+			return nil, nil
 		}
+		return nil, fmt.Errorf("error querying stored block hash: %v: %w", err, ErrRepoQuery)
+	}
+
+	return &storedHash, nil
+}
+
+// ensureStoredBlockHash returns ErrBlockConflict when the block stored at the
+// same height has a different hash
+func (repo *RDbBlockDataRepo) ensureStoredBlockHash(tx RDbTx, block *chainindex.Block) error {
+	maybeStoredHash, err := repo.findStoredBlockHash(tx, block.Height)
+	if err != nil {
+		return err
+	}
+	if maybeStoredHash == nil {
+		return fmt.Errorf("error querying stored block hash: block %d is not stored: %w", block.Height, ErrRepoQuery)
+	}
+	storedHash := *maybeStoredHash
+
+	if storedHash != block.Hash {
+		return fmt.Errorf(
+			"error storing block %d: hash %s differs from stored hash %s: %w",
+			block.Height, block.Hash, storedHash, ErrBlockConflict,
+		)
 	}
 
 	return nil
 }
 
-func (repo *RDbBlockDataRepo) insertBlockCommittedCouncilNodeRow(tx RDbTx, row *RDbBlockCommittedCouncilNodeRow) error {
+// updateBlockCommittedCouncilNodes denormalizes the committed council nodes
+// into the block, which is stored before its commit is seen
+func (repo *RDbBlockDataRepo) updateBlockCommittedCouncilNodes(
+	tx RDbTx,
+	blockHeight uint64,
+	committedCouncilNodes []RDbBlockCommittedCouncilNodeRow,
+) error {
 	var err error
 
-	sql, _, err := repo.stmtBuilder.Insert(
+	if len(committedCouncilNodes) == 0 {
+		return nil
+	}
+
+	committedCouncilNodesJSON, err := jsoniter.MarshalToString(committedCouncilNodes)
+	if err != nil {
+		return fmt.Errorf("error building committed council nodes JSON for update: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	sql, sqlArgs, err := repo.stmtBuilder.Update(
+		"blocks",
+	).Set(
+		"committed_council_nodes", committedCouncilNodesJSON,
+	).Where(
+		"height = ?", blockHeight,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building block committed council nodes update SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	result, err := tx.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error updating block committed council nodes: %v: %w", err, ErrRepoWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error updating block committed council nodes: no rows updated: %w", ErrRepoWrite)
+	}
+
+	return nil
+}
+
+// Insert all committed council nodes of a block with a multi-row insert
+func (repo *RDbBlockDataRepo) insertBlockCommittedCouncilNodes(tx RDbTx, rows []RDbBlockCommittedCouncilNodeRow) error {
+	var err error
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	stmtBuilder := repo.stmtBuilder.Insert(
 		"block_committed_council_nodes",
 	).Columns(
 		"block_height",
 		"council_node_id",
 		"signature",
 		"is_proposer",
-	).Values("?", "?", "?", "?").ToSql()
+	)
+	for _, row := range rows {
+		stmtBuilder = stmtBuilder.Values(row.BlockHeight, row.ID, row.Signature, row.IsProposer)
+	}
+	sql, sqlArgs, err := stmtBuilder.ToSql()
 	if err != nil {
 		return fmt.Errorf("error building block signature insert SQL: %v: %w", err, ErrBuildSQLStmt)
 	}
 
-	result, err := tx.Exec(sql, row.BlockHeight, row.ID, row.Signature, row.IsProposer)
+	result, err := tx.Exec(sql, sqlArgs...)
 	if err != nil {
 		return fmt.Errorf("error inserting block signature into the table: %v: %w", err, ErrRepoWrite)
 	}
-	if result.RowsAffected() != 1 {
-		return fmt.Errorf("error inserting block signature into the table: no row inserted: %w", ErrRepoWrite)
+	if result.RowsAffected() != int64(len(rows)) {
+		return fmt.Errorf("error inserting block signature into the table: mismatched number of rows inserted: %w", ErrRepoWrite)
+	}
+
+	return nil
+}
+
+// listValidatorCouncilNodeIds lists the council nodes in the validator set
+// which is expected to sign the block at the height
+func (repo *RDbBlockDataRepo) listValidatorCouncilNodeIds(tx RDbTx, blockHeight uint64) ([]uint64, error) {
+	var err error
+
+	sql, sqlArgs, err := SelectValidatorCouncilNodes(
+		repo.stmtBuilder, blockHeight, "c.id",
+	).OrderBy(
+		"c.id",
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building validator council nodes select SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := tx.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error querying validator council nodes: %v: %w", err, ErrRepoQuery)
+	}
+	defer rowsResult.Close()
+
+	councilNodeIds := make([]uint64, 0)
+	for rowsResult.Next() {
+		var councilNodeId uint64
+		if err = rowsResult.Scan(&councilNodeId); err != nil {
+			return nil, fmt.Errorf("error scanning validator council node row: %v: %w", err, ErrRepoQuery)
+		}
+		councilNodeIds = append(councilNodeIds, councilNodeId)
+	}
+	if err = rowsResult.Err(); err != nil {
+		return nil, fmt.Errorf("error querying validator council nodes: %v: %w", err, ErrRepoQuery)
+	}
+
+	return councilNodeIds, nil
+}
+
+// Insert all council nodes missed signing a block with a multi-row insert
+func (repo *RDbBlockDataRepo) insertBlockMissedCouncilNodes(tx RDbTx, blockHeight uint64, councilNodeIds []uint64) error {
+	var err error
+
+	if len(councilNodeIds) == 0 {
+		return nil
+	}
+
+	stmtBuilder := repo.stmtBuilder.Insert(
+		"block_missed_council_nodes",
+	).Columns(
+		"block_height",
+		"council_node_id",
+	)
+	for _, councilNodeId := range councilNodeIds {
+		stmtBuilder = stmtBuilder.Values(blockHeight, councilNodeId)
+	}
+	sql, sqlArgs, err := stmtBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("error building block missed council nodes insert SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	result, err := tx.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting block missed council nodes into the table: %v: %w", err, ErrRepoWrite)
+	}
+	if result.RowsAffected() != int64(len(councilNodeIds)) {
+		return fmt.Errorf("error inserting block missed council nodes into the table: mismatched number of rows inserted: %w", ErrRepoWrite)
+	}
+
+	return nil
+}
+
+// insertBlockEvidences links each evidence to the council node of the offending
+// address. The council node is left empty when the address is unknown
+func (repo *RDbBlockDataRepo) insertBlockEvidences(tx RDbTx, evidences []chainindex.BlockEvidence) error {
+	var err error
+
+	if len(evidences) == 0 {
+		return nil
+	}
+
+	stmtBuilder := repo.stmtBuilder.Insert(
+		"block_evidences",
+	).Columns(
+		"block_height",
+		"position",
+		"type",
+		"council_node_id",
+		"council_node_address",
+		"vote_height",
+		"vote_a",
+		"vote_b",
+	)
+	for i := range evidences {
+		evidence := &evidences[i]
+
+		councilNodeId, _, err := repo.findLatestCouncilNodeByAddress(tx, evidence.CouncilNodeAddress)
+		if err != nil {
+			return fmt.Errorf("error querying council node by evidence address: %v", err)
+		}
+		var maybeCouncilNodeId *uint64
+		if councilNodeId != uint64(0) {
+			maybeCouncilNodeId = &councilNodeId
+		}
+
+		voteAJSON, err := jsoniter.MarshalToString(BlockEvidenceVoteToRDbBlockEvidenceVoteRow(&evidence.VoteA))
+		if err != nil {
+			return fmt.Errorf("error marshalling evidence vote A: %v", err)
+		}
+		voteBJSON, err := jsoniter.MarshalToString(BlockEvidenceVoteToRDbBlockEvidenceVoteRow(&evidence.VoteB))
+		if err != nil {
+			return fmt.Errorf("error marshalling evidence vote B: %v", err)
+		}
+
+		stmtBuilder = stmtBuilder.Values(
+			evidence.BlockHeight,
+			evidence.Position,
+			evidence.Type,
+			maybeCouncilNodeId,
+			evidence.CouncilNodeAddress,
+			evidence.VoteA.Height,
+			voteAJSON,
+			voteBJSON,
+		)
+	}
+	sql, sqlArgs, err := stmtBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("error building block evidences insert SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	result, err := tx.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting block evidences into the table: %v: %w", err, ErrRepoWrite)
+	}
+	if result.RowsAffected() != int64(len(evidences)) {
+		return fmt.Errorf("error inserting block evidences into the table: mismatched number of rows inserted: %w", ErrRepoWrite)
 	}
 
 	return nil
@@ -213,6 +994,10 @@ func (repo *RDbBlockDataRepo) storeActivities(tx RDbTx, activities []chainindex.
 }
 
 func (repo *RDbBlockDataRepo) storeActivity(tx RDbTx, activity chainindex.Activity) error {
+	if activity.IsFailedTransaction() {
+		return repo.activityDataRepo.InsertFailedTransaction(tx, &activity)
+	}
+
 	switch activity.Type {
 	case chainindex.ACTIVITY_GENESIS:
 		return repo.activityDataRepo.InsertGenesisActivity(tx, &activity)
@@ -263,18 +1048,70 @@ func (repo *RDbBlockDataRepo) insertReward(tx RDbTx, reward *chainindex.BlockRew
 	return nil
 }
 
+func (repo *RDbBlockDataRepo) insertChainParams(tx RDbTx, params *chainindex.ChainParams) error {
+	var err error
+
+	sql, _, err := repo.stmtBuilder.Insert(
+		"chain_params",
+	).Columns(
+		"chain_id",
+		"network_params",
+		"consensus_params",
+	).Values("?", "?", "?").ToSql()
+	if err != nil {
+		return fmt.Errorf("error building chain params insert SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	networkParamsJSON, err := jsoniter.MarshalToString(NetworkParamsToRDbNetworkParamsRow(&params.NetworkParams))
+	if err != nil {
+		return fmt.Errorf("error building network params JSON for insertion: %v: %w", err, ErrBuildSQLStmt)
+	}
+	consensusParamsJSON, err := jsoniter.MarshalToString(ConsensusParamsToRDbConsensusParamsRow(&params.ConsensusParams))
+	if err != nil {
+		return fmt.Errorf("error building consensus params JSON for insertion: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	result, err := tx.Exec(sql, params.ChainID, networkParamsJSON, consensusParamsJSON)
+	if err != nil {
+		return fmt.Errorf("error inserting chain params into the table: %v: %w", err, ErrRepoWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting chain params into the table: no row inserted: %w", ErrRepoWrite)
+	}
+
+	return nil
+}
+
 func (repo *RDbBlockDataRepo) storeCouncilNodeUpdates(tx RDbTx, updates []chainindex.CouncilNodeUpdate, blockHeight uint64) error {
 	for _, update := range updates {
 		var err error
 		// TODO: insert node kicked activity into database
 
+		councilNodeId, _, err := repo.findLatestCouncilNodeByAddress(tx, update.Address)
+		if err != nil {
+			return fmt.Errorf("error querying council node by Tendermint address: %v", err)
+		}
+
+		if update.Type == chainindex.COUNCIL_NODE_UPDATE_TYPE_POWER_CHANGED {
+			// Validators not known as council node have no power history
+			if councilNodeId == uint64(0) {
+				continue
+			}
+			if err = repo.insertCouncilNodePowerChange(tx, councilNodeId, blockHeight, *update.MaybePower); err != nil {
+				return err
+			}
+			continue
+		}
 		if update.Type != chainindex.COUNCIL_NODE_UPDATE_TYPE_LEFT {
 			continue
 		}
 
-		councilNodeId, _, err := repo.findLatestCouncilNodeByAddress(tx, update.Address)
-		if err != nil {
-			return fmt.Errorf("error querying council node by Tendermint address: %v", err)
+		// Leaving is recorded as a power change to 0 as well, so that every
+		// time a council node has left is kept
+		if councilNodeId != uint64(0) {
+			if err = repo.insertCouncilNodePowerChange(tx, councilNodeId, blockHeight, uint64(0)); err != nil {
+				return err
+			}
 		}
 
 		if err = repo.updateCouncilNodeLastUpdatedAtBlockHeight(tx, blockHeight, councilNodeId); err != nil {
@@ -289,6 +1126,40 @@ func (repo *RDbBlockDataRepo) storeCouncilNodeUpdates(tx RDbTx, updates []chaini
 	return nil
 }
 
+func (repo *RDbBlockDataRepo) insertCouncilNodePowerChange(
+	tx RDbTx,
+	councilNodeId uint64,
+	blockHeight uint64,
+	power uint64,
+) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Insert(
+		"council_node_power_changes",
+	).Columns(
+		"council_node_id",
+		"block_height",
+		"power",
+	).Values(
+		councilNodeId,
+		blockHeight,
+		power,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building council node power change insertion SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	result, err := tx.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting council node power change into the table: %v: %w", err, ErrRepoWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting council node power change into the table: no row inserted: %w", ErrRepoWrite)
+	}
+
+	return nil
+}
+
 func (repo *RDbBlockDataRepo) findLatestCouncilNodeByAddress(tx RDbTx, address string) (uint64, string, error) {
 	var err error
 
@@ -1,6 +1,9 @@
 package adapter_test
 
 import (
+	"errors"
+	"strings"
+
 	sq "github.com/Masterminds/squirrel"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -12,18 +15,36 @@ import (
 	"github.com/crypto-com/chainindex/adapter"
 	. "github.com/crypto-com/chainindex/adapter/test/fake"
 	. "github.com/crypto-com/chainindex/adapter/test/mock"
+	"github.com/crypto-com/chainindex/internal/bignum"
 	"github.com/crypto-com/chainindex/internal/primptr"
 	. "github.com/crypto-com/chainindex/test/factory"
+	"github.com/crypto-com/chainindex/usecase"
 	. "github.com/crypto-com/chainindex/usecase/test/factory"
 )
 
 const (
-	SQL_BLOCK_INSERT                                          = "INSERT INTO blocks (height,hash,time,app_hash,committed_council_nodes) VALUES (?,?,?,?,?)"
-	SQL_BLOCK_COMMITTED_COUNCIL_NODES_INSERT                  = "INSERT INTO block_committed_council_nodes (block_height,council_node_id,signature,is_proposer) VALUES (?,?,?,?)"
+	SQL_BLOCK_INSERT                                          = "INSERT INTO blocks (height,hash,time,app_hash,proposer_council_node_id,last_block_hash) VALUES (?,?,?,?,?,?) ON CONFLICT (height) DO NOTHING"
+	SQL_BLOCK_PROPOSER_SELECT                                 = "SELECT proposer_council_node_id FROM blocks WHERE height = ?"
+	SQL_STORED_BLOCK_HASH_SELECT                              = "SELECT hash FROM blocks WHERE height = ?"
+	SQL_BLOCK_COMMITTED_COUNCIL_NODES_INSERT                  = "INSERT INTO block_committed_council_nodes (block_height,council_node_id,signature,is_proposer) VALUES "
+	SQL_BLOCK_COMMITTED_COUNCIL_NODES_UPDATE                  = "UPDATE blocks SET committed_council_nodes = ? WHERE height = ?"
+	SQL_BLOCK_MISSED_COUNCIL_NODES_INSERT                     = "INSERT INTO block_missed_council_nodes (block_height,council_node_id) VALUES "
+	SQL_BLOCK_EVIDENCES_INSERT                                = "INSERT INTO block_evidences (block_height,position,type,council_node_id,council_node_address,vote_height,vote_a,vote_b) VALUES "
+	SQL_VALIDATOR_COUNCIL_NODE_IDS_SELECT                     = "SELECT c.id FROM council_nodes c LEFT JOIN LATERAL (SELECT power, block_height FROM council_node_power_changes WHERE council_node_id = c.id AND (block_height = ? OR block_height <= ?) ORDER BY block_height DESC LIMIT 1) pc ON TRUE WHERE (c.created_at_block_height = ? OR c.created_at_block_height <= ?) AND (c.last_left_at_block_height IS NULL OR c.last_left_at_block_height > ?) AND (pc.power IS NULL OR pc.power > 0) ORDER BY c.id"
 	SQL_REWARD_INSERT                                         = "INSERT INTO block_rewards (block_height,minted) VALUES (?,?)"
+	SQL_CHAIN_PARAMS_INSERT                                   = "INSERT INTO chain_params (chain_id,network_params,consensus_params) VALUES (?,?,?)"
 	SQL_COUNCIL_NODE_ID_BY_ADDRESS_SELECT                     = "SELECT id, name FROM council_nodes WHERE address = ? ORDER BY id DESC"
 	SQL_COUNCIL_NODE_LAST_LEFT_AT_BLOCK_HEIGHT_UPDATE         = "UPDATE council_nodes SET last_left_at_block_height = ? WHERE id = ?"
 	SQL_STAKING_ACCOUNT_REMOVE_CURRENT_COUNCIL_NODE_ID_UPDATE = "UPDATE staking_accounts SET current_council_node_id = ? WHERE current_council_node_id = ?"
+	SQL_COUNCIL_NODE_POWER_CHANGE_INSERT                      = "INSERT INTO council_node_power_changes (council_node_id,block_height,power) VALUES (?,?,?)"
+	SQL_INDEX_BOOTSTRAP_HEIGHT_SELECT                         = "SELECT height FROM index_bootstrap LIMIT 1"
+	SQL_BLOCK_DATA_TRUNCATE                                   = "TRUNCATE blocks, activities, transaction_outputs, staking_accounts, council_nodes, council_node_power_changes, block_rewards, block_committed_council_nodes, block_missed_council_nodes, block_evidences, chain_params, index_bootstrap"
+	SQL_SYNC_STATE_RESET_UPDATE                               = "UPDATE sync_state SET last_stored_height = ?, parser_version = ?, updated_at = ?"
+	SQL_TRANSACTION_OUTPUTS_SPENT_REWIND_UPDATE               = "UPDATE transaction_outputs SET spent_at_txid = ? WHERE spent_at_txid IN (SELECT txid FROM activities WHERE block_height >= ?)"
+	SQL_TRANSACTION_OUTPUTS_REWIND_DELETE                     = "DELETE FROM transaction_outputs WHERE txid IN (SELECT txid FROM activities WHERE block_height >= ?)"
+	SQL_COUNCIL_NODES_CREATED_FROM_HEIGHT_DELETE              = "DELETE FROM council_nodes WHERE created_at_block_height >= ?"
+	SQL_BLOCKS_FROM_HEIGHT_DELETE                             = "DELETE FROM blocks WHERE height >= ?"
+	SQL_SYNC_STATE_REWIND_UPDATE                              = "UPDATE sync_state SET last_stored_height = ?, updated_at = ?"
 )
 
 var _ = Describe("Blockdata", func() {
@@ -36,6 +57,9 @@ var _ = Describe("Blockdata", func() {
 		mockTx.On("Rollback").Return(nil)
 		mockConn = new(MockRDbConn)
 		mockConn.On("Begin").Return(mockTx, nil)
+		mockSyncStateExecResult := new(MockRDbExecResult)
+		mockSyncStateExecResult.On("RowsAffected").Return(int64(1))
+		OnTxUpsertSyncStateWithoutChainID(mockTx).Return(mockSyncStateExecResult, nil)
 
 		stmtBuilder := sq.StatementBuilder
 		mockActivityRepo = new(MockRDbBlockActivityDataRepo)
@@ -46,48 +70,59 @@ var _ = Describe("Blockdata", func() {
 	Describe("Store", func() {
 		It("should insert Block into table", func() {
 			anyBlockData := RandomBlockData()
-			anyBlockData.Signatures = RandomBlockSignaturesOfSize(3, anyBlockData.Block.Height)
-			anyBlockData.Signatures[0].IsProposer = false
-			anyBlockData.Signatures[1].IsProposer = true
-			anyBlockData.Signatures[2].IsProposer = false
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height-1)
 			anyBlockData.Activities = make([]chainindex.Activity, 0)
 			anyBlockData.Reward = nil
 			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
 
+			anyProposerCouncilNodeId := uint64(2)
+			OnTxQueryCouncilNodeByAddressRowReturn(mockTx,
+				anyBlockData.Block.ProposerAddress, anyProposerCouncilNodeId, random.Company(),
+			)
+
 			mockExecResult := new(MockRDbExecResult)
 			mockExecResult.On("RowsAffected").Return(int64(1))
-			OnTxInsertAnyBlockCommittedCouncilNode(mockTx).Return(mockExecResult, nil)
+			mockTx.On("Exec",
+				SQL_BLOCK_INSERT,
+				anyBlockData.Block.Height,
+				anyBlockData.Block.Hash,
+				&anyBlockData.Block.Time,
+				anyBlockData.Block.AppHash,
+				&anyProposerCouncilNodeId,
+				(*string)(nil),
+			).Return(mockExecResult, nil)
 
-			anyCouncilNodeId0 := uint64(1)
-			anyCouncilNodeName0 := random.Company()
-			OnTxQueryCouncilNodeByAddressRowReturn(mockTx,
-				anyBlockData.Signatures[0].CouncilNodeAddress, anyCouncilNodeId0, anyCouncilNodeName0,
-			)
-			anyCouncilNodeId1 := uint64(2)
-			anyCouncilNodeName1 := random.Company()
-			OnTxQueryCouncilNodeByAddressRowReturn(mockTx,
-				anyBlockData.Signatures[1].CouncilNodeAddress, anyCouncilNodeId1, anyCouncilNodeName1,
-			)
-			anyCouncilNodeId2 := uint64(3)
-			anyCouncilNodeName2 := random.Company()
-			OnTxQueryCouncilNodeByAddressRowReturn(mockTx,
-				anyBlockData.Signatures[2].CouncilNodeAddress, anyCouncilNodeId2, anyCouncilNodeName2,
-			)
+			mockTx.On("Commit").Once().Return(nil)
 
-			committedCouncilNodes := []adapter.RDbBlockCommittedCouncilNodeRow{
-				BlockSignatureToRDbBlockSignatureRow(anyBlockData.Signatures[1], anyCouncilNodeId1, anyCouncilNodeName1),
-				BlockSignatureToRDbBlockSignatureRow(anyBlockData.Signatures[0], anyCouncilNodeId0, anyCouncilNodeName0),
-				BlockSignatureToRDbBlockSignatureRow(anyBlockData.Signatures[2], anyCouncilNodeId2, anyCouncilNodeName2),
-			}
+			err := repo.Store(&anyBlockData)
+			Expect(err).To(BeNil())
+			mockTx.AssertExpectations(GinkgoT())
+		})
+
+		It("should insert Block without proposer when the proposer is not a council node", func() {
+			anyBlockData := RandomBlockData()
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height-1)
+			anyBlockData.Activities = make([]chainindex.Activity, 0)
+			anyBlockData.Reward = nil
+			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+
+			mockRowResult := new(MockRDbRowResult)
+			mockRowResult.On("Scan", mock.Anything, mock.Anything).Return(adapter.ErrNoRows)
+			mockTx.On("QueryRow",
+				SQL_COUNCIL_NODE_ID_BY_ADDRESS_SELECT,
+				anyBlockData.Block.ProposerAddress,
+			).Once().Return(mockRowResult)
+
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
 			mockTx.On("Exec",
 				SQL_BLOCK_INSERT,
 				anyBlockData.Block.Height,
 				anyBlockData.Block.Hash,
 				&anyBlockData.Block.Time,
 				anyBlockData.Block.AppHash,
-				primptr.String(JsonMustMarshal(
-					committedCouncilNodes,
-				)),
+				(*uint64)(nil),
+				(*string)(nil),
 			).Return(mockExecResult, nil)
 
 			mockTx.On("Commit").Once().Return(nil)
@@ -97,17 +132,139 @@ var _ = Describe("Blockdata", func() {
 			mockTx.AssertExpectations(GinkgoT())
 		})
 
+		It("should skip the block data when the block is already stored with the same hash", func() {
+			anyBlockData := RandomBlockData()
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(1, anyBlockData.Block.Height-1)
+			anyBlockData.Activities = make([]chainindex.Activity, 0)
+			anyBlockData.Reward = &chainindex.BlockReward{
+				BlockHeight: anyBlockData.Block.Height,
+				Minted:      bignum.Int0().SetUint64(1000),
+			}
+			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(0))
+			OnTxInsertAnyBlock(mockTx).Once().Return(mockExecResult, nil)
+			OnTxQueryStoredBlockHashRowReturn(mockTx, anyBlockData.Block.Height, anyBlockData.Block.Hash)
+
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Store(&anyBlockData)
+			Expect(err).To(BeNil())
+			mockTx.AssertExpectations(GinkgoT())
+			mockTx.AssertNotCalled(GinkgoT(), "QueryRow", SQL_BLOCK_PROPOSER_SELECT, mock.Anything)
+			mockTx.AssertNotCalled(GinkgoT(), "Exec", SQL_REWARD_INSERT, mock.Anything, mock.Anything)
+		})
+
+		It("should return block conflict error when a different block is stored at the same height", func() {
+			anyBlockData := RandomBlockData()
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height-1)
+			anyBlockData.Activities = make([]chainindex.Activity, 0)
+			anyBlockData.Reward = nil
+			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(0))
+			OnTxInsertAnyBlock(mockTx).Once().Return(mockExecResult, nil)
+			OnTxQueryStoredBlockHashRowReturn(mockTx, anyBlockData.Block.Height, "0000000000000000")
+
+			err := repo.Store(&anyBlockData)
+			Expect(errors.Is(err, adapter.ErrBlockConflict)).To(BeTrue())
+			Expect(adapter.IsChainMismatchError(err)).To(BeTrue())
+			mockTx.AssertNotCalled(GinkgoT(), "Commit")
+		})
+
+		It("should store the block when it follows the stored previous block", func() {
+			anyBlockData := RandomBlockData()
+			anyBlockData.Block.Height = uint64(100)
+			anyBlockData.Block.LastBlockHash = RandomBlockHash()
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height-1)
+			anyBlockData.Activities = make([]chainindex.Activity, 0)
+			anyBlockData.Reward = nil
+			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+
+			OnTxQueryStoredBlockHashRowReturn(mockTx, uint64(99), anyBlockData.Block.LastBlockHash)
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			mockTx.On("Exec",
+				SQL_BLOCK_INSERT,
+				uint64(100),
+				anyBlockData.Block.Hash,
+				&anyBlockData.Block.Time,
+				anyBlockData.Block.AppHash,
+				mock.Anything,
+				&anyBlockData.Block.LastBlockHash,
+			).Return(mockExecResult, nil)
+
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Store(&anyBlockData)
+			Expect(err).To(BeNil())
+			mockTx.AssertExpectations(GinkgoT())
+		})
+
+		It("should return parent block mismatch error when the previous block is stored with another hash", func() {
+			anyBlockData := RandomBlockData()
+			anyBlockData.Block.Height = uint64(100)
+			anyBlockData.Block.LastBlockHash = RandomBlockHash()
+
+			OnTxQueryStoredBlockHashRowReturn(mockTx, uint64(99), "0000000000000000")
+
+			err := repo.Store(&anyBlockData)
+			Expect(errors.Is(err, adapter.ErrParentBlockMismatch)).To(BeTrue())
+			Expect(adapter.IsChainMismatchError(err)).To(BeTrue())
+			mockTx.AssertNotCalled(GinkgoT(), "Commit")
+		})
+
+		It("should return chain id mismatch error when the block belongs to another chain than the index", func() {
+			anyBlockData := RandomBlockData()
+			anyBlockData.Block.ChainID = "testnet-croeseid-1"
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height-1)
+			anyBlockData.Activities = make([]chainindex.Activity, 0)
+			anyBlockData.Reward = nil
+			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			OnTxInsertAnyBlock(mockTx).Return(mockExecResult, nil)
+			mockSyncStateExecResult := new(MockRDbExecResult)
+			mockSyncStateExecResult.On("RowsAffected").Return(int64(0))
+			mockTx.On("Exec",
+				SQL_SYNC_STATE_CHECKPOINT_UPSERT,
+				anyBlockData.Block.Height,
+				anyBlockData.Block.Height,
+				mock.MatchedBy(func(chainID *string) bool {
+					return chainID != nil && *chainID == "testnet-croeseid-1"
+				}),
+				adapter.BLOCK_DATA_PARSER_VERSION,
+				mock.Anything,
+				mock.Anything,
+			).Return(mockSyncStateExecResult, nil)
+
+			err := repo.Store(&anyBlockData)
+			Expect(errors.Is(err, adapter.ErrChainIDMismatch)).To(BeTrue())
+			Expect(adapter.IsChainMismatchError(err)).To(BeTrue())
+			mockTx.AssertNotCalled(GinkgoT(), "Commit")
+		})
+
 		It("should panic when the council node who signed the signature does not exist", func() {
 			anyBlockData := RandomBlockData()
-			anyBlockData.Signatures = RandomBlockSignaturesOfSize(1, anyBlockData.Block.Height)
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(1, anyBlockData.Block.Height-1)
 			anyBlockData.Activities = make([]chainindex.Activity, 0)
 			anyBlockData.Reward = nil
 			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
 
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
 			mockExecResult := new(MockRDbExecResult)
 			mockExecResult.On("RowsAffected").Return(int64(1))
 			OnTxInsertAnyBlock(mockTx).Return(mockExecResult, nil)
 
+			OnTxQueryCommittedBlockProposerRowReturn(mockTx, anyBlockData.Block.Height-1, nil)
+
 			mockRowResult := new(MockRDbRowResult)
 			mockRowResult.On("Scan", mock.Anything).Return(adapter.ErrNoRows)
 			mockTx.On("QueryRow",
@@ -122,40 +279,201 @@ var _ = Describe("Blockdata", func() {
 			}).To(Panic())
 		})
 
-		It("should insert block signature into table", func() {
+		It("should store signatures of the previous block with its proposer first", func() {
 			anyBlockData := RandomBlockData()
-			anyBlockData.Signatures = RandomBlockSignaturesOfSize(3, anyBlockData.Block.Height)
+			committedBlockHeight := anyBlockData.Block.Height - 1
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(3, committedBlockHeight)
 			anyBlockData.Activities = make([]chainindex.Activity, 0)
 			anyBlockData.Reward = nil
 			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
 
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
 			mockExecResult := new(MockRDbExecResult)
 			mockExecResult.On("RowsAffected").Return(int64(1))
 			OnTxInsertAnyBlock(mockTx).Return(mockExecResult, nil)
 
+			anyCommittedProposerCouncilNodeId := uint64(2)
+			OnTxQueryCommittedBlockProposerRowReturn(mockTx, committedBlockHeight, &anyCommittedProposerCouncilNodeId)
+
 			anyCouncilNodeId0 := uint64(1)
+			anyCouncilNodeName0 := random.Company()
 			OnTxQueryCouncilNodeByAddressRowReturn(mockTx,
-				anyBlockData.Signatures[0].CouncilNodeAddress, anyCouncilNodeId0, random.Company(),
+				anyBlockData.Signatures[0].CouncilNodeAddress, anyCouncilNodeId0, anyCouncilNodeName0,
 			)
 			anyCouncilNodeId1 := uint64(2)
+			anyCouncilNodeName1 := random.Company()
 			OnTxQueryCouncilNodeByAddressRowReturn(mockTx,
-				anyBlockData.Signatures[1].CouncilNodeAddress, anyCouncilNodeId1, random.Company(),
+				anyBlockData.Signatures[1].CouncilNodeAddress, anyCouncilNodeId1, anyCouncilNodeName1,
 			)
 			anyCouncilNodeId2 := uint64(3)
+			anyCouncilNodeName2 := random.Company()
 			OnTxQueryCouncilNodeByAddressRowReturn(mockTx,
-				anyBlockData.Signatures[2].CouncilNodeAddress, anyCouncilNodeId2, random.Company(),
+				anyBlockData.Signatures[2].CouncilNodeAddress, anyCouncilNodeId2, anyCouncilNodeName2,
 			)
 
-			OnTxInsertBlockCommittedCouncilNode(mockTx,
-				&anyBlockData.Signatures[0], anyCouncilNodeId0,
-			).Once().Return(mockExecResult, nil)
-			OnTxInsertBlockCommittedCouncilNode(mockTx,
-				&anyBlockData.Signatures[1], anyCouncilNodeId1,
+			mockInsertCommittedCouncilNodesExecResult := new(MockRDbExecResult)
+			mockInsertCommittedCouncilNodesExecResult.On("RowsAffected").Return(int64(3))
+			OnTxInsertBlockCommittedCouncilNodes(mockTx,
+				[]*chainindex.BlockSignature{
+					&anyBlockData.Signatures[1],
+					&anyBlockData.Signatures[0],
+					&anyBlockData.Signatures[2],
+				},
+				[]uint64{anyCouncilNodeId1, anyCouncilNodeId0, anyCouncilNodeId2},
+				[]bool{true, false, false},
+			).Once().Return(mockInsertCommittedCouncilNodesExecResult, nil)
+
+			committedCouncilNodes := []adapter.RDbBlockCommittedCouncilNodeRow{
+				BlockSignatureToRDbBlockSignatureRow(anyBlockData.Signatures[1], anyCouncilNodeId1, anyCouncilNodeName1, true),
+				BlockSignatureToRDbBlockSignatureRow(anyBlockData.Signatures[0], anyCouncilNodeId0, anyCouncilNodeName0, false),
+				BlockSignatureToRDbBlockSignatureRow(anyBlockData.Signatures[2], anyCouncilNodeId2, anyCouncilNodeName2, false),
+			}
+			mockTx.On("Exec",
+				SQL_BLOCK_COMMITTED_COUNCIL_NODES_UPDATE,
+				JsonMustMarshal(committedCouncilNodes),
+				committedBlockHeight,
 			).Once().Return(mockExecResult, nil)
-			OnTxInsertBlockCommittedCouncilNode(mockTx,
-				&anyBlockData.Signatures[2], anyCouncilNodeId2,
+
+			OnTxQueryValidatorCouncilNodeIdsReturn(mockTx, committedBlockHeight, []uint64{
+				anyCouncilNodeId0, anyCouncilNodeId1, anyCouncilNodeId2,
+			})
+
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Store(&anyBlockData)
+			Expect(err).To(BeNil())
+			mockTx.AssertExpectations(GinkgoT())
+			mockTx.AssertNotCalled(GinkgoT(), "Exec",
+				MockSQLWithAnyArgs(SQLBlockMissedCouncilNodesInsertOfSize(1), 2)...,
+			)
+		})
+
+		It("should insert validators which have not signed the previous block as missed", func() {
+			anyBlockData := RandomBlockData()
+			committedBlockHeight := anyBlockData.Block.Height - 1
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(1, committedBlockHeight)
+			anyBlockData.Activities = make([]chainindex.Activity, 0)
+			anyBlockData.Reward = nil
+			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			OnTxInsertAnyBlock(mockTx).Return(mockExecResult, nil)
+
+			OnTxQueryCommittedBlockProposerRowReturn(mockTx, committedBlockHeight, nil)
+
+			anyCouncilNodeId := uint64(1)
+			OnTxQueryCouncilNodeByAddressRowReturn(mockTx,
+				anyBlockData.Signatures[0].CouncilNodeAddress, anyCouncilNodeId, random.Company(),
+			)
+			OnTxInsertAnyBlockCommittedCouncilNodes(mockTx, 1).Once().Return(mockExecResult, nil)
+			mockTx.On("Exec",
+				MockSQLWithAnyArgs(SQL_BLOCK_COMMITTED_COUNCIL_NODES_UPDATE, 2)...,
 			).Once().Return(mockExecResult, nil)
 
+			anyMissedCouncilNodeId0 := uint64(2)
+			anyMissedCouncilNodeId1 := uint64(3)
+			OnTxQueryValidatorCouncilNodeIdsReturn(mockTx, committedBlockHeight, []uint64{
+				anyCouncilNodeId, anyMissedCouncilNodeId0, anyMissedCouncilNodeId1,
+			})
+
+			mockInsertMissedCouncilNodesExecResult := new(MockRDbExecResult)
+			mockInsertMissedCouncilNodesExecResult.On("RowsAffected").Return(int64(2))
+			mockTx.On("Exec",
+				SQLBlockMissedCouncilNodesInsertOfSize(2),
+				committedBlockHeight,
+				anyMissedCouncilNodeId0,
+				committedBlockHeight,
+				anyMissedCouncilNodeId1,
+			).Once().Return(mockInsertMissedCouncilNodesExecResult, nil)
+
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Store(&anyBlockData)
+			Expect(err).To(BeNil())
+			mockTx.AssertExpectations(GinkgoT())
+		})
+
+		It("should discard signatures of the previous block when it is not stored", func() {
+			anyBlockData := RandomBlockData()
+			committedBlockHeight := anyBlockData.Block.Height - 1
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(3, committedBlockHeight)
+			anyBlockData.Activities = make([]chainindex.Activity, 0)
+			anyBlockData.Reward = nil
+			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			OnTxInsertAnyBlock(mockTx).Return(mockExecResult, nil)
+
+			mockRowResult := new(MockRDbRowResult)
+			mockRowResult.On("Scan", mock.Anything).Return(adapter.ErrNoRows)
+			mockTx.On("QueryRow", SQL_BLOCK_PROPOSER_SELECT, committedBlockHeight).Once().Return(mockRowResult)
+
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Store(&anyBlockData)
+			Expect(err).To(BeNil())
+			mockTx.AssertExpectations(GinkgoT())
+			mockTx.AssertNotCalled(GinkgoT(), "Exec",
+				MockSQLWithAnyArgs(SQLBlockCommittedCouncilNodesInsertOfSize(3), 12)...,
+			)
+		})
+
+		It("should insert evidences linked to the council node of the offending address", func() {
+			anyBlockData := RandomBlockData()
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height-1)
+			anyBlockData.Activities = make([]chainindex.Activity, 0)
+			anyBlockData.Reward = nil
+			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+			anyKnownEvidence := RandomBlockEvidence()
+			anyKnownEvidence.BlockHeight = anyBlockData.Block.Height
+			anyKnownEvidence.Position = uint32(0)
+			anyUnknownEvidence := RandomBlockEvidence()
+			anyUnknownEvidence.BlockHeight = anyBlockData.Block.Height
+			anyUnknownEvidence.Position = uint32(1)
+			anyBlockData.Evidences = []chainindex.BlockEvidence{anyKnownEvidence, anyUnknownEvidence}
+
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			OnTxInsertAnyBlock(mockTx).Return(mockExecResult, nil)
+
+			anyCouncilNodeId := uint64(5)
+			OnTxQueryCouncilNodeByAddressRowReturn(mockTx,
+				anyKnownEvidence.CouncilNodeAddress, anyCouncilNodeId, random.Company(),
+			)
+			mockUnknownRowResult := new(MockRDbRowResult)
+			mockUnknownRowResult.On("Scan", mock.Anything, mock.Anything).Return(adapter.ErrNoRows)
+			mockTx.On("QueryRow",
+				SQL_COUNCIL_NODE_ID_BY_ADDRESS_SELECT,
+				anyUnknownEvidence.CouncilNodeAddress,
+			).Once().Return(mockUnknownRowResult)
+
+			mockInsertEvidencesExecResult := new(MockRDbExecResult)
+			mockInsertEvidencesExecResult.On("RowsAffected").Return(int64(2))
+			mockTx.On("Exec",
+				SQL_BLOCK_EVIDENCES_INSERT+"(?,?,?,?,?,?,?,?),(?,?,?,?,?,?,?,?)",
+				anyBlockData.Block.Height,
+				uint32(0),
+				anyKnownEvidence.Type,
+				&anyCouncilNodeId,
+				anyKnownEvidence.CouncilNodeAddress,
+				anyKnownEvidence.VoteA.Height,
+				mock.Anything,
+				mock.Anything,
+				anyBlockData.Block.Height,
+				uint32(1),
+				anyUnknownEvidence.Type,
+				(*uint64)(nil),
+				anyUnknownEvidence.CouncilNodeAddress,
+				anyUnknownEvidence.VoteA.Height,
+				mock.Anything,
+				mock.Anything,
+			).Once().Return(mockInsertEvidencesExecResult, nil)
+
 			mockTx.On("Commit").Once().Return(nil)
 
 			err := repo.Store(&anyBlockData)
@@ -200,6 +518,7 @@ var _ = Describe("Blockdata", func() {
 			mockActivityRepo.On("InsertSlashEvent", mock.Anything, &anySlashActivity).Once().Return(nil)
 			mockActivityRepo.On("InsertJailEvent", mock.Anything, &anyJailActivity).Once().Return(nil)
 
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
 			mockExecResult := new(MockRDbExecResult)
 			mockExecResult.On("RowsAffected").Return(int64(1))
 			mockTx.On("Exec",
@@ -208,6 +527,7 @@ var _ = Describe("Blockdata", func() {
 				anyBlockData.Block.Hash,
 				&anyBlockData.Block.Time,
 				anyBlockData.Block.AppHash,
+				mock.Anything,
 				(*string)(nil),
 			).Return(mockExecResult, nil)
 
@@ -219,6 +539,33 @@ var _ = Describe("Blockdata", func() {
 			mockActivityRepo.AssertExpectations(GinkgoT())
 		})
 
+		It("should store failed transaction without applying its effects", func() {
+			anyBlockData := RandomBlockData()
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height)
+			anyBlockData.Reward = nil
+			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+
+			anyFailedActivity := RandomTransferActivity()
+			anyFailedActivity.MaybeTxStatus = primptr.Uint8(chainindex.TRANSACTION_STATUS_FAILED)
+			anyBlockData.Activities = []chainindex.Activity{
+				anyFailedActivity,
+			}
+
+			mockActivityRepo.On("InsertFailedTransaction", mock.Anything, &anyFailedActivity).Once().Return(nil)
+
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			OnTxInsertAnyBlock(mockTx).Return(mockExecResult, nil)
+
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Store(&anyBlockData)
+			Expect(err).To(BeNil())
+			mockActivityRepo.AssertExpectations(GinkgoT())
+			mockActivityRepo.AssertNotCalled(GinkgoT(), "InsertTransferTransaction", mock.Anything, mock.Anything)
+		})
+
 		It("should insert Reward into table", func() {
 			anyBlockData := RandomBlockData()
 			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height)
@@ -228,6 +575,7 @@ var _ = Describe("Blockdata", func() {
 			anyBlockData.Reward = &reward
 			anyBlockData.Reward.BlockHeight = anyBlockData.Block.Height
 
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
 			mockExecResult := new(MockRDbExecResult)
 			mockExecResult.On("RowsAffected").Return(int64(1))
 
@@ -246,13 +594,85 @@ var _ = Describe("Blockdata", func() {
 			mockTx.AssertExpectations(GinkgoT())
 		})
 
-		It("should update council node last left at block height", func() {
+		It("should insert chain params into table", func() {
+			anyBlockData := RandomBlockData()
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height)
+			anyBlockData.Activities = make([]chainindex.Activity, 0)
+			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+			anyBlockData.Reward = nil
+			anyBlockData.ChainParams = &chainindex.ChainParams{
+				ChainID: "testnet-thaler-crypto-com-chain-42",
+				NetworkParams: chainindex.NetworkParams{
+					InitialFeePolicy: chainindex.InitialFeePolicy{
+						Coefficient: uint64(1250),
+						Constant:    uint64(1100),
+					},
+					JailingConfig: chainindex.JailingConfig{
+						BlockSigningWindow:   uint64(720),
+						MissedBlockThreshold: uint64(360),
+					},
+					MaxValidators:            uint64(50),
+					RequiredCouncilNodeStake: bignum.MustAtoi("5000000000000000"),
+					RewardsConfig: chainindex.RewardsConfig{
+						MonetaryExpansionCap:   bignum.MustAtoi("2000000000000000000"),
+						MonetaryExpansionDecay: uint64(999860),
+						MonetaryExpansionR0:    uint64(350),
+						MonetaryExpansionTau:   uint64(999999999999999999),
+						RewardPeriodSeconds:    uint64(86400),
+					},
+					SlashingConfig: chainindex.SlashingConfig{
+						ByzantineSlashPercent: "0.200",
+						LivenessSlashPercent:  "0.100",
+					},
+					UnbondingPeriod: uint64(5400),
+				},
+				ConsensusParams: chainindex.ConsensusParams{
+					BlockMaxBytes:           int64(22020096),
+					BlockMaxGas:             int64(-1),
+					BlockTimeIotaMs:         int64(1000),
+					EvidenceMaxAgeNumBlocks: int64(200),
+					EvidenceMaxAgeDuration:  int64(5400000000000),
+					ValidatorPubKeyTypes:    []string{"ed25519"},
+				},
+			}
+
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+
+			OnTxInsertAnyBlock(mockTx).Return(mockExecResult, nil)
+
+			mockTx.On("Exec",
+				SQL_CHAIN_PARAMS_INSERT,
+				"testnet-thaler-crypto-com-chain-42",
+				"{\"initial_fee_policy\":{\"coefficient\":1250,\"constant\":1100},"+
+					"\"jailing_config\":{\"block_signing_window\":720,\"missed_block_threshold\":360},"+
+					"\"max_validators\":50,"+
+					"\"required_council_node_stake\":\"5000000000000000\","+
+					"\"rewards_config\":{\"monetary_expansion_cap\":\"2000000000000000000\",\"monetary_expansion_decay\":999860,"+
+					"\"monetary_expansion_r0\":350,\"monetary_expansion_tau\":999999999999999999,\"reward_period_seconds\":86400},"+
+					"\"slashing_config\":{\"byzantine_slash_percent\":\"0.200\",\"liveness_slash_percent\":\"0.100\"},"+
+					"\"unbonding_period\":5400}",
+				"{\"block\":{\"max_bytes\":22020096,\"max_gas\":-1,\"time_iota_ms\":1000},"+
+					"\"evidence\":{\"max_age_num_blocks\":200,\"max_age_duration\":5400000000000},"+
+					"\"validator\":{\"pub_key_types\":[\"ed25519\"]}}",
+			).Once().Return(mockExecResult, nil)
+
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Store(&anyBlockData)
+			Expect(err).To(BeNil())
+			mockTx.AssertExpectations(GinkgoT())
+		})
+
+		It("should update council node last left at block height and record power change to 0", func() {
 			anyBlockData := RandomBlockData()
 			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height)
 			anyBlockData.Activities = make([]chainindex.Activity, 0)
 			anyBlockData.Reward = nil
 			anyBlockData.CouncilNodeUpdates = RandomCouncilNodeUpdatesOfSize(3)
 
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
 			mockExecResult := new(MockRDbExecResult)
 			mockExecResult.On("RowsAffected").Return(int64(1))
 
@@ -281,6 +701,14 @@ var _ = Describe("Blockdata", func() {
 			OnTxUpdateCouncilNodeLastLeftAtBlockHeight(mockTx,
 				anyBlockData.Block.Height, anyCouncilNodeId2,
 			).Once().Return(mockExecResult, nil)
+			for _, anyCouncilNodeId := range []uint64{anyCouncilNodeId0, anyCouncilNodeId1, anyCouncilNodeId2} {
+				mockTx.On("Exec",
+					SQL_COUNCIL_NODE_POWER_CHANGE_INSERT,
+					anyCouncilNodeId,
+					anyBlockData.Block.Height,
+					uint64(0),
+				).Once().Return(mockExecResult, nil)
+			}
 
 			mockTx.On("Commit").Once().Return(nil)
 
@@ -296,11 +724,15 @@ var _ = Describe("Blockdata", func() {
 			anyBlockData.Reward = nil
 			anyBlockData.CouncilNodeUpdates = RandomCouncilNodeUpdatesOfSize(3)
 
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
 			mockExecResult := new(MockRDbExecResult)
 			mockExecResult.On("RowsAffected").Return(int64(1))
 
 			OnTxInsertAnyBlock(mockTx).Return(mockExecResult, nil)
 			OnTxUpdateAnyCouncilNodeLastLeftAtBlockHeight(mockTx).Return(mockExecResult, nil)
+			mockTx.On("Exec",
+				MockSQLWithAnyArgs(SQL_COUNCIL_NODE_POWER_CHANGE_INSERT, 3)...,
+			).Return(mockExecResult, nil)
 
 			anyCouncilNodeId0 := uint64(1)
 			OnTxQueryCouncilNodeByAddressRowReturn(mockTx,
@@ -338,6 +770,74 @@ var _ = Describe("Blockdata", func() {
 			mockTx.AssertExpectations(GinkgoT())
 		})
 
+		It("should insert power change of council node", func() {
+			anyBlockData := RandomBlockData()
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height)
+			anyBlockData.Activities = make([]chainindex.Activity, 0)
+			anyBlockData.Reward = nil
+			anyBlockData.CouncilNodeUpdates = []chainindex.CouncilNodeUpdate{
+				RandomCouncilNodePowerChangedUpdate(),
+			}
+
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+
+			OnTxInsertAnyBlock(mockTx).Return(mockExecResult, nil)
+
+			anyCouncilNodeId := uint64(1)
+			OnTxQueryCouncilNodeByAddressRowReturn(mockTx,
+				anyBlockData.CouncilNodeUpdates[0].Address, anyCouncilNodeId, random.Company(),
+			)
+			mockTx.On("Exec",
+				SQL_COUNCIL_NODE_POWER_CHANGE_INSERT,
+				anyCouncilNodeId,
+				anyBlockData.Block.Height,
+				*anyBlockData.CouncilNodeUpdates[0].MaybePower,
+			).Once().Return(mockExecResult, nil)
+
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Store(&anyBlockData)
+			Expect(err).To(BeNil())
+			mockTx.AssertExpectations(GinkgoT())
+			mockTx.AssertNotCalled(GinkgoT(), "Exec",
+				SQL_COUNCIL_NODE_LAST_LEFT_AT_BLOCK_HEIGHT_UPDATE, mock.Anything, mock.Anything,
+			)
+		})
+
+		It("should skip power change of validator which is not a council node", func() {
+			anyBlockData := RandomBlockData()
+			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height)
+			anyBlockData.Activities = make([]chainindex.Activity, 0)
+			anyBlockData.Reward = nil
+			anyBlockData.CouncilNodeUpdates = []chainindex.CouncilNodeUpdate{
+				RandomCouncilNodePowerChangedUpdate(),
+			}
+
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+
+			OnTxInsertAnyBlock(mockTx).Return(mockExecResult, nil)
+
+			mockRowResult := new(MockRDbRowResult)
+			mockRowResult.On("Scan", mock.Anything, mock.Anything).Return(adapter.ErrNoRows)
+			mockTx.On("QueryRow",
+				SQL_COUNCIL_NODE_ID_BY_ADDRESS_SELECT,
+				anyBlockData.CouncilNodeUpdates[0].Address,
+			).Once().Return(mockRowResult)
+
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Store(&anyBlockData)
+			Expect(err).To(BeNil())
+			mockTx.AssertExpectations(GinkgoT())
+			mockTx.AssertNotCalled(GinkgoT(), "Exec",
+				SQL_COUNCIL_NODE_POWER_CHANGE_INSERT, mock.Anything, mock.Anything, mock.Anything,
+			)
+		})
+
 		It("should roll back all changes whenever there is an error", func() {
 			anyBlockData := RandomBlockData()
 			anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height)
@@ -345,28 +845,207 @@ var _ = Describe("Blockdata", func() {
 			anyBlockData.Reward = nil
 			anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
 
+			OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
 			OnTxInsertAnyBlock(mockTx).Once().Return(nil, adapter.ErrRepoWrite)
 
 			err := repo.Store(&anyBlockData)
 			Expect(err).NotTo(BeNil())
 		})
 	})
+
+	Describe("Reset", func() {
+		It("should truncate block data tables and rewind sync state in a single transaction", func() {
+			mockRowResult := new(MockRDbRowResult)
+			mockRowResult.On("Scan", mock.Anything).Return(adapter.ErrNoRows)
+			mockTx.On("QueryRow", SQL_INDEX_BOOTSTRAP_HEIGHT_SELECT).Return(mockRowResult)
+			mockTx.On("Exec", SQL_BLOCK_DATA_TRUNCATE).Return(new(MockRDbExecResult), nil)
+			mockTx.On("Exec", MockSQLWithAnyArgs(SQL_SYNC_STATE_RESET_UPDATE, 3)...).Return(new(MockRDbExecResult), nil)
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Reset()
+			Expect(err).To(BeNil())
+			mockTx.AssertCalled(GinkgoT(), "Exec", SQL_BLOCK_DATA_TRUNCATE)
+			mockTx.AssertCalled(GinkgoT(), "Exec",
+				SQL_SYNC_STATE_RESET_UPDATE, 0, adapter.BLOCK_DATA_PARSER_VERSION, mock.Anything,
+			)
+			mockTx.AssertCalled(GinkgoT(), "Commit")
+		})
+
+		It("should return ErrIndexBootstrapped when the index is bootstrapped from a state snapshot", func() {
+			mockRowResult := new(MockRDbRowResult)
+			mockRowResult.On("Scan", mock.Anything).Return(nil)
+			mockTx.On("QueryRow", SQL_INDEX_BOOTSTRAP_HEIGHT_SELECT).Return(mockRowResult)
+
+			err := repo.Reset()
+			Expect(errors.Is(err, adapter.ErrIndexBootstrapped)).To(BeTrue())
+			mockTx.AssertNotCalled(GinkgoT(), "Exec", SQL_BLOCK_DATA_TRUNCATE)
+			mockTx.AssertNotCalled(GinkgoT(), "Commit")
+		})
+	})
+
+	Describe("Rewind", func() {
+		It("should remove block data from the height and roll projections back in a single transaction", func() {
+			anyFromHeight := uint64(100)
+
+			mockRowResult := new(MockRDbRowResult)
+			mockRowResult.On("Scan", mock.Anything).Return(adapter.ErrNoRows)
+			mockTx.On("QueryRow", SQL_INDEX_BOOTSTRAP_HEIGHT_SELECT).Return(mockRowResult)
+			mockExecResult := new(MockRDbExecResult)
+			mockTx.On("Exec", mock.Anything).Return(mockExecResult, nil)
+			mockTx.On("Exec", mock.Anything, mock.Anything).Return(mockExecResult, nil)
+			mockTx.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(mockExecResult, nil)
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Rewind(anyFromHeight)
+			Expect(err).To(BeNil())
+
+			mockTx.AssertCalled(GinkgoT(), "Exec", SQL_TRANSACTION_OUTPUTS_SPENT_REWIND_UPDATE, nil, anyFromHeight)
+			mockTx.AssertCalled(GinkgoT(), "Exec", SQL_TRANSACTION_OUTPUTS_REWIND_DELETE, anyFromHeight)
+			for _, table := range adapter.RDB_BLOCK_HEIGHT_TABLES {
+				mockTx.AssertCalled(GinkgoT(), "Exec", "DELETE FROM "+table+" WHERE block_height >= ?", anyFromHeight)
+			}
+			// Commit of the previous block is carried by the block at the height
+			for _, table := range adapter.RDB_BLOCK_COMMIT_TABLES {
+				mockTx.AssertCalled(GinkgoT(), "Exec", "DELETE FROM "+table+" WHERE block_height >= ?", anyFromHeight-1)
+			}
+			mockTx.AssertCalled(GinkgoT(), "Exec", SQL_BLOCK_COMMITTED_COUNCIL_NODES_UPDATE, nil, anyFromHeight-1)
+			mockTx.AssertCalled(GinkgoT(), "Exec", mock.MatchedBy(func(sql string) bool {
+				return strings.HasPrefix(sql, "UPDATE council_nodes SET last_left_at_block_height = (SELECT")
+			}))
+			mockTx.AssertCalled(GinkgoT(), "Exec", mock.MatchedBy(func(sql string) bool {
+				return strings.HasPrefix(sql, "DELETE FROM staking_accounts WHERE NOT EXISTS")
+			}))
+			mockTx.AssertCalled(GinkgoT(), "Exec", mock.MatchedBy(func(sql string) bool {
+				return strings.HasPrefix(sql, "UPDATE staking_accounts SET bonded = ")
+			}))
+			mockTx.AssertCalled(GinkgoT(), "Exec", SQL_COUNCIL_NODES_CREATED_FROM_HEIGHT_DELETE, anyFromHeight)
+			mockTx.AssertCalled(GinkgoT(), "Exec", SQL_BLOCKS_FROM_HEIGHT_DELETE, anyFromHeight)
+			mockTx.AssertCalled(GinkgoT(), "Exec", SQL_SYNC_STATE_REWIND_UPDATE, anyFromHeight-1, mock.Anything)
+			mockConn.AssertNumberOfCalls(GinkgoT(), "Begin", 1)
+			mockTx.AssertCalled(GinkgoT(), "Commit")
+		})
+
+		It("should return ErrIndexBootstrapped when the index is bootstrapped from a state snapshot", func() {
+			mockRowResult := new(MockRDbRowResult)
+			mockRowResult.On("Scan", mock.Anything).Return(nil)
+			mockTx.On("QueryRow", SQL_INDEX_BOOTSTRAP_HEIGHT_SELECT).Return(mockRowResult)
+
+			err := repo.Rewind(uint64(100))
+			Expect(errors.Is(err, adapter.ErrIndexBootstrapped)).To(BeTrue())
+			mockTx.AssertNotCalled(GinkgoT(), "Exec", SQL_BLOCKS_FROM_HEIGHT_DELETE, mock.Anything)
+			mockTx.AssertNotCalled(GinkgoT(), "Commit")
+		})
+
+		It("should return error without removing anything when rewinding from the genesis block", func() {
+			err := repo.Rewind(adapter.GENESIS_BLOCK_HEIGHT)
+			Expect(err).NotTo(BeNil())
+			mockConn.AssertNotCalled(GinkgoT(), "Begin")
+		})
+	})
+
+	Describe("StoreBatch", func() {
+		It("should store all block data in a single transaction", func() {
+			anyBlockDataList := make([]*usecase.BlockData, 0, 3)
+			for i := 0; i < 3; i += 1 {
+				anyBlockData := RandomBlockData()
+				anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height)
+				anyBlockData.Activities = make([]chainindex.Activity, 0)
+				anyBlockData.Reward = nil
+				anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+				anyBlockDataList = append(anyBlockDataList, &anyBlockData)
+				OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			}
+
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			OnTxInsertAnyBlock(mockTx).Times(3).Return(mockExecResult, nil)
+
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.StoreBatch(anyBlockDataList)
+			Expect(err).To(BeNil())
+			mockConn.AssertNumberOfCalls(GinkgoT(), "Begin", 1)
+			mockTx.AssertExpectations(GinkgoT())
+		})
+
+		It("should checkpoint sync state at the last block height in the same transaction", func() {
+			anyBlockDataList := make([]*usecase.BlockData, 0, 3)
+			for i := 0; i < 3; i += 1 {
+				anyBlockData := RandomBlockData()
+				anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height)
+				anyBlockData.Activities = make([]chainindex.Activity, 0)
+				anyBlockData.Reward = nil
+				anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+				anyBlockDataList = append(anyBlockDataList, &anyBlockData)
+				OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			}
+			lastBlockHeight := anyBlockDataList[2].Block.Height
+			anyBlockDataList[2].TendermintHeight = lastBlockHeight + 10
+
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			OnTxInsertAnyBlock(mockTx).Times(3).Return(mockExecResult, nil)
+
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.StoreBatch(anyBlockDataList)
+			Expect(err).To(BeNil())
+			mockTx.AssertCalled(GinkgoT(), "Exec",
+				SQL_SYNC_STATE_CHECKPOINT_UPSERT,
+				lastBlockHeight,
+				lastBlockHeight+10,
+				(*string)(nil),
+				adapter.BLOCK_DATA_PARSER_VERSION,
+				mock.Anything,
+				mock.Anything,
+			)
+			mockTx.AssertNumberOfCalls(GinkgoT(), "Commit", 1)
+		})
+
+		It("should not commit any block data when one of them fails", func() {
+			anyBlockDataList := make([]*usecase.BlockData, 0, 2)
+			for i := 0; i < 2; i += 1 {
+				anyBlockData := RandomBlockData()
+				anyBlockData.Signatures = RandomBlockSignaturesOfSize(0, anyBlockData.Block.Height)
+				anyBlockData.Activities = make([]chainindex.Activity, 0)
+				anyBlockData.Reward = nil
+				anyBlockData.CouncilNodeUpdates = make([]chainindex.CouncilNodeUpdate, 0)
+				anyBlockDataList = append(anyBlockDataList, &anyBlockData)
+				OnTxQueryBlockProposerCouncilNodeReturn(mockTx, &anyBlockData.Block, uint64(1))
+			}
+
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			OnTxInsertAnyBlock(mockTx).Once().Return(mockExecResult, nil)
+			OnTxInsertAnyBlock(mockTx).Once().Return(nil, adapter.ErrRepoWrite)
+
+			err := repo.StoreBatch(anyBlockDataList)
+			Expect(err).NotTo(BeNil())
+			mockTx.AssertNotCalled(GinkgoT(), "Commit")
+			mockTx.AssertCalled(GinkgoT(), "Rollback")
+		})
+	})
 })
 
-func BlockSignatureToRDbBlockSignatureRow(signature chainindex.BlockSignature, councilNodeId uint64, councilNodeName string) adapter.RDbBlockCommittedCouncilNodeRow {
+func BlockSignatureToRDbBlockSignatureRow(
+	signature chainindex.BlockSignature,
+	councilNodeId uint64,
+	councilNodeName string,
+	isProposer bool,
+) adapter.RDbBlockCommittedCouncilNodeRow {
 	return adapter.RDbBlockCommittedCouncilNodeRow{
 		BlockHeight:        signature.BlockHeight,
 		ID:                 councilNodeId,
 		Name:               councilNodeName,
 		CouncilNodeAddress: signature.CouncilNodeAddress,
 		Signature:          signature.Signature,
-		IsProposer:         signature.IsProposer,
+		IsProposer:         isProposer,
 	}
 }
 
 func OnTxInsertAnyBlock(mockTx *MockRDbTx) *mock.Call {
 	return mockTx.On("Exec",
-		MockSQLWithAnyArgs(SQL_BLOCK_INSERT, 5)...,
+		MockSQLWithAnyArgs(SQL_BLOCK_INSERT, 6)...,
 	)
 }
 
@@ -385,19 +1064,91 @@ func OnTxQueryCouncilNodeByAddressRowReturn(mockTx *MockRDbTx, address string, c
 	).Once().Return(mockRowResult)
 }
 
-func OnTxInsertBlockCommittedCouncilNode(mockTx *MockRDbTx, signature *chainindex.BlockSignature, councilNodeId uint64) *mock.Call {
-	return mockTx.On("Exec",
-		SQL_BLOCK_COMMITTED_COUNCIL_NODES_INSERT,
-		signature.BlockHeight,
-		councilNodeId,
-		signature.Signature,
-		signature.IsProposer,
-	)
+func OnTxQueryStoredBlockHashRowReturn(mockTx *MockRDbTx, blockHeight uint64, hash string) *mock.Call {
+	mockRowResult := new(MockRDbRowResult)
+	mockRowResult.On("Scan", mock.MatchedBy(func(storedHash *string) bool {
+		*storedHash = hash
+		return true
+	})).Return(nil)
+	return mockTx.On("QueryRow",
+		SQL_STORED_BLOCK_HASH_SELECT,
+		blockHeight,
+	).Once().Return(mockRowResult)
+}
+
+func OnTxQueryBlockProposerCouncilNodeReturn(mockTx *MockRDbTx, block *chainindex.Block, councilNodeId uint64) *mock.Call {
+	return OnTxQueryCouncilNodeByAddressRowReturn(mockTx, block.ProposerAddress, councilNodeId, random.Company())
+}
+
+func OnTxQueryCommittedBlockProposerRowReturn(
+	mockTx *MockRDbTx,
+	blockHeight uint64,
+	maybeProposerCouncilNodeId *uint64,
+) *mock.Call {
+	mockRowResult := new(MockRDbRowResult)
+	mockRowResult.On("Scan", mock.MatchedBy(func(maybeId **uint64) bool {
+		*maybeId = maybeProposerCouncilNodeId
+		return true
+	})).Return(nil)
+	return mockTx.On("QueryRow",
+		SQL_BLOCK_PROPOSER_SELECT,
+		blockHeight,
+	).Once().Return(mockRowResult)
+}
+
+func OnTxQueryValidatorCouncilNodeIdsReturn(mockTx *MockRDbTx, blockHeight uint64, councilNodeIds []uint64) *mock.Call {
+	mockRowsResult := new(MockRDbRowsResult)
+	for _, councilNodeId := range councilNodeIds {
+		councilNodeId := councilNodeId
+		mockRowsResult.On("Next").Once().Return(true)
+		mockRowsResult.On("Scan", mock.MatchedBy(func(id *uint64) bool {
+			*id = councilNodeId
+			return true
+		})).Once().Return(nil)
+	}
+	mockRowsResult.On("Next").Return(false)
+	mockRowsResult.On("Err").Return(nil)
+	mockRowsResult.On("Close").Return()
+
+	return mockTx.On("Query",
+		SQL_VALIDATOR_COUNCIL_NODE_IDS_SELECT,
+		adapter.GENESIS_BLOCK_HEIGHT,
+		adapter.LastEffectiveValidatorUpdateHeight(blockHeight),
+		adapter.GENESIS_BLOCK_HEIGHT,
+		adapter.LastEffectiveValidatorUpdateHeight(blockHeight),
+		adapter.LastEffectiveValidatorUpdateHeight(blockHeight),
+	).Once().Return(mockRowsResult, nil)
+}
+
+func SQLBlockMissedCouncilNodesInsertOfSize(size int) string {
+	return SQL_BLOCK_MISSED_COUNCIL_NODES_INSERT + strings.TrimSuffix(strings.Repeat("(?,?),", size), ",")
+}
+
+func SQLBlockCommittedCouncilNodesInsertOfSize(size int) string {
+	return SQL_BLOCK_COMMITTED_COUNCIL_NODES_INSERT + strings.TrimSuffix(strings.Repeat("(?,?,?,?),", size), ",")
+}
+
+func OnTxInsertBlockCommittedCouncilNodes(
+	mockTx *MockRDbTx,
+	signatures []*chainindex.BlockSignature,
+	councilNodeIds []uint64,
+	isProposers []bool,
+) *mock.Call {
+	args := []interface{}{SQLBlockCommittedCouncilNodesInsertOfSize(len(signatures))}
+	for i, signature := range signatures {
+		args = append(args,
+			signature.BlockHeight,
+			councilNodeIds[i],
+			signature.Signature,
+			isProposers[i],
+		)
+	}
+	return mockTx.On("Exec", args...)
 }
 
-func OnTxInsertAnyBlockCommittedCouncilNode(mockTx *MockRDbTx) *mock.Call {
+func OnTxInsertAnyBlockCommittedCouncilNodes(mockTx *MockRDbTx, size int) *mock.Call {
 	return mockTx.On("Exec",
-		MockSQLWithAnyArgs(SQL_BLOCK_COMMITTED_COUNCIL_NODES_INSERT, 5)...,
+		MockSQLWithAnyArgs(SQLBlockCommittedCouncilNodesInsertOfSize(size), 4*size)...,
 	)
 }
 
@@ -2,8 +2,10 @@ package adapter
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,19 +19,46 @@ import (
 	jsoniter "github.com/json-iterator/go"
 )
 
-func ParseGenesisToBlockData(rawBlockData TendermintGenesisBlockData) *usecase.BlockData {
+const GENESIS_BLOCK_HEIGHT = uint64(1)
+
+// BLOCK_DATA_PARSER_VERSION is recorded in the sync state together with the
+// stored blocks. Bump it when a parsing change makes block data stored by an
+// earlier version inconsistent, so that such indexes can be told and rebuilt
+const BLOCK_DATA_PARSER_VERSION = uint32(1)
+
+// ParseGenesisToBlockData parses the genesis together with the first block.
+// Errors caused by malformed genesis wrap ErrMalformedBlock
+func ParseGenesisToBlockData(rawBlockData TendermintGenesisBlockData) (*usecase.BlockData, error) {
+	var err error
+
+	activities, err := parseGenesisActivities(rawBlockData.Genesis.AppState)
+	if err != nil {
+		return nil, err
+	}
+	chainParams, err := parseGenesisChainParams(rawBlockData.Genesis)
+	if err != nil {
+		return nil, err
+	}
+	councilNodeUpdates, err := parseGenesisValidators(rawBlockData.Genesis.Validators)
+	if err != nil {
+		return nil, err
+	}
+
 	blockData := usecase.BlockData{
 		Block: chainindex.Block{
-			Height:  uint64(1),
-			Hash:    rawBlockData.Block.Hash,
-			Time:    rawBlockData.Genesis.GenesisTime,
-			AppHash: rawBlockData.Genesis.AppHash,
+			Height:          GENESIS_BLOCK_HEIGHT,
+			Hash:            rawBlockData.Block.Hash,
+			ChainID:         rawBlockData.Genesis.ChainID,
+			Time:            rawBlockData.Genesis.GenesisTime,
+			AppHash:         rawBlockData.Genesis.AppHash,
+			ProposerAddress: rawBlockData.Block.PropserAddress,
 		},
-		Activities:         parseGenesisActivities(rawBlockData.Genesis.AppState),
+		Activities:         activities,
 		Reward:             nil,
-		CouncilNodeUpdates: nil,
+		CouncilNodeUpdates: councilNodeUpdates,
+		ChainParams:        chainParams,
 	}
-	return &blockData
+	return &blockData, nil
 }
 
 type TendermintGenesisBlockData struct {
@@ -37,8 +66,104 @@ type TendermintGenesisBlockData struct {
 	Block   *tenderminttypes.Block
 }
 
-func parseGenesisActivities(appState tenderminttypes.GenesisAppState) []chainindex.Activity {
-	blockHeight := uint64(1)
+func parseGenesisChainParams(genesis *tenderminttypes.Genesis) (*chainindex.ChainParams, error) {
+	var err error
+
+	networkParams := genesis.AppState.NetworkParams
+	consensusParams := genesis.ConsensusParams
+
+	requiredCouncilNodeStake, err := bignum.Atoi(networkParams.RequiredCouncilNodeStake)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing genesis required council node stake: %v: %w", err, ErrMalformedBlock)
+	}
+	monetaryExpansionCap, err := bignum.Atoi(networkParams.RewardsConfig.MonetaryExpansionCap)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing genesis monetary expansion cap: %v: %w", err, ErrMalformedBlock)
+	}
+
+	consensusParamValues := []string{
+		consensusParams.Block.MaxBytes,
+		consensusParams.Block.MaxGas,
+		consensusParams.Block.TimeIotaMs,
+		consensusParams.Evidence.MaxAgeNumBlocks,
+		consensusParams.Evidence.MaxAgeDuration,
+	}
+	parsedConsensusParamValues := make([]int64, 0, len(consensusParamValues))
+	for _, value := range consensusParamValues {
+		parsed, parseErr := strconv.ParseInt(value, 10, 64)
+		if parseErr != nil {
+			return nil, fmt.Errorf("error parsing genesis consensus param: %v: %w", parseErr, ErrMalformedBlock)
+		}
+		parsedConsensusParamValues = append(parsedConsensusParamValues, parsed)
+	}
+
+	return &chainindex.ChainParams{
+		ChainID: genesis.ChainID,
+		NetworkParams: chainindex.NetworkParams{
+			InitialFeePolicy: chainindex.InitialFeePolicy{
+				Coefficient: uint64(networkParams.InitialFeePolicy.Coefficient),
+				Constant:    uint64(networkParams.InitialFeePolicy.Constant),
+			},
+			JailingConfig: chainindex.JailingConfig{
+				BlockSigningWindow:   uint64(networkParams.JailingConfig.BlockSigningWindow),
+				MissedBlockThreshold: uint64(networkParams.JailingConfig.MissedBlockThreshold),
+			},
+			MaxValidators:            uint64(networkParams.MaxValidators),
+			RequiredCouncilNodeStake: requiredCouncilNodeStake,
+			RewardsConfig: chainindex.RewardsConfig{
+				MonetaryExpansionCap:   monetaryExpansionCap,
+				MonetaryExpansionDecay: uint64(networkParams.RewardsConfig.MonetaryExpansionDecay),
+				MonetaryExpansionR0:    uint64(networkParams.RewardsConfig.MonetaryExpansionR0),
+				MonetaryExpansionTau:   uint64(networkParams.RewardsConfig.MonetaryExpansionTau),
+				RewardPeriodSeconds:    uint64(networkParams.RewardsConfig.RewardPeriodSeconds),
+			},
+			SlashingConfig: chainindex.SlashingConfig{
+				ByzantineSlashPercent: networkParams.SlashingConfig.ByzantineSlashPercent,
+				LivenessSlashPercent:  networkParams.SlashingConfig.LivenessSlashPercent,
+			},
+			UnbondingPeriod: uint64(networkParams.UnbondingPeriod),
+		},
+		ConsensusParams: chainindex.ConsensusParams{
+			BlockMaxBytes:           parsedConsensusParamValues[0],
+			BlockMaxGas:             parsedConsensusParamValues[1],
+			BlockTimeIotaMs:         parsedConsensusParamValues[2],
+			EvidenceMaxAgeNumBlocks: parsedConsensusParamValues[3],
+			EvidenceMaxAgeDuration:  parsedConsensusParamValues[4],
+			ValidatorPubKeyTypes:    consensusParams.Validator.PubKeyTypes,
+		},
+	}, nil
+}
+
+// parseGenesisValidators returns the power of the genesis validators as power
+// changes, so that council nodes in the genesis validator set have a power
+// from the genesis block
+func parseGenesisValidators(validators []tenderminttypes.GenesisValidator) ([]chainindex.CouncilNodeUpdate, error) {
+	if len(validators) == 0 {
+		return nil, nil
+	}
+
+	councilNodeUpdates := make([]chainindex.CouncilNodeUpdate, 0, len(validators))
+	for _, validator := range validators {
+		power, err := strconv.ParseUint(validator.Power, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error parsing genesis validator %s power: %v: %w", validator.Address, err, ErrMalformedBlock,
+			)
+		}
+		councilNodeUpdates = append(councilNodeUpdates, chainindex.CouncilNodeUpdate{
+			Address:    validator.Address,
+			Type:       chainindex.COUNCIL_NODE_UPDATE_TYPE_POWER_CHANGED,
+			MaybePower: &power,
+		})
+	}
+
+	return councilNodeUpdates, nil
+}
+
+func parseGenesisActivities(appState tenderminttypes.GenesisAppState) ([]chainindex.Activity, error) {
+	var err error
+
+	blockHeight := GENESIS_BLOCK_HEIGHT
 
 	councilNodes := make(map[string]tenderminttypes.GenesisCouncilNode)
 	for _, councilNode := range appState.CouncilNodes {
@@ -49,10 +174,14 @@ func parseGenesisActivities(appState tenderminttypes.GenesisAppState) []chainind
 	for _, entry := range appState.Distribution {
 		var bonded, unbonded *big.Int
 		if entry.Bonded != nil {
-			bonded = bignum.MustAtoi(*entry.Bonded)
+			if bonded, err = bignum.Atoi(*entry.Bonded); err != nil {
+				return nil, fmt.Errorf("error parsing genesis bonded amount: %v: %w", err, ErrMalformedBlock)
+			}
 		}
 		if entry.Unbonded != nil {
-			unbonded = bignum.MustAtoi(*entry.Unbonded)
+			if unbonded, err = bignum.Atoi(*entry.Unbonded); err != nil {
+				return nil, fmt.Errorf("error parsing genesis unbonded amount: %v: %w", err, ErrMalformedBlock)
+			}
 		}
 
 		var councilNodeMeta *chainindex.CouncilNode
@@ -80,6 +209,7 @@ func parseGenesisActivities(appState tenderminttypes.GenesisAppState) []chainind
 			Type:                       chainindex.ACTIVITY_GENESIS,
 			MaybeTxID:                  nil,
 			MaybeEventPosition:         nil,
+			MaybeEventPhase:            nil,
 			MaybeFee:                   nil,
 			MaybeTxInputs:              nil,
 			MaybeOutputCount:           nil,
@@ -93,84 +223,179 @@ func parseGenesisActivities(appState tenderminttypes.GenesisAppState) []chainind
 		})
 	}
 
-	return activities
+	return activities, nil
 }
 
-func ParseBlockToBlockData(rawBlockData TendermintBlockData) *usecase.BlockData {
+// ParseBlockToBlockData parses a block together with its results. Errors
+// caused by malformed block wrap ErrMalformedBlock
+func ParseBlockToBlockData(rawBlockData TendermintBlockData) (*usecase.BlockData, error) {
+	var err error
 	var blockData usecase.BlockData
 
 	blockData.Block = chainindex.Block{
-		Height:  rawBlockData.Block.Height,
-		Hash:    rawBlockData.Block.Hash,
-		Time:    rawBlockData.Block.Time,
-		AppHash: rawBlockData.Block.AppHash,
+		Height:          rawBlockData.Block.Height,
+		Hash:            rawBlockData.Block.Hash,
+		ChainID:         rawBlockData.Block.ChainID,
+		LastBlockHash:   rawBlockData.Block.LastBlockHash,
+		Time:            rawBlockData.Block.Time,
+		AppHash:         rawBlockData.Block.AppHash,
+		ProposerAddress: rawBlockData.Block.PropserAddress,
 	}
 
 	blockData.Signatures = parseSignatures(
 		rawBlockData.Block.Height,
-		rawBlockData.Block.PropserAddress,
 		rawBlockData.Block.Signatures,
 	)
+	blockData.Evidences = parseEvidences(
+		rawBlockData.Block.Height,
+		rawBlockData.Block.Evidences,
+	)
 
 	activities := make([]chainindex.Activity, 0)
 
 	if rawBlockData.BlockResults.TxsEvents != nil {
-		activities = parseTransactions(
+		activities, err = parseTransactions(
 			rawBlockData.Block.Height,
 			rawBlockData.BlockResults.TxsEvents,
+			rawBlockData.BlockResults.TxsResults,
 			rawBlockData.Block.Txs,
 		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	eventActivities, reward := parseBeginBlockEvents(
+	beginBlockActivities, reward, err := parseBlockEvents(
 		rawBlockData.Block.Height,
+		chainindex.EVENT_PHASE_BEGIN_BLOCK,
 		rawBlockData.BlockResults.BeginBlockEvents,
 	)
-	if eventActivities != nil {
-		activities = append(activities, eventActivities...)
+	if err != nil {
+		return nil, err
+	}
+	if beginBlockActivities != nil {
+		activities = append(activities, beginBlockActivities...)
+	}
+	blockData.Reward = mergeBlockRewards(blockData.Reward, reward)
+
+	endBlockActivities, reward, err := parseBlockEvents(
+		rawBlockData.Block.Height,
+		chainindex.EVENT_PHASE_END_BLOCK,
+		rawBlockData.BlockResults.EndBlockEvents,
+	)
+	if err != nil {
+		return nil, err
 	}
-	if reward != nil {
-		blockData.Reward = reward
+	if endBlockActivities != nil {
+		activities = append(activities, endBlockActivities...)
 	}
+	blockData.Reward = mergeBlockRewards(blockData.Reward, reward)
 
 	if len(activities) != 0 {
 		blockData.Activities = activities
 	}
-	blockData.CouncilNodeUpdates = parseValidatorUpdates(rawBlockData.BlockResults.ValidatorUpdates)
+	if blockData.CouncilNodeUpdates, err = parseValidatorUpdates(rawBlockData.BlockResults.ValidatorUpdates); err != nil {
+		return nil, err
+	}
 
-	return &blockData
+	return &blockData, nil
 }
 
-func parseSignatures(blockHeight uint64, proposerAddress string, signatures []tenderminttypes.BlockSignature) []chainindex.BlockSignature {
-	if signatures == nil {
+// parseSignatures parses the last commit signatures of a block. They are
+// signed for the previous block and so attributed to the previous height. The
+// first block has no last commit
+func parseSignatures(blockHeight uint64, signatures []tenderminttypes.BlockSignature) []chainindex.BlockSignature {
+	if signatures == nil || blockHeight <= GENESIS_BLOCK_HEIGHT {
 		return nil
 	}
 
 	parsedSignatures := make([]chainindex.BlockSignature, 0, len(signatures))
 	for _, signature := range signatures {
 		parsedSignatures = append(parsedSignatures, chainindex.BlockSignature{
-			BlockHeight:        blockHeight,
+			BlockHeight:        blockHeight - 1,
 			CouncilNodeAddress: signature.ValidatorAddress,
 			Signature:          signature.Signature,
-			IsProposer:         signature.ValidatorAddress == proposerAddress,
 		})
 	}
 
 	return parsedSignatures
 }
 
+const TENDERMINT_DUPLICATE_VOTE_EVIDENCE_TYPE = "tendermint/DuplicateVoteEvidence"
+
+// parseEvidences parses the duplicate vote evidences carried by a block. The
+// position of an evidence is kept when other types of evidence are skipped
+func parseEvidences(blockHeight uint64, evidences []tenderminttypes.BlockEvidence) []chainindex.BlockEvidence {
+	if len(evidences) == 0 {
+		return nil
+	}
+
+	parsedEvidences := make([]chainindex.BlockEvidence, 0, len(evidences))
+	for position, evidence := range evidences {
+		if evidence.Type != TENDERMINT_DUPLICATE_VOTE_EVIDENCE_TYPE {
+			continue
+		}
+		parsedEvidences = append(parsedEvidences, chainindex.BlockEvidence{
+			BlockHeight:        blockHeight,
+			Position:           uint32(position),
+			Type:               evidence.Type,
+			CouncilNodeAddress: evidence.VoteA.ValidatorAddress,
+			VoteA:              parseEvidenceVote(evidence.VoteA),
+			VoteB:              parseEvidenceVote(evidence.VoteB),
+		})
+	}
+
+	return parsedEvidences
+}
+
+func parseEvidenceVote(vote tenderminttypes.BlockEvidenceVote) chainindex.BlockEvidenceVote {
+	return chainindex.BlockEvidenceVote{
+		Type:           uint8(vote.Type),
+		Height:         vote.Height,
+		Round:          vote.Round,
+		BlockHash:      vote.BlockHash,
+		Timestamp:      vote.Timestamp,
+		ValidatorIndex: vote.ValidatorIndex,
+		Signature:      vote.Signature,
+	}
+}
+
 type TendermintBlockData struct {
 	Block        *tenderminttypes.Block
 	BlockResults *tenderminttypes.BlockResults
 }
 
-func parseTransactions(blockHeight uint64, txsEvents [][]tenderminttypes.BlockResultsEvent, rawTxs []string) []chainindex.Activity {
+func parseTransactions(
+	blockHeight uint64,
+	txsEvents [][]tenderminttypes.BlockResultsEvent,
+	txsResults []tenderminttypes.BlockResultsTxResult,
+	rawTxs []string,
+) ([]chainindex.Activity, error) {
+	if len(rawTxs) < len(txsEvents) {
+		return nil, fmt.Errorf(
+			"error parsing transactions: %d transaction results but only %d transactions: %w",
+			len(txsEvents), len(rawTxs), ErrMalformedBlock,
+		)
+	}
+
 	activities := make([]chainindex.Activity, 0, len(txsEvents))
 	for i, txEvents := range txsEvents {
 		var activity chainindex.Activity
 		activity.BlockHeight = blockHeight
+		activity.MaybeTxStatus = primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS)
+		if i < len(txsResults) && txsResults[i].Code != 0 {
+			activity.MaybeTxStatus = primptr.Uint8(chainindex.TRANSACTION_STATUS_FAILED)
+			activity.MaybeTxLog = primptr.String(txsResults[i].Log)
+			activity.MaybeTxCodespace = primptr.String(txsResults[i].Codespace)
+		}
 
-		decodedTx := txauxdecoder.MustDecodeBase64(rawTxs[i])
+		decodedTx, err := txauxdecoder.DecodeBase64(rawTxs[i])
+		if err != nil {
+			return nil, fmt.Errorf("error decoding transaction %d: %v: %w", i, err, ErrMalformedBlock)
+		}
+		// Failed transaction emits no valid_txs event, so the transaction id is
+		// taken from the decoded transaction
+		activity.MaybeTxID = primptr.String(decodedTx.TxID)
 		switch decodedTx.TxType {
 		case "Transfer":
 			activity.Type = chainindex.ACTIVITY_TRANSFER
@@ -194,6 +419,10 @@ func parseTransactions(blockHeight uint64, txsEvents [][]tenderminttypes.BlockRe
 			activity.Type = chainindex.ACTIVITY_NODEJOIN
 		case "Unjail":
 			activity.Type = chainindex.ACTIVITY_UNJAIL
+		default:
+			return nil, fmt.Errorf(
+				"error parsing transaction %d: unsupported transaction type %s: %w", i, decodedTx.TxType, ErrMalformedBlock,
+			)
 		}
 
 		for _, event := range txEvents {
@@ -202,21 +431,25 @@ func parseTransactions(blockHeight uint64, txsEvents [][]tenderminttypes.BlockRe
 				for _, attribute := range event.Attributes {
 					value, err := base64DecodeString(attribute.Value)
 					if err != nil {
-						panic(fmt.Sprintf("error base64 decoding valid_txs event: %v", err))
+						return nil, fmt.Errorf("error base64 decoding valid_txs event: %v: %w", err, ErrMalformedBlock)
 					}
 
 					switch attribute.Key {
-					case ATTRIBUTE_TXID:
-						activity.MaybeTxID = &value
 					case ATTRIBUTE_FEE:
-						activity.MaybeFee = chainindex.MustCROStrToCoin(value)
+						if activity.MaybeFee, err = chainindex.CROStrToCoin(value); err != nil {
+							return nil, fmt.Errorf("error parsing valid_txs fee: %v: %w", err, ErrMalformedBlock)
+						}
 					}
 				}
 			case "staking_change":
+				// Failed transaction has no effect on staking accounts
+				if activity.IsFailedTransaction() {
+					continue
+				}
 				for _, attribute := range event.Attributes {
 					value, err := base64DecodeString(attribute.Value)
 					if err != nil {
-						panic(fmt.Sprintf("error base64 decoding staking_change event: %v", err))
+						return nil, fmt.Errorf("error base64 decoding staking_change event: %v: %w", err, ErrMalformedBlock)
 					}
 
 					switch attribute.Key {
@@ -226,42 +459,25 @@ func parseTransactions(blockHeight uint64, txsEvents [][]tenderminttypes.BlockRe
 						var stakingDiffs StakingDiffs
 						err := jsoniter.Unmarshal([]byte(value), &stakingDiffs)
 						if err != nil {
-							panic(fmt.Sprintf("error deserializing staking_diff: %v", err))
+							return nil, fmt.Errorf("error deserializing staking_diff: %v: %w", err, ErrMalformedBlock)
 						}
 
 						for _, kvPair := range stakingDiffs {
 							switch kvPair.Key {
 							case "Bonded":
-								activity.MaybeBonded, err = bignum.Atoi(kvPair.Value.(string))
-								if err != nil {
-									panic("error converting staking_diff bonded amount to big.Int")
+								if activity.MaybeBonded, err = parseStakingDiffAmount(kvPair.Value); err != nil {
+									return nil, fmt.Errorf("error converting staking_diff bonded amount to big.Int: %v: %w", err, ErrMalformedBlock)
 								}
 							case "Unbonded":
-								activity.MaybeUnbonded, err = bignum.Atoi(kvPair.Value.(string))
-								if err != nil {
-									panic("error converting staking_diff unbonded amount to big.Int")
+								if activity.MaybeUnbonded, err = parseStakingDiffAmount(kvPair.Value); err != nil {
+									return nil, fmt.Errorf("error converting staking_diff unbonded amount to big.Int: %v: %w", err, ErrMalformedBlock)
 								}
 							case "UnbondedFrom":
 								unix, _ := kvPair.Value.(float64)
 								activity.MaybeUnbondedFrom = primptr.Time(time.Unix(int64(unix), 0).UTC())
 							case "CouncilNode":
-								councilNode, _ := kvPair.Value.(map[string]interface{})
-
-								var securityContact *string
-								if councilNode["security_contact"] != nil {
-									*securityContact, _ = councilNode["security_contact"].(string)
-								}
-								consensusPubKey, _ := councilNode["consensus_pubkey"].(map[string]interface{})
-								pubKey, _ := consensusPubKey["value"].(string)
-								activity.MaybeCouncilNodeMeta = &chainindex.CouncilNode{
-									Id:                         nil,
-									Name:                       councilNode["name"].(string),
-									MaybeSecurityContact:       securityContact,
-									PubKeyType:                 chainindex.PUBKEY_TYPE_ED25519,
-									PubKey:                     pubKey,
-									Address:                    tendermint.AddressFromPubKey(pubKey),
-									CreatedAtBlockHeight:       blockHeight,
-									MaybeLastLeftAtBlockHeight: nil,
+								if activity.MaybeCouncilNodeMeta, err = parseStakingDiffCouncilNode(blockHeight, kvPair.Value); err != nil {
+									return nil, fmt.Errorf("error parsing staking_diff council node: %v: %w", err, ErrMalformedBlock)
 								}
 							}
 						}
@@ -273,20 +489,123 @@ func parseTransactions(blockHeight uint64, txsEvents [][]tenderminttypes.BlockRe
 		activities = append(activities, activity)
 	}
 
-	return activities
+	return activities, nil
 }
 
-func parseBeginBlockEvents(blockHeight uint64, beginBlockEvents []tenderminttypes.BlockResultsEvent) ([]chainindex.Activity, *chainindex.BlockReward) {
+// ParsePendingTransaction parses a base64 encoded transaction in the mempool.
+// Only information decodable from the transaction itself is available because
+// it is not yet executed
+func ParsePendingTransaction(rawTx string) (*usecase.PendingTransaction, error) {
+	decodedTx, err := txauxdecoder.DecodeBase64(rawTx)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding pending transaction: %v", err)
+	}
+
+	pendingTx := usecase.PendingTransaction{
+		TxID:                       decodedTx.TxID,
+		MaybeOutputCount:           decodedTx.OutputCount,
+		MaybeStakingAccountAddress: decodedTx.StakingAccountAddress,
+	}
+	switch decodedTx.TxType {
+	case "Transfer":
+		pendingTx.Type = chainindex.ACTIVITY_TRANSFER
+	case "Deposit":
+		pendingTx.Type = chainindex.ACTIVITY_DEPOSIT
+	case "Unbond":
+		pendingTx.Type = chainindex.ACTIVITY_UNBOND
+	case "Withdraw":
+		pendingTx.Type = chainindex.ACTIVITY_WITHDRAW
+	case "NodeJoin":
+		pendingTx.Type = chainindex.ACTIVITY_NODEJOIN
+	case "Unjail":
+		pendingTx.Type = chainindex.ACTIVITY_UNJAIL
+	default:
+		return nil, fmt.Errorf("error parsing pending transaction: unsupported transaction type %s", decodedTx.TxType)
+	}
+	if decodedTx.Inputs != nil {
+		pendingTx.MaybeTxInputs = make([]chainindex.TxInput, 0, len(decodedTx.Inputs))
+		for _, txInput := range decodedTx.Inputs {
+			pendingTx.MaybeTxInputs = append(pendingTx.MaybeTxInputs, chainindex.TxInput{
+				TxId:  txInput.ID,
+				Index: txInput.Index,
+			})
+		}
+	}
+
+	return &pendingTx, nil
+}
+
+func parseStakingDiffAmount(value interface{}) (*big.Int, error) {
+	amount, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected amount type %T", value)
+	}
+
+	return bignum.Atoi(amount)
+}
+
+func parseStakingDiffCouncilNode(blockHeight uint64, value interface{}) (*chainindex.CouncilNode, error) {
+	councilNode, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected council node type %T", value)
+	}
+
+	name, ok := councilNode["name"].(string)
+	if !ok {
+		return nil, errors.New("missing council node name")
+	}
+	var securityContact *string
+	if contact, ok := councilNode["security_contact"].(string); ok {
+		securityContact = &contact
+	}
+	consensusPubKey, _ := councilNode["consensus_pubkey"].(map[string]interface{})
+	pubKey, _ := consensusPubKey["value"].(string)
+
+	return &chainindex.CouncilNode{
+		Id:                         nil,
+		Name:                       name,
+		MaybeSecurityContact:       securityContact,
+		PubKeyType:                 chainindex.PUBKEY_TYPE_ED25519,
+		PubKey:                     pubKey,
+		Address:                    tendermint.AddressFromPubKey(pubKey),
+		CreatedAtBlockHeight:       blockHeight,
+		MaybeLastLeftAtBlockHeight: nil,
+	}, nil
+}
+
+// mergeBlockRewards sums up the minted amounts of the rewards of the same
+// block, which can be distributed at both begin and end block
+func mergeBlockRewards(reward *chainindex.BlockReward, other *chainindex.BlockReward) *chainindex.BlockReward {
+	if reward == nil {
+		return other
+	}
+	if other == nil {
+		return reward
+	}
+
+	return &chainindex.BlockReward{
+		BlockHeight: reward.BlockHeight,
+		Minted:      new(big.Int).Add(reward.Minted, other.Minted),
+	}
+}
+
+// parseBlockEvents parses begin block or end block events. Event positions are
+// counted within the phase
+func parseBlockEvents(
+	blockHeight uint64,
+	phase chainindex.EventPhase,
+	events []tenderminttypes.BlockResultsEvent,
+) ([]chainindex.Activity, *chainindex.BlockReward, error) {
 	activities := make([]chainindex.Activity, 0)
 	var reward *chainindex.BlockReward
 
-	for position, event := range beginBlockEvents {
+	for position, event := range events {
 		switch event.Type {
 		case "reward":
 			for _, attribute := range event.Attributes {
 				value, err := base64DecodeString(attribute.Value)
 				if err != nil {
-					panic(fmt.Sprintf("error base64 decoding reward event value: %v", err))
+					return nil, nil, fmt.Errorf("error base64 decoding reward event value: %v: %w", err, ErrMalformedBlock)
 				}
 
 				switch attribute.Key {
@@ -294,23 +613,25 @@ func parseBeginBlockEvents(blockHeight uint64, beginBlockEvents []tenderminttype
 					// FIXME: v0.5 reward event minted amount has unnecessary double quotes
 					minted, err := bignum.Atoi(strings.Trim(value, "\""))
 					if err != nil {
-						panic("error converting staking_diff bonded amount to big.Int")
+						return nil, nil, fmt.Errorf("error converting reward minted amount to big.Int: %v: %w", err, ErrMalformedBlock)
 					}
 
-					reward = new(chainindex.BlockReward)
-					reward.BlockHeight = blockHeight
-					reward.Minted = minted
+					reward = mergeBlockRewards(reward, &chainindex.BlockReward{
+						BlockHeight: blockHeight,
+						Minted:      minted,
+					})
 				}
 			}
 		case "staking_change":
 			var activity chainindex.Activity
 			activity.BlockHeight = blockHeight
 			activity.MaybeEventPosition = primptr.Uint32(uint32(position))
+			activity.MaybeEventPhase = primptr.Uint8(phase)
 
 			for _, attribute := range event.Attributes {
 				value, err := base64DecodeString(attribute.Value)
 				if err != nil {
-					panic(fmt.Sprintf("error base64 decoding staking_change event value: %v", err))
+					return nil, nil, fmt.Errorf("error base64 decoding staking_change event value: %v: %w", err, ErrMalformedBlock)
 				}
 
 				switch attribute.Key {
@@ -336,20 +657,18 @@ func parseBeginBlockEvents(blockHeight uint64, beginBlockEvents []tenderminttype
 					var stakingDiffs StakingDiffs
 					err := jsoniter.Unmarshal([]byte(value), &stakingDiffs)
 					if err != nil {
-						panic(fmt.Sprintf("error deserializing staking_diff: %v", err))
+						return nil, nil, fmt.Errorf("error deserializing staking_diff: %v: %w", err, ErrMalformedBlock)
 					}
 
 					for _, kvPair := range stakingDiffs {
 						switch kvPair.Key {
 						case "Bonded":
-							activity.MaybeBonded, err = bignum.Atoi(kvPair.Value.(string))
-							if err != nil {
-								panic("error converting staking_diff bonded amount to big.Int")
+							if activity.MaybeBonded, err = parseStakingDiffAmount(kvPair.Value); err != nil {
+								return nil, nil, fmt.Errorf("error converting staking_diff bonded amount to big.Int: %v: %w", err, ErrMalformedBlock)
 							}
 						case "Unbonded":
-							activity.MaybeUnbonded, err = bignum.Atoi(kvPair.Value.(string))
-							if err != nil {
-								panic("error converting staking_diff unbonded amount to big.Int")
+							if activity.MaybeUnbonded, err = parseStakingDiffAmount(kvPair.Value); err != nil {
+								return nil, nil, fmt.Errorf("error converting staking_diff unbonded amount to big.Int: %v: %w", err, ErrMalformedBlock)
 							}
 						case "JailedUntil":
 							unix, _ := kvPair.Value.(float64)
@@ -364,12 +683,14 @@ func parseBeginBlockEvents(blockHeight uint64, beginBlockEvents []tenderminttype
 		}
 	}
 
-	return activities, reward
+	return activities, reward, nil
 }
 
-func parseValidatorUpdates(validatorUpdates []tenderminttypes.BlockResultsValidator) []chainindex.CouncilNodeUpdate {
+// parseValidatorUpdates parses kicked validators into left updates, and the
+// rest into power changed updates
+func parseValidatorUpdates(validatorUpdates []tenderminttypes.BlockResultsValidator) ([]chainindex.CouncilNodeUpdate, error) {
 	if validatorUpdates == nil {
-		return nil
+		return nil, nil
 	}
 
 	councilNodeUpdates := make([]chainindex.CouncilNodeUpdate, 0)
@@ -380,10 +701,23 @@ func parseValidatorUpdates(validatorUpdates []tenderminttypes.BlockResultsValida
 				Address: validatorUpdate.PubKey.Address,
 				Type:    chainindex.COUNCIL_NODE_UPDATE_TYPE_LEFT,
 			})
+			continue
 		}
+
+		power, err := strconv.ParseUint(*validatorUpdate.Power, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error parsing validator %s power: %v: %w", validatorUpdate.PubKey.Address, err, ErrMalformedBlock,
+			)
+		}
+		councilNodeUpdates = append(councilNodeUpdates, chainindex.CouncilNodeUpdate{
+			Address:    validatorUpdate.PubKey.Address,
+			Type:       chainindex.COUNCIL_NODE_UPDATE_TYPE_POWER_CHANGED,
+			MaybePower: &power,
+		})
 	}
 
-	return councilNodeUpdates
+	return councilNodeUpdates, nil
 }
 
 func isValidatorKicked(validatorUpdate *tenderminttypes.BlockResultsValidator) bool {
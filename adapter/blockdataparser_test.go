@@ -1,6 +1,7 @@
 package adapter_test
 
 import (
+	"errors"
 	"time"
 
 	. "github.com/onsi/ginkgo"
@@ -24,13 +25,16 @@ var _ = Describe("Blockdataparser", func() {
 				Block:   &block,
 			}
 
-			actualBlockData := ParseGenesisToBlockData(rawBlockData)
+			actualBlockData, err := ParseGenesisToBlockData(rawBlockData)
+			Expect(err).To(BeNil())
 			Expect(*actualBlockData).To(Equal(usecase.BlockData{
 				Block: chainindex.Block{
-					Height:  block.Height,
-					Hash:    block.Hash,
-					Time:    block.Time,
-					AppHash: block.AppHash,
+					Height:          block.Height,
+					Hash:            block.Hash,
+					ChainID:         genesis.ChainID,
+					Time:            block.Time,
+					AppHash:         block.AppHash,
+					ProposerAddress: block.PropserAddress,
 				},
 				Activities: []chainindex.Activity{
 					{
@@ -118,12 +122,171 @@ var _ = Describe("Blockdataparser", func() {
 						},
 					},
 				},
+				CouncilNodeUpdates: []chainindex.CouncilNodeUpdate{
+					{
+						Address:    "FA7B721B5704DF98EF3ECD3796DDEF6AA2A80257",
+						Type:       chainindex.COUNCIL_NODE_UPDATE_TYPE_POWER_CHANGED,
+						MaybePower: primptr.Uint64(60000000),
+					},
+					{
+						Address:    "7570B2D23A4C7B638BEFE02EB4FC7927BFDED6B7",
+						Type:       chainindex.COUNCIL_NODE_UPDATE_TYPE_POWER_CHANGED,
+						MaybePower: primptr.Uint64(60000000),
+					},
+				},
+				ChainParams: &chainindex.ChainParams{
+					ChainID: "testnet-thaler-crypto-com-chain-42",
+					NetworkParams: chainindex.NetworkParams{
+						InitialFeePolicy: chainindex.InitialFeePolicy{
+							Coefficient: uint64(1250),
+							Constant:    uint64(1100),
+						},
+						JailingConfig: chainindex.JailingConfig{
+							BlockSigningWindow:   uint64(720),
+							MissedBlockThreshold: uint64(360),
+						},
+						MaxValidators:            uint64(50),
+						RequiredCouncilNodeStake: bignum.MustAtoi("5000000000000000"),
+						RewardsConfig: chainindex.RewardsConfig{
+							MonetaryExpansionCap:   bignum.MustAtoi("2000000000000000000"),
+							MonetaryExpansionDecay: uint64(999860),
+							MonetaryExpansionR0:    uint64(350),
+							MonetaryExpansionTau:   uint64(999999999999999999),
+							RewardPeriodSeconds:    uint64(86400),
+						},
+						SlashingConfig: chainindex.SlashingConfig{
+							ByzantineSlashPercent: "0.200",
+							LivenessSlashPercent:  "0.100",
+						},
+						UnbondingPeriod: uint64(5400),
+					},
+					ConsensusParams: chainindex.ConsensusParams{
+						BlockMaxBytes:           int64(22020096),
+						BlockMaxGas:             int64(-1),
+						BlockTimeIotaMs:         int64(1000),
+						EvidenceMaxAgeNumBlocks: int64(200),
+						EvidenceMaxAgeDuration:  int64(5400000000000),
+						ValidatorPubKeyTypes:    []string{"ed25519"},
+					},
+				},
 			}))
 		})
 	})
 
+	Describe("ParseGenesis with malformed genesis", func() {
+		It("should return malformed block error when distribution amount is invalid", func() {
+			genesis := sampleGenesis()
+			genesis.AppState.Distribution[0].Bonded = primptr.String("invalid")
+			block := sampleGenesisBlock()
+
+			_, err := ParseGenesisToBlockData(TendermintGenesisBlockData{
+				Genesis: &genesis,
+				Block:   &block,
+			})
+			Expect(errors.Is(err, ErrMalformedBlock)).To(BeTrue())
+		})
+
+		It("should return malformed block error when validator power is invalid", func() {
+			genesis := sampleGenesis()
+			genesis.Validators[0].Power = "invalid"
+			block := sampleGenesisBlock()
+
+			_, err := ParseGenesisToBlockData(TendermintGenesisBlockData{
+				Genesis: &genesis,
+				Block:   &block,
+			})
+			Expect(errors.Is(err, ErrMalformedBlock)).To(BeTrue())
+		})
+	})
+
+	Describe("ParseBlock with malformed block", func() {
+		It("should return malformed block error when begin block event value is not base64 encoded", func() {
+			anyBlockHeight := uint64(33339)
+			block := tenderminttypes.Block{
+				Height: anyBlockHeight,
+				Hash:   "42E2A7C6AA135D2652ED8C0BEEB446BFC2B4A54B679FE07109CD249F42EC853C",
+			}
+			blockResults := tenderminttypes.BlockResults{
+				Height: anyBlockHeight,
+				BeginBlockEvents: []tenderminttypes.BlockResultsEvent{
+					{
+						Type: "staking_change",
+						Attributes: []tenderminttypes.BlockResultsEventAttribute{
+							{
+								Key:   "c3Rha2luZ19hZGRyZXNz",
+								Value: "not base64",
+							},
+						},
+					},
+				},
+			}
+
+			_, err := ParseBlockToBlockData(TendermintBlockData{
+				Block:        &block,
+				BlockResults: &blockResults,
+			})
+			Expect(errors.Is(err, ErrMalformedBlock)).To(BeTrue())
+		})
+
+		It("should return malformed block error when staking diff amount is not a string", func() {
+			anyBlockHeight := uint64(33339)
+			block := tenderminttypes.Block{
+				Height: anyBlockHeight,
+				Hash:   "42E2A7C6AA135D2652ED8C0BEEB446BFC2B4A54B679FE07109CD249F42EC853C",
+			}
+			blockResults := tenderminttypes.BlockResults{
+				Height: anyBlockHeight,
+				EndBlockEvents: []tenderminttypes.BlockResultsEvent{
+					{
+						Type: "staking_change",
+						Attributes: []tenderminttypes.BlockResultsEventAttribute{
+							{
+								// staking_diff: [{"key":"Bonded","value":1}]
+								Key:   "c3Rha2luZ19kaWZm",
+								Value: "W3sia2V5IjoiQm9uZGVkIiwidmFsdWUiOjF9XQ==",
+							},
+						},
+					},
+				},
+			}
+
+			_, err := ParseBlockToBlockData(TendermintBlockData{
+				Block:        &block,
+				BlockResults: &blockResults,
+			})
+			Expect(errors.Is(err, ErrMalformedBlock)).To(BeTrue())
+		})
+
+		It("should return malformed block error when validator power is not an unsigned integer", func() {
+			anyBlockHeight := uint64(33339)
+			block := tenderminttypes.Block{
+				Height: anyBlockHeight,
+				Hash:   "42E2A7C6AA135D2652ED8C0BEEB446BFC2B4A54B679FE07109CD249F42EC853C",
+			}
+			blockResults := tenderminttypes.BlockResults{
+				Height: anyBlockHeight,
+				ValidatorUpdates: []tenderminttypes.BlockResultsValidator{
+					{
+						PubKey: tenderminttypes.BlockResultsValidatorPubKey{
+							Type:    "ed25519",
+							PubKey:  "tDLheZJwsA8oYEwarR6/X+zAmNKMLHTVkh/fvcLqcwA=",
+							Address: "D527DAECDE0501CF2E785A8DC0D9F4A64760F0BB",
+						},
+						Power: primptr.String("-1"),
+					},
+				},
+			}
+
+			_, err := ParseBlockToBlockData(TendermintBlockData{
+				Block:        &block,
+				BlockResults: &blockResults,
+			})
+			Expect(errors.Is(err, ErrMalformedBlock)).To(BeTrue())
+		})
+	})
+
 	Describe("ParseBlock", func() {
-		It("should parse signatures", func() {
+		It("should parse signatures of the last commit as signatures of the previous block", func() {
 			anyBlockHeight := uint64(33339)
 			anyBlockTime, _ := time.Parse("2006-01-02T15:04:05.000000000Z", "2020-05-15T13:19:07.783916012Z")
 			block := tenderminttypes.Block{
@@ -163,51 +326,164 @@ var _ = Describe("Blockdataparser", func() {
 				ValidatorUpdates: nil,
 			}
 
-			actualBlockData := ParseBlockToBlockData(TendermintBlockData{
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
 				Block:        &block,
 				BlockResults: &blockResults,
 			})
+			Expect(err).To(BeNil())
 			Expect(*actualBlockData).To(Equal(usecase.BlockData{
 				Block: chainindex.Block{
-					Height:  anyBlockHeight,
-					Hash:    "42E2A7C6AA135D2652ED8C0BEEB446BFC2B4A54B679FE07109CD249F42EC853C",
-					Time:    anyBlockTime,
-					AppHash: "DCAD7CEAD2B0A6A668E1D8458E5C1E2B1B790AFFD2D56B36EE1690825BD9818C",
+					Height:          anyBlockHeight,
+					Hash:            "42E2A7C6AA135D2652ED8C0BEEB446BFC2B4A54B679FE07109CD249F42EC853C",
+					Time:            anyBlockTime,
+					AppHash:         "DCAD7CEAD2B0A6A668E1D8458E5C1E2B1B790AFFD2D56B36EE1690825BD9818C",
+					ProposerAddress: "64161C75F5F2A78806267AB3A4E2BD3C04944AA0",
 				},
 				Signatures: []chainindex.BlockSignature{
 					{
-						BlockHeight:        anyBlockHeight,
+						BlockHeight:        anyBlockHeight - 1,
 						CouncilNodeAddress: "34C725CABA703269B3F1D1A907A84DE5FEE96469",
 						Signature:          "waN7MvfcTUwA8hD5aueM5XXsZ4hkwpXCP5MMO0xr/njryxNx1hrfyPD3z07DXPAasVFVrD4mwjkbowzM4T+mCg==",
-						IsProposer:         false,
 					},
 					{
-						BlockHeight:        anyBlockHeight,
+						BlockHeight:        anyBlockHeight - 1,
 						CouncilNodeAddress: "64161C75F5F2A78806267AB3A4E2BD3C04944AA0",
 						Signature:          "N9PoH1tgBTLtPxqxfDNWKMrAPZVBpNvrzQEXQpfZMO2TXvBwKW4Gmw31b0bTUMsiyLJJkSAI+UF5zRrHEu2FDQ==",
-						IsProposer:         true,
 					},
 					{
-						BlockHeight:        anyBlockHeight,
+						BlockHeight:        anyBlockHeight - 1,
 						CouncilNodeAddress: "7570B2D23A4C7B638BEFE02EB4FC7927BFDED6B7",
 						Signature:          "t3b8S0yakf2xdCGRzsXskGegAv0xu406T7jbBoH+fqfcO3wnbvaD8xUs5A1zOKPEVzj5aylzym/w074K+omoAg==",
-						IsProposer:         false,
 					},
 					{
-						BlockHeight:        anyBlockHeight,
+						BlockHeight:        anyBlockHeight - 1,
 						CouncilNodeAddress: "D527DAECDE0501CF2E785A8DC0D9F4A64760F0BB",
 						Signature:          "x9YjNUFpi+W8WcdJXJdUgsfzYUmrqmC7NYDo0vpYpqfRh33VrUWjxkFG6auB0uliEy5yBTEZdivR+Qxb964gCg==",
-						IsProposer:         false,
 					},
 					{
-						BlockHeight:        anyBlockHeight,
+						BlockHeight:        anyBlockHeight - 1,
 						CouncilNodeAddress: "FA7B721B5704DF98EF3ECD3796DDEF6AA2A80257",
 						Signature:          "jMos+ST8x69X16GJhRqzpcG0Bxk9aRt0jifL6gJuZGqX50i9iGD5DGNZSkDfTv1YnHL3YdSsOpfXqmbuVCCuAw==",
-						IsProposer:         false,
 					},
 				},
 			}))
 		})
+		It("should parse no signature for the first block", func() {
+			block := tenderminttypes.Block{
+				Height:         uint64(1),
+				Hash:           "42E2A7C6AA135D2652ED8C0BEEB446BFC2B4A54B679FE07109CD249F42EC853C",
+				PropserAddress: "64161C75F5F2A78806267AB3A4E2BD3C04944AA0",
+				Signatures:     []tenderminttypes.BlockSignature{},
+			}
+			blockResults := tenderminttypes.BlockResults{
+				Height: uint64(1),
+			}
+
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
+				Block:        &block,
+				BlockResults: &blockResults,
+			})
+			Expect(err).To(BeNil())
+			Expect(actualBlockData.Signatures).To(BeNil())
+		})
+
+		It("should parse chain id and parent block hash of the block", func() {
+			block := tenderminttypes.Block{
+				Height:         uint64(3510),
+				Hash:           "2CD22EA622D190B9ABCAB797E2F60F6F4FCFC19CC0A67642E5E7856CEAD78163",
+				ChainID:        "testnet-thaler-crypto-com-chain-42",
+				LastBlockHash:  "BE33BBB643E19D90DDE8FEA7334F43DF8479F3D41ACD91F3B89FF97742ED2C1A",
+				PropserAddress: "7570B2D23A4C7B638BEFE02EB4FC7927BFDED6B7",
+			}
+			blockResults := tenderminttypes.BlockResults{
+				Height: uint64(3510),
+			}
+
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
+				Block:        &block,
+				BlockResults: &blockResults,
+			})
+			Expect(err).To(BeNil())
+			Expect(actualBlockData.Block.ChainID).To(Equal("testnet-thaler-crypto-com-chain-42"))
+			Expect(actualBlockData.Block.LastBlockHash).To(Equal("BE33BBB643E19D90DDE8FEA7334F43DF8479F3D41ACD91F3B89FF97742ED2C1A"))
+		})
+
+		It("should parse duplicate vote evidences and skip other types of evidence", func() {
+			anyBlockHeight := uint64(3510)
+			voteATime, _ := time.Parse("2006-01-02T15:04:05.000000000Z", "2020-05-07T10:00:25.395605367Z")
+			voteBTime, _ := time.Parse("2006-01-02T15:04:05.000000000Z", "2020-05-07T10:00:25.330002296Z")
+			block := tenderminttypes.Block{
+				Height:         anyBlockHeight,
+				Hash:           "2CD22EA622D190B9ABCAB797E2F60F6F4FCFC19CC0A67642E5E7856CEAD78163",
+				PropserAddress: "7570B2D23A4C7B638BEFE02EB4FC7927BFDED6B7",
+				Evidences: []tenderminttypes.BlockEvidence{
+					{
+						Type: "tendermint/UnknownEvidence",
+					},
+					{
+						Type:       "tendermint/DuplicateVoteEvidence",
+						PubKeyType: "tendermint/PubKeyEd25519",
+						PubKey:     "rXhu7xhqYBtJftVLKxvKN0XnpyOzxFnUEfAhD1dEF/8=",
+						VoteA: tenderminttypes.BlockEvidenceVote{
+							Type:             1,
+							Height:           uint64(3509),
+							Round:            uint64(0),
+							BlockHash:        "2C278F10E96EB892FF456D91EB48CFFA31679692102A6FC6F600DC51ABAAE989",
+							Timestamp:        voteATime,
+							ValidatorAddress: "34C725CABA703269B3F1D1A907A84DE5FEE96469",
+							ValidatorIndex:   uint64(0),
+							Signature:        "tBWO1Wf9lf39WXpU2aJ1hzZF8nXl+D0izGt1FT1acA/nu/ezE2pVeQDgrU85b16ENTuYy375p2hdaXvyjESCBw==",
+						},
+						VoteB: tenderminttypes.BlockEvidenceVote{
+							Type:             1,
+							Height:           uint64(3509),
+							Round:            uint64(0),
+							BlockHash:        "BE33BBB643E19D90DDE8FEA7334F43DF8479F3D41ACD91F3B89FF97742ED2C1A",
+							Timestamp:        voteBTime,
+							ValidatorAddress: "34C725CABA703269B3F1D1A907A84DE5FEE96469",
+							ValidatorIndex:   uint64(0),
+							Signature:        "LqTXlX4msVieutlPzQ9sQCa/ClvY4VYYDjfAZQBH7mT2cJjUS3r7hK8q4VUCktT0Regryrbb40Bts2SJaaYbBw==",
+						},
+					},
+				},
+			}
+			blockResults := tenderminttypes.BlockResults{
+				Height: anyBlockHeight,
+			}
+
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
+				Block:        &block,
+				BlockResults: &blockResults,
+			})
+			Expect(err).To(BeNil())
+			Expect(actualBlockData.Evidences).To(Equal([]chainindex.BlockEvidence{
+				{
+					BlockHeight:        anyBlockHeight,
+					Position:           uint32(1),
+					Type:               "tendermint/DuplicateVoteEvidence",
+					CouncilNodeAddress: "34C725CABA703269B3F1D1A907A84DE5FEE96469",
+					VoteA: chainindex.BlockEvidenceVote{
+						Type:           uint8(1),
+						Height:         uint64(3509),
+						Round:          uint64(0),
+						BlockHash:      "2C278F10E96EB892FF456D91EB48CFFA31679692102A6FC6F600DC51ABAAE989",
+						Timestamp:      voteATime,
+						ValidatorIndex: uint64(0),
+						Signature:      "tBWO1Wf9lf39WXpU2aJ1hzZF8nXl+D0izGt1FT1acA/nu/ezE2pVeQDgrU85b16ENTuYy375p2hdaXvyjESCBw==",
+					},
+					VoteB: chainindex.BlockEvidenceVote{
+						Type:           uint8(1),
+						Height:         uint64(3509),
+						Round:          uint64(0),
+						BlockHash:      "BE33BBB643E19D90DDE8FEA7334F43DF8479F3D41ACD91F3B89FF97742ED2C1A",
+						Timestamp:      voteBTime,
+						ValidatorIndex: uint64(0),
+						Signature:      "LqTXlX4msVieutlPzQ9sQCa/ClvY4VYYDjfAZQBH7mT2cJjUS3r7hK8q4VUCktT0Regryrbb40Bts2SJaaYbBw==",
+					},
+				},
+			}))
+		})
+
 		It("should parse transfer activity", func() {
 			anyBlockHeight := uint64(32168)
 			anyBlockTime, _ := time.Parse("2006-01-02T15:04:05.000000000Z", "2020-05-15T05:35:05.012038715Z")
@@ -261,23 +537,26 @@ var _ = Describe("Blockdataparser", func() {
 				ValidatorUpdates: nil,
 			}
 
-			actualBlockData := ParseBlockToBlockData(TendermintBlockData{
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
 				Block:        &block,
 				BlockResults: &blockResults,
 			})
+			Expect(err).To(BeNil())
 			Expect(*actualBlockData).To(Equal(usecase.BlockData{
 				Block: chainindex.Block{
-					Height:  anyBlockHeight,
-					Hash:    "964738311B5657215DC8857FD95FD270C561D1FF93967F9AFCBC398DD52D768A",
-					Time:    anyBlockTime,
-					AppHash: "0C2269A48DFE4BCA4CBE1008103D71F133B14C4B7D25C7F34441EAC9C83731E8",
+					Height:          anyBlockHeight,
+					Hash:            "964738311B5657215DC8857FD95FD270C561D1FF93967F9AFCBC398DD52D768A",
+					Time:            anyBlockTime,
+					AppHash:         "0C2269A48DFE4BCA4CBE1008103D71F133B14C4B7D25C7F34441EAC9C83731E8",
+					ProposerAddress: "7570B2D23A4C7B638BEFE02EB4FC7927BFDED6B7",
 				},
 				Activities: []chainindex.Activity{
 					{
-						BlockHeight: anyBlockHeight,
-						Type:        chainindex.ACTIVITY_TRANSFER,
-						MaybeTxID:   primptr.String("cfbf9084076e717d62ec1ddca6106faf898f7bfe14220f779e44dd54444505de"),
-						MaybeFee:    bignum.MustAtoi("469"),
+						BlockHeight:   anyBlockHeight,
+						Type:          chainindex.ACTIVITY_TRANSFER,
+						MaybeTxID:     primptr.String("cfbf9084076e717d62ec1ddca6106faf898f7bfe14220f779e44dd54444505de"),
+						MaybeTxStatus: primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
+						MaybeFee:      bignum.MustAtoi("469"),
 						MaybeTxInputs: []chainindex.TxInput{
 							{
 								TxId:  "633e424f27b524815ccacf3265eab8feaccc5abe75276d61e527c6a19ed9297a",
@@ -287,10 +566,11 @@ var _ = Describe("Blockdataparser", func() {
 						MaybeOutputCount: primptr.Uint32(2),
 					},
 					{
-						BlockHeight: anyBlockHeight,
-						Type:        chainindex.ACTIVITY_TRANSFER,
-						MaybeTxID:   primptr.String("c8f05f92cb553a2648678d0e3b86f3e5746883166d79c5104f05b84fb291199a"),
-						MaybeFee:    bignum.MustAtoi("469"),
+						BlockHeight:   anyBlockHeight,
+						Type:          chainindex.ACTIVITY_TRANSFER,
+						MaybeTxID:     primptr.String("c8f05f92cb553a2648678d0e3b86f3e5746883166d79c5104f05b84fb291199a"),
+						MaybeTxStatus: primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
+						MaybeFee:      bignum.MustAtoi("469"),
 						MaybeTxInputs: []chainindex.TxInput{
 							{
 								TxId:  "ad07eb3bacab6d90b9f7a5dc859706e739e37c990711e053a5ea8a7bb7123443",
@@ -355,10 +635,11 @@ var _ = Describe("Blockdataparser", func() {
 				ValidatorUpdates: nil,
 			}
 
-			actualBlockData := ParseBlockToBlockData(TendermintBlockData{
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
 				Block:        &block,
 				BlockResults: &blockResults,
 			})
+			Expect(err).To(BeNil())
 			Expect(*actualBlockData).To(Equal(usecase.BlockData{
 				Block: chainindex.Block{
 					Height: anyBlockHeight,
@@ -370,6 +651,7 @@ var _ = Describe("Blockdataparser", func() {
 						BlockHeight:                anyBlockHeight,
 						Type:                       chainindex.ACTIVITY_DEPOSIT,
 						MaybeTxID:                  primptr.String("188edd14d9b465c2c5d0e810922c9f29d529260238b8e67f41b4a7ea0b658270"),
+						MaybeTxStatus:              primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
 						MaybeFee:                   bignum.MustAtoi("299"),
 						MaybeStakingAccountAddress: primptr.String("0x4b75f275dde0a8c8e70fb84243adc97a3afb78f2"),
 						MaybeBonded:                bignum.MustAtoi("100000000"),
@@ -378,6 +660,59 @@ var _ = Describe("Blockdataparser", func() {
 			}))
 		})
 
+		It("should parse failed transaction with its transaction id, log and codespace", func() {
+			anyBlockHeight := uint64(29220)
+			anyBlockTime, _ := time.Parse("2006-01-02T15:04:05.000000000Z", "2020-05-14T09:13:45.866764762Z")
+			block := tenderminttypes.Block{
+				Height: anyBlockHeight,
+				Hash:   "77C918D6719285702468F87A017444B3766C79F77540211C1091E083C14BD4D1",
+				Time:   anyBlockTime,
+				Txs: []string{
+					"AAEEEE85Ht83dvrnlKV2rh2byE9Qs1vKzdAOIkUbnE8ja74AAABLdfJ13eCoyOcPuEJDrcl6Ovt48gBCAQAAAAAAAAAAAAAAAAAAAEnuiOdDBdlZ8SU6gNEB0r8zw/QUhtcFC1tdxFoxHO9HdUvDJRMHx6PE7oP22mmbN33PRmJ+kKtNHTCD12ERlQOSOxccDXPygTKqpdZ6Z6M4Q/E/eHhtSBdJHzbfSWY1lHloXJyNLyl9eQjrIDMFozyn+g7umf9NbhGNaBtXrHNsXGcYjt0U2bRlwsXQ6BCSLJ8p1SkmAji45n9BtKfqC2WCcA==",
+				},
+				Signatures: nil,
+			}
+			blockResults := tenderminttypes.BlockResults{
+				Height: anyBlockHeight,
+				// Failed transaction emits neither valid_txs nor staking_change event
+				TxsEvents: [][]tenderminttypes.BlockResultsEvent{
+					{},
+				},
+				TxsResults: []tenderminttypes.BlockResultsTxResult{
+					{
+						Code:      1,
+						Log:       "verification failed: insufficient balance",
+						Codespace: "",
+					},
+				},
+				BeginBlockEvents: nil,
+				ValidatorUpdates: nil,
+			}
+
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
+				Block:        &block,
+				BlockResults: &blockResults,
+			})
+			Expect(err).To(BeNil())
+			Expect(*actualBlockData).To(Equal(usecase.BlockData{
+				Block: chainindex.Block{
+					Height: anyBlockHeight,
+					Hash:   "77C918D6719285702468F87A017444B3766C79F77540211C1091E083C14BD4D1",
+					Time:   anyBlockTime,
+				},
+				Activities: []chainindex.Activity{
+					{
+						BlockHeight:      anyBlockHeight,
+						Type:             chainindex.ACTIVITY_DEPOSIT,
+						MaybeTxID:        primptr.String("188edd14d9b465c2c5d0e810922c9f29d529260238b8e67f41b4a7ea0b658270"),
+						MaybeTxStatus:    primptr.Uint8(chainindex.TRANSACTION_STATUS_FAILED),
+						MaybeTxLog:       primptr.String("verification failed: insufficient balance"),
+						MaybeTxCodespace: primptr.String(""),
+					},
+				},
+			}))
+		})
+
 		It("should parse unbond transaction", func() {
 			anyBlockHeight := uint64(32702)
 			anyBlockTime, _ := time.Parse("2006-01-02T15:04:05.000000000Z", "2020-05-15T09:17:42.981053198Z")
@@ -430,10 +765,11 @@ var _ = Describe("Blockdataparser", func() {
 				ValidatorUpdates: nil,
 			}
 
-			actualBlockData := ParseBlockToBlockData(TendermintBlockData{
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
 				Block:        &block,
 				BlockResults: &blockResults,
 			})
+			Expect(err).To(BeNil())
 			Expect(*actualBlockData).To(Equal(usecase.BlockData{
 				Block: chainindex.Block{
 					Height: anyBlockHeight,
@@ -445,6 +781,7 @@ var _ = Describe("Blockdataparser", func() {
 						BlockHeight:                anyBlockHeight,
 						Type:                       chainindex.ACTIVITY_UNBOND,
 						MaybeTxID:                  primptr.String("7f7b9c73de9a3be037a4bccc6dfc133f146493fac9e7d5e58ba59522d945d024"),
+						MaybeTxStatus:              primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
 						MaybeFee:                   bignum.MustAtoi("145"),
 						MaybeStakingAccountAddress: primptr.String("0x4b75f275dde0a8c8e70fb84243adc97a3afb78f2"),
 						MaybeBonded:                bignum.MustAtoi("-100000000145"),
@@ -507,10 +844,11 @@ var _ = Describe("Blockdataparser", func() {
 				ValidatorUpdates: nil,
 			}
 
-			actualBlockData := ParseBlockToBlockData(TendermintBlockData{
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
 				Block:        &block,
 				BlockResults: &blockResults,
 			})
+			Expect(err).To(BeNil())
 			Expect(*actualBlockData).To(Equal(usecase.BlockData{
 				Block: chainindex.Block{
 					Height: anyBlockHeight,
@@ -522,6 +860,7 @@ var _ = Describe("Blockdataparser", func() {
 						BlockHeight:                anyBlockHeight,
 						Type:                       chainindex.ACTIVITY_WITHDRAW,
 						MaybeTxID:                  primptr.String("327901627fe47077b788929e039b7717bcdedfe0e0569962ac073be37d406ce1"),
+						MaybeTxStatus:              primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
 						MaybeFee:                   bignum.MustAtoi("308"),
 						MaybeOutputCount:           primptr.Uint32(uint32(1)),
 						MaybeStakingAccountAddress: primptr.String("0x4b75f275dde0a8c8e70fb84243adc97a3afb78f2"),
@@ -583,10 +922,11 @@ var _ = Describe("Blockdataparser", func() {
 				ValidatorUpdates: nil,
 			}
 
-			actualBlockData := ParseBlockToBlockData(TendermintBlockData{
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
 				Block:        &block,
 				BlockResults: &blockResults,
 			})
+			Expect(err).To(BeNil())
 			Expect(*actualBlockData).To(Equal(usecase.BlockData{
 				Block: chainindex.Block{
 					Height: anyBlockHeight,
@@ -598,6 +938,7 @@ var _ = Describe("Blockdataparser", func() {
 						BlockHeight:                anyBlockHeight,
 						Type:                       chainindex.ACTIVITY_NODEJOIN,
 						MaybeTxID:                  primptr.String("fc082211218b0a55e24058243b8fcacf6f23b314f1e1dccd557bd25187826ab2"),
+						MaybeTxStatus:              primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
 						MaybeFee:                   bignum.MustAtoi("0"),
 						MaybeStakingAccountAddress: primptr.String("0xb328a39002ede64c33bb60f1dc43f5df9eb47043"),
 						MaybeCouncilNodeMeta: &chainindex.CouncilNode{
@@ -661,10 +1002,11 @@ var _ = Describe("Blockdataparser", func() {
 				ValidatorUpdates: nil,
 			}
 
-			actualBlockData := ParseBlockToBlockData(TendermintBlockData{
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
 				Block:        &block,
 				BlockResults: &blockResults,
 			})
+			Expect(err).To(BeNil())
 			Expect(*actualBlockData).To(Equal(usecase.BlockData{
 				Block: chainindex.Block{
 					Height: anyBlockHeight,
@@ -676,6 +1018,7 @@ var _ = Describe("Blockdataparser", func() {
 						BlockHeight:                anyBlockHeight,
 						Type:                       chainindex.ACTIVITY_UNJAIL,
 						MaybeTxID:                  primptr.String("71b73ed5aa27d39549bc9c57821f41716e0139d044d81de340cb33497d663caf"),
+						MaybeTxStatus:              primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
 						MaybeFee:                   bignum.MustAtoi("0"),
 						MaybeStakingAccountAddress: primptr.String("0xb328a39002ede64c33bb60f1dc43f5df9eb47043"),
 					},
@@ -761,10 +1104,11 @@ var _ = Describe("Blockdataparser", func() {
 				ValidatorUpdates: nil,
 			}
 
-			actualBlockData := ParseBlockToBlockData(TendermintBlockData{
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
 				Block:        &block,
 				BlockResults: &blockResults,
 			})
+			Expect(err).To(BeNil())
 			Expect(*actualBlockData).To(Equal(usecase.BlockData{
 				Block: chainindex.Block{
 					Height: anyBlockHeight,
@@ -776,6 +1120,7 @@ var _ = Describe("Blockdataparser", func() {
 						BlockHeight:                anyBlockHeight,
 						Type:                       chainindex.ACTIVITY_REWARD,
 						MaybeEventPosition:         primptr.Uint32(uint32(0)),
+						MaybeEventPhase:            primptr.Uint8(chainindex.EVENT_PHASE_BEGIN_BLOCK),
 						MaybeStakingAccountAddress: primptr.String("0x6dbd5b8fe0dad494465aa7574defba711c184102"),
 						MaybeBonded:                bignum.MustAtoi("4858990203333"),
 					},
@@ -783,6 +1128,7 @@ var _ = Describe("Blockdataparser", func() {
 						BlockHeight:                anyBlockHeight,
 						Type:                       chainindex.ACTIVITY_REWARD,
 						MaybeEventPosition:         primptr.Uint32(uint32(1)),
+						MaybeEventPhase:            primptr.Uint8(chainindex.EVENT_PHASE_BEGIN_BLOCK),
 						MaybeStakingAccountAddress: primptr.String("0x6fc1e3124a7ed07f3710378b68f7046c7300179d"),
 						MaybeBonded:                bignum.MustAtoi("4858990203333"),
 					},
@@ -790,6 +1136,7 @@ var _ = Describe("Blockdataparser", func() {
 						BlockHeight:                anyBlockHeight,
 						Type:                       chainindex.ACTIVITY_REWARD,
 						MaybeEventPosition:         primptr.Uint32(uint32(2)),
+						MaybeEventPhase:            primptr.Uint8(chainindex.EVENT_PHASE_BEGIN_BLOCK),
 						MaybeStakingAccountAddress: primptr.String("0xb8c6886da09e12db8aebfc8108c67ce2ba086ac6"),
 						MaybeBonded:                bignum.MustAtoi("4858990203333"),
 					},
@@ -801,6 +1148,55 @@ var _ = Describe("Blockdataparser", func() {
 			}))
 		})
 
+		It("should sum up minted amounts of reward events at begin and end block", func() {
+			anyBlockHeight := uint64(2)
+			anyBlockTime, _ := time.Parse("2006-01-02T15:04:05.000000000Z", "2020-05-07T11:33:44.402712005Z")
+			block := tenderminttypes.Block{
+				Height:     anyBlockHeight,
+				Hash:       "31378879152B877926D756B9063F7F5C188AD49B0F9B65BC316E4EF501832986",
+				Time:       anyBlockTime,
+				Txs:        nil,
+				Signatures: nil,
+			}
+			blockResults := tenderminttypes.BlockResults{
+				Height:    anyBlockHeight,
+				TxsEvents: nil,
+				BeginBlockEvents: []tenderminttypes.BlockResultsEvent{
+					{
+						Type: "reward",
+						Attributes: []tenderminttypes.BlockResultsEventAttribute{
+							{
+								Key:   "bWludGVk",
+								Value: "IjE0NTc2OTcwNjEwMDAwIg==",
+							},
+						},
+					},
+				},
+				EndBlockEvents: []tenderminttypes.BlockResultsEvent{
+					{
+						Type: "reward",
+						Attributes: []tenderminttypes.BlockResultsEventAttribute{
+							{
+								Key:   "bWludGVk",
+								Value: "IjEwMDAi",
+							},
+						},
+					},
+				},
+				ValidatorUpdates: nil,
+			}
+
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
+				Block:        &block,
+				BlockResults: &blockResults,
+			})
+			Expect(err).To(BeNil())
+			Expect(actualBlockData.Reward).To(Equal(&chainindex.BlockReward{
+				BlockHeight: anyBlockHeight,
+				Minted:      bignum.MustAtoi("14576970611000"),
+			}))
+		})
+
 		It("should parse slash and jail event by byzantine fault", func() {
 			anyBlockHeight := uint64(3510)
 			anyBlockTime, _ := time.Parse("2006-01-02T15:04:05.000000000Z", "2020-05-07T10:00:25.582998694Z")
@@ -861,10 +1257,11 @@ var _ = Describe("Blockdataparser", func() {
 				ValidatorUpdates: nil,
 			}
 
-			actualBlockData := ParseBlockToBlockData(TendermintBlockData{
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
 				Block:        &block,
 				BlockResults: &blockResults,
 			})
+			Expect(err).To(BeNil())
 			Expect(*actualBlockData).To(Equal(usecase.BlockData{
 				Block: chainindex.Block{
 					Height: anyBlockHeight,
@@ -876,6 +1273,7 @@ var _ = Describe("Blockdataparser", func() {
 						BlockHeight:                anyBlockHeight,
 						Type:                       chainindex.ACTIVITY_SLASH,
 						MaybeEventPosition:         primptr.Uint32(uint32(0)),
+						MaybeEventPhase:            primptr.Uint8(chainindex.EVENT_PHASE_BEGIN_BLOCK),
 						MaybeStakingAccountAddress: primptr.String("0xb328a39002ede64c33bb60f1dc43f5df9eb47043"),
 						MaybeBonded:                bignum.MustAtoi("-1094367912122319"),
 						MaybeUnbonded:              bignum.MustAtoi("-0"),
@@ -885,6 +1283,7 @@ var _ = Describe("Blockdataparser", func() {
 						BlockHeight:                anyBlockHeight,
 						Type:                       chainindex.ACTIVITY_JAIL,
 						MaybeEventPosition:         primptr.Uint32(uint32(1)),
+						MaybeEventPhase:            primptr.Uint8(chainindex.EVENT_PHASE_BEGIN_BLOCK),
 						MaybeStakingAccountAddress: primptr.String("0xb328a39002ede64c33bb60f1dc43f5df9eb47043"),
 						MaybePunishmentKind:        primptr.Uint8(chainindex.PUNISHMENT_KIND_BYZANTINE_FAULT),
 						MaybeJailedUntil:           primptr.Time(time.Unix(1588851025, 0).UTC()),
@@ -893,6 +1292,95 @@ var _ = Describe("Blockdataparser", func() {
 			}))
 		})
 
+		It("should parse end block events with event positions counted separately from begin block events", func() {
+			anyBlockHeight := uint64(450)
+			anyBlockTime, _ := time.Parse("2006-01-02T15:04:05.000000000Z", "2020-05-05T19:57:10.527286067Z")
+			block := tenderminttypes.Block{
+				Height:     anyBlockHeight,
+				Hash:       "2CD22EA622D190B9ABCAB797E2F60F6F4FCFC19CC0A67642E5E7856CEAD78163",
+				Time:       anyBlockTime,
+				Txs:        nil,
+				Signatures: nil,
+			}
+			blockResults := tenderminttypes.BlockResults{
+				Height:    anyBlockHeight,
+				TxsEvents: nil,
+				BeginBlockEvents: []tenderminttypes.BlockResultsEvent{
+					{
+						Type: "staking_change",
+						Attributes: []tenderminttypes.BlockResultsEventAttribute{
+							{
+								Key:   "c3Rha2luZ19hZGRyZXNz",
+								Value: "MHhiMzI4YTM5MDAyZWRlNjRjMzNiYjYwZjFkYzQzZjVkZjllYjQ3MDQz",
+							},
+							{
+								Key:   "c3Rha2luZ19vcHR5cGU=",
+								Value: "amFpbA==",
+							},
+							{
+								Key:   "c3Rha2luZ19kaWZm",
+								Value: "W3sia2V5IjoiSmFpbGVkVW50aWwiLCJ2YWx1ZSI6MTU4ODg1MTAyNX1d",
+							},
+							{
+								Key:   "c3Rha2luZ19vcHJlYXNvbg==",
+								Value: "Qnl6YW50aW5lRmF1bHQ=",
+							},
+						},
+					},
+				},
+				EndBlockEvents: []tenderminttypes.BlockResultsEvent{
+					{
+						Type: "staking_change",
+						Attributes: []tenderminttypes.BlockResultsEventAttribute{
+							{
+								Key:   "c3Rha2luZ19hZGRyZXNz",
+								Value: "MHhiMzI4YTM5MDAyZWRlNjRjMzNiYjYwZjFkYzQzZjVkZjllYjQ3MDQz",
+							},
+							{
+								Key:   "c3Rha2luZ19vcHR5cGU=",
+								Value: "amFpbA==",
+							},
+							{
+								Key:   "c3Rha2luZ19kaWZm",
+								Value: "W3sia2V5IjoiSmFpbGVkVW50aWwiLCJ2YWx1ZSI6MTU4ODg1MTAyNX1d",
+							},
+							{
+								Key:   "c3Rha2luZ19vcHJlYXNvbg==",
+								Value: "Qnl6YW50aW5lRmF1bHQ=",
+							},
+						},
+					},
+				},
+				ValidatorUpdates: nil,
+			}
+
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
+				Block:        &block,
+				BlockResults: &blockResults,
+			})
+			Expect(err).To(BeNil())
+			Expect(actualBlockData.Activities).To(Equal([]chainindex.Activity{
+				{
+					BlockHeight:                anyBlockHeight,
+					Type:                       chainindex.ACTIVITY_JAIL,
+					MaybeEventPosition:         primptr.Uint32(uint32(0)),
+					MaybeEventPhase:            primptr.Uint8(chainindex.EVENT_PHASE_BEGIN_BLOCK),
+					MaybeStakingAccountAddress: primptr.String("0xb328a39002ede64c33bb60f1dc43f5df9eb47043"),
+					MaybePunishmentKind:        primptr.Uint8(chainindex.PUNISHMENT_KIND_BYZANTINE_FAULT),
+					MaybeJailedUntil:           primptr.Time(time.Unix(1588851025, 0).UTC()),
+				},
+				{
+					BlockHeight:                anyBlockHeight,
+					Type:                       chainindex.ACTIVITY_JAIL,
+					MaybeEventPosition:         primptr.Uint32(uint32(0)),
+					MaybeEventPhase:            primptr.Uint8(chainindex.EVENT_PHASE_END_BLOCK),
+					MaybeStakingAccountAddress: primptr.String("0xb328a39002ede64c33bb60f1dc43f5df9eb47043"),
+					MaybePunishmentKind:        primptr.Uint8(chainindex.PUNISHMENT_KIND_BYZANTINE_FAULT),
+					MaybeJailedUntil:           primptr.Time(time.Unix(1588851025, 0).UTC()),
+				},
+			}))
+		})
+
 		It("should parse nonlive slash and council node kicked", func() {
 			anyBlockHeight := uint64(600)
 			anyBlockTime, _ := time.Parse("2006-01-02T15:04:05.000000000Z", "2020-05-05T19:57:10.527286067Z")
@@ -958,10 +1446,11 @@ var _ = Describe("Blockdataparser", func() {
 				},
 			}
 
-			actualBlockData := ParseBlockToBlockData(TendermintBlockData{
+			actualBlockData, err := ParseBlockToBlockData(TendermintBlockData{
 				Block:        &block,
 				BlockResults: &blockResults,
 			})
+			Expect(err).To(BeNil())
 			Expect(*actualBlockData).To(Equal(usecase.BlockData{
 				Block: chainindex.Block{
 					Height:  anyBlockHeight,
@@ -974,6 +1463,7 @@ var _ = Describe("Blockdataparser", func() {
 						BlockHeight:                anyBlockHeight,
 						Type:                       chainindex.ACTIVITY_SLASH,
 						MaybeEventPosition:         primptr.Uint32(uint32(0)),
+						MaybeEventPhase:            primptr.Uint8(chainindex.EVENT_PHASE_BEGIN_BLOCK),
 						MaybeStakingAccountAddress: primptr.String("0xb328a39002ede64c33bb60f1dc43f5df9eb47043"),
 						MaybeBonded:                bignum.MustAtoi("-600000000000000"),
 						MaybeUnbonded:              bignum.MustAtoi("-0"),
@@ -989,18 +1479,49 @@ var _ = Describe("Blockdataparser", func() {
 						Address: "7570B2D23A4C7B638BEFE02EB4FC7927BFDED6B7",
 						Type:    chainindex.COUNCIL_NODE_UPDATE_TYPE_LEFT,
 					},
+					{
+						Address:    "D527DAECDE0501CF2E785A8DC0D9F4A64760F0BB",
+						Type:       chainindex.COUNCIL_NODE_UPDATE_TYPE_POWER_CHANGED,
+						MaybePower: primptr.Uint64(uint64(60000000)),
+					},
 				},
 			}))
 		})
 	})
+
+	Describe("ParsePendingTransaction", func() {
+		It("should return pending transaction decoded from NodeJoin transaction", func() {
+			pendingTx, err := ParsePendingTransaction(
+				"AQIAAAAAAAAAAACzKKOQAu3mTDO7YPHcQ/XfnrRwQwBCAAAAAAAAAAAAZGNhbmFkYWNlbnRyYWxfdmFsaWRhdG9yXzIAAK14bu8YamAbSX7VSysbyjdF56cjs8RZ1BHwIQ9XRBf/FEZJWE1FAAEbC33EXRoay1jVBgObgohxS0Q3NFDj/IprjkML6Vj/+nqbrwYBykRAsVxPFXKB6E+qa6II57Ngb3iStwu4Awfx",
+			)
+			Expect(err).To(BeNil())
+			Expect(pendingTx.TxID).To(MatchRegexp("^[0-9a-f]{64}$"))
+			Expect(*pendingTx).To(Equal(usecase.PendingTransaction{
+				TxID:                       pendingTx.TxID,
+				Type:                       chainindex.ACTIVITY_NODEJOIN,
+				MaybeStakingAccountAddress: primptr.String("0xb328a39002ede64c33bb60f1dc43f5df9eb47043"),
+			}))
+		})
+	})
 })
 
 func sampleGenesis() tenderminttypes.Genesis {
 	genesisTime, _ := time.Parse("2006-01-02T15:04:05.000000Z", "2020-05-01T12:09:01.568951Z")
 	return tenderminttypes.Genesis{
-		GenesisTime: genesisTime,
-		ChainID:     "testnet-thaler-crypto-com-chain-42",
-		AppHash:     "F62DDB49D7EB8ED0883C735A0FB7DE7F2A3FA322FCD2AA832F452A62B38607D5",
+		GenesisTime:     genesisTime,
+		ChainID:         "testnet-thaler-crypto-com-chain-42",
+		ConsensusParams: sampleGenesisConsensusParams(),
+		Validators: []tenderminttypes.GenesisValidator{
+			{
+				Address: "FA7B721B5704DF98EF3ECD3796DDEF6AA2A80257",
+				Power:   "60000000",
+			},
+			{
+				Address: "7570B2D23A4C7B638BEFE02EB4FC7927BFDED6B7",
+				Power:   "60000000",
+			},
+		},
+		AppHash: "F62DDB49D7EB8ED0883C735A0FB7DE7F2A3FA322FCD2AA832F452A62B38607D5",
 		AppState: tenderminttypes.GenesisAppState{
 			CouncilNodes: []tenderminttypes.GenesisCouncilNode{
 				{
@@ -1080,10 +1601,43 @@ func sampleGenesis() tenderminttypes.Genesis {
 					Unbonded:              nil,
 				},
 			},
+			NetworkParams: sampleGenesisNetworkParams(),
 		},
 	}
 }
 
+func sampleGenesisConsensusParams() tenderminttypes.GenesisConsensusParams {
+	var consensusParams tenderminttypes.GenesisConsensusParams
+	consensusParams.Block.MaxBytes = "22020096"
+	consensusParams.Block.MaxGas = "-1"
+	consensusParams.Block.TimeIotaMs = "1000"
+	consensusParams.Evidence.MaxAgeNumBlocks = "200"
+	consensusParams.Evidence.MaxAgeDuration = "5400000000000"
+	consensusParams.Validator.PubKeyTypes = []string{"ed25519"}
+
+	return consensusParams
+}
+
+func sampleGenesisNetworkParams() tenderminttypes.GenesisNetworkParams {
+	var networkParams tenderminttypes.GenesisNetworkParams
+	networkParams.InitialFeePolicy.Coefficient = 1250
+	networkParams.InitialFeePolicy.Constant = 1100
+	networkParams.JailingConfig.BlockSigningWindow = 720
+	networkParams.JailingConfig.MissedBlockThreshold = 360
+	networkParams.MaxValidators = 50
+	networkParams.RequiredCouncilNodeStake = "5000000000000000"
+	networkParams.RewardsConfig.MonetaryExpansionCap = "2000000000000000000"
+	networkParams.RewardsConfig.MonetaryExpansionDecay = 999860
+	networkParams.RewardsConfig.MonetaryExpansionR0 = 350
+	networkParams.RewardsConfig.MonetaryExpansionTau = 999999999999999999
+	networkParams.RewardsConfig.RewardPeriodSeconds = 86400
+	networkParams.SlashingConfig.ByzantineSlashPercent = "0.200"
+	networkParams.SlashingConfig.LivenessSlashPercent = "0.100"
+	networkParams.UnbondingPeriod = 5400
+
+	return networkParams
+}
+
 func sampleGenesisBlock() tenderminttypes.Block {
 	blockTime, _ := time.Parse("2006-01-02T15:04:05.000000Z", "2020-05-01T12:09:01.568951Z")
 	return tenderminttypes.Block{
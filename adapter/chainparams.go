@@ -0,0 +1,116 @@
+package adapter
+
+import (
+	"github.com/crypto-com/chainindex"
+	"github.com/crypto-com/chainindex/internal/bignum"
+)
+
+type RDbNetworkParamsRow struct {
+	InitialFeePolicy struct {
+		Coefficient uint64 `json:"coefficient"`
+		Constant    uint64 `json:"constant"`
+	} `json:"initial_fee_policy"`
+	JailingConfig struct {
+		BlockSigningWindow   uint64 `json:"block_signing_window"`
+		MissedBlockThreshold uint64 `json:"missed_block_threshold"`
+	} `json:"jailing_config"`
+	MaxValidators            uint64 `json:"max_validators"`
+	RequiredCouncilNodeStake string `json:"required_council_node_stake"`
+	RewardsConfig            struct {
+		MonetaryExpansionCap   string `json:"monetary_expansion_cap"`
+		MonetaryExpansionDecay uint64 `json:"monetary_expansion_decay"`
+		MonetaryExpansionR0    uint64 `json:"monetary_expansion_r0"`
+		MonetaryExpansionTau   uint64 `json:"monetary_expansion_tau"`
+		RewardPeriodSeconds    uint64 `json:"reward_period_seconds"`
+	} `json:"rewards_config"`
+	SlashingConfig struct {
+		ByzantineSlashPercent string `json:"byzantine_slash_percent"`
+		LivenessSlashPercent  string `json:"liveness_slash_percent"`
+	} `json:"slashing_config"`
+	UnbondingPeriod uint64 `json:"unbonding_period"`
+}
+
+type RDbConsensusParamsRow struct {
+	Block struct {
+		MaxBytes   int64 `json:"max_bytes"`
+		MaxGas     int64 `json:"max_gas"`
+		TimeIotaMs int64 `json:"time_iota_ms"`
+	} `json:"block"`
+	Evidence struct {
+		MaxAgeNumBlocks int64 `json:"max_age_num_blocks"`
+		MaxAgeDuration  int64 `json:"max_age_duration"`
+	} `json:"evidence"`
+	Validator struct {
+		PubKeyTypes []string `json:"pub_key_types"`
+	} `json:"validator"`
+}
+
+func NetworkParamsToRDbNetworkParamsRow(params *chainindex.NetworkParams) *RDbNetworkParamsRow {
+	var row RDbNetworkParamsRow
+	row.InitialFeePolicy.Coefficient = params.InitialFeePolicy.Coefficient
+	row.InitialFeePolicy.Constant = params.InitialFeePolicy.Constant
+	row.JailingConfig.BlockSigningWindow = params.JailingConfig.BlockSigningWindow
+	row.JailingConfig.MissedBlockThreshold = params.JailingConfig.MissedBlockThreshold
+	row.MaxValidators = params.MaxValidators
+	row.RequiredCouncilNodeStake = params.RequiredCouncilNodeStake.String()
+	row.RewardsConfig.MonetaryExpansionCap = params.RewardsConfig.MonetaryExpansionCap.String()
+	row.RewardsConfig.MonetaryExpansionDecay = params.RewardsConfig.MonetaryExpansionDecay
+	row.RewardsConfig.MonetaryExpansionR0 = params.RewardsConfig.MonetaryExpansionR0
+	row.RewardsConfig.MonetaryExpansionTau = params.RewardsConfig.MonetaryExpansionTau
+	row.RewardsConfig.RewardPeriodSeconds = params.RewardsConfig.RewardPeriodSeconds
+	row.SlashingConfig.ByzantineSlashPercent = params.SlashingConfig.ByzantineSlashPercent
+	row.SlashingConfig.LivenessSlashPercent = params.SlashingConfig.LivenessSlashPercent
+	row.UnbondingPeriod = params.UnbondingPeriod
+
+	return &row
+}
+
+func RDbNetworkParamsRowToNetworkParams(row *RDbNetworkParamsRow) *chainindex.NetworkParams {
+	return &chainindex.NetworkParams{
+		InitialFeePolicy: chainindex.InitialFeePolicy{
+			Coefficient: row.InitialFeePolicy.Coefficient,
+			Constant:    row.InitialFeePolicy.Constant,
+		},
+		JailingConfig: chainindex.JailingConfig{
+			BlockSigningWindow:   row.JailingConfig.BlockSigningWindow,
+			MissedBlockThreshold: row.JailingConfig.MissedBlockThreshold,
+		},
+		MaxValidators:            row.MaxValidators,
+		RequiredCouncilNodeStake: bignum.MustAtoi(row.RequiredCouncilNodeStake),
+		RewardsConfig: chainindex.RewardsConfig{
+			MonetaryExpansionCap:   bignum.MustAtoi(row.RewardsConfig.MonetaryExpansionCap),
+			MonetaryExpansionDecay: row.RewardsConfig.MonetaryExpansionDecay,
+			MonetaryExpansionR0:    row.RewardsConfig.MonetaryExpansionR0,
+			MonetaryExpansionTau:   row.RewardsConfig.MonetaryExpansionTau,
+			RewardPeriodSeconds:    row.RewardsConfig.RewardPeriodSeconds,
+		},
+		SlashingConfig: chainindex.SlashingConfig{
+			ByzantineSlashPercent: row.SlashingConfig.ByzantineSlashPercent,
+			LivenessSlashPercent:  row.SlashingConfig.LivenessSlashPercent,
+		},
+		UnbondingPeriod: row.UnbondingPeriod,
+	}
+}
+
+func ConsensusParamsToRDbConsensusParamsRow(params *chainindex.ConsensusParams) *RDbConsensusParamsRow {
+	var row RDbConsensusParamsRow
+	row.Block.MaxBytes = params.BlockMaxBytes
+	row.Block.MaxGas = params.BlockMaxGas
+	row.Block.TimeIotaMs = params.BlockTimeIotaMs
+	row.Evidence.MaxAgeNumBlocks = params.EvidenceMaxAgeNumBlocks
+	row.Evidence.MaxAgeDuration = params.EvidenceMaxAgeDuration
+	row.Validator.PubKeyTypes = params.ValidatorPubKeyTypes
+
+	return &row
+}
+
+func RDbConsensusParamsRowToConsensusParams(row *RDbConsensusParamsRow) *chainindex.ConsensusParams {
+	return &chainindex.ConsensusParams{
+		BlockMaxBytes:           row.Block.MaxBytes,
+		BlockMaxGas:             row.Block.MaxGas,
+		BlockTimeIotaMs:         row.Block.TimeIotaMs,
+		EvidenceMaxAgeNumBlocks: row.Evidence.MaxAgeNumBlocks,
+		EvidenceMaxAgeDuration:  row.Evidence.MaxAgeDuration,
+		ValidatorPubKeyTypes:    row.Validator.PubKeyTypes,
+	}
+}
@@ -1,10 +1,58 @@
 package adapter
 
 import (
-	"github.com/crypto-com/chainindex"
+	sq "github.com/Masterminds/squirrel"
 	"github.com/luci/go-render/render"
+
+	"github.com/crypto-com/chainindex"
 )
 
+// Validator updates returned at the end of a block take effect from the block
+// after the next one
+const VALIDATOR_UPDATE_DELAY = uint64(2)
+
+// SelectValidatorCouncilNodes selects the columns of the council nodes in the
+// validator set which is expected to sign the block at the height, joined with
+// their latest power change in effect as pc. Joining, leaving and power changes
+// take effect VALIDATOR_UPDATE_DELAY blocks after the block they are recorded
+// at, except for the genesis validators which are in effect from the genesis
+// block. Council nodes without any power change in effect have unknown power
+func SelectValidatorCouncilNodes(
+	stmtBuilder sq.StatementBuilderType,
+	blockHeight uint64,
+	columns ...string,
+) sq.SelectBuilder {
+	lastEffectiveHeight := LastEffectiveValidatorUpdateHeight(blockHeight)
+
+	return stmtBuilder.Select(
+		columns...,
+	).From(
+		"council_nodes c",
+	).LeftJoin(
+		"LATERAL ("+
+			"SELECT power, block_height FROM council_node_power_changes "+
+			"WHERE council_node_id = c.id AND (block_height = ? OR block_height <= ?) "+
+			"ORDER BY block_height DESC LIMIT 1"+
+			") pc ON TRUE", GENESIS_BLOCK_HEIGHT, lastEffectiveHeight,
+	).Where(
+		"(c.created_at_block_height = ? OR c.created_at_block_height <= ?)", GENESIS_BLOCK_HEIGHT, lastEffectiveHeight,
+	).Where(
+		"(c.last_left_at_block_height IS NULL OR c.last_left_at_block_height > ?)", lastEffectiveHeight,
+	).Where(
+		"(pc.power IS NULL OR pc.power > 0)",
+	)
+}
+
+// LastEffectiveValidatorUpdateHeight returns the height of the last block
+// whose validator updates are in effect at the block height. It is 0 when
+// none of them is in effect yet
+func LastEffectiveValidatorUpdateHeight(blockHeight uint64) uint64 {
+	if blockHeight <= VALIDATOR_UPDATE_DELAY {
+		return uint64(0)
+	}
+	return blockHeight - VALIDATOR_UPDATE_DELAY
+}
+
 type RDbCouncilNodeRow struct {
 	ID                         *uint64 `json:"id"`
 	Name                       string  `json:"name"`
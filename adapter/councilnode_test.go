@@ -0,0 +1,55 @@
+package adapter_test
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chainindex/adapter"
+)
+
+var _ = Describe("CouncilNode", func() {
+	Describe("LastEffectiveValidatorUpdateHeight", func() {
+		It("should take a validator update into effect from the block after the next one", func() {
+			powerChangeHeight := uint64(100)
+
+			Expect(adapter.LastEffectiveValidatorUpdateHeight(powerChangeHeight)).To(BeNumerically("<", powerChangeHeight))
+			Expect(adapter.LastEffectiveValidatorUpdateHeight(powerChangeHeight + 1)).To(BeNumerically("<", powerChangeHeight))
+			Expect(adapter.LastEffectiveValidatorUpdateHeight(powerChangeHeight + 2)).To(Equal(powerChangeHeight))
+			Expect(adapter.LastEffectiveValidatorUpdateHeight(powerChangeHeight + 3)).To(BeNumerically(">", powerChangeHeight))
+		})
+
+		It("should return 0 when no validator update is in effect yet", func() {
+			Expect(adapter.LastEffectiveValidatorUpdateHeight(uint64(1))).To(Equal(uint64(0)))
+			Expect(adapter.LastEffectiveValidatorUpdateHeight(uint64(2))).To(Equal(uint64(0)))
+			Expect(adapter.LastEffectiveValidatorUpdateHeight(uint64(3))).To(Equal(uint64(1)))
+		})
+	})
+
+	Describe("SelectValidatorCouncilNodes", func() {
+		It("should select council nodes by the genesis validators and the joins, leaves and power changes in effect", func() {
+			sql, sqlArgs, err := adapter.SelectValidatorCouncilNodes(
+				sq.StatementBuilder, uint64(102), "c.id", "pc.power",
+			).ToSql()
+
+			Expect(err).To(BeNil())
+			Expect(sql).To(Equal(
+				"SELECT c.id, pc.power FROM council_nodes c " +
+					"LEFT JOIN LATERAL (SELECT power, block_height FROM council_node_power_changes " +
+					"WHERE council_node_id = c.id AND (block_height = ? OR block_height <= ?) " +
+					"ORDER BY block_height DESC LIMIT 1) pc ON TRUE " +
+					"WHERE (c.created_at_block_height = ? OR c.created_at_block_height <= ?) " +
+					"AND (c.last_left_at_block_height IS NULL OR c.last_left_at_block_height > ?) " +
+					"AND (pc.power IS NULL OR pc.power > 0)",
+			))
+			// Validator updates at height 100 are the last ones in effect at height 102
+			Expect(sqlArgs).To(Equal([]interface{}{
+				adapter.GENESIS_BLOCK_HEIGHT,
+				uint64(100),
+				adapter.GENESIS_BLOCK_HEIGHT,
+				uint64(100),
+				uint64(100),
+			}))
+		})
+	})
+})
@@ -4,4 +4,33 @@ import "errors"
 
 var (
 	ErrNotFound = errors.New("not found")
+
+	// Errors caused by the block itself. Processing the same block again
+	// always fails with the same error
+	ErrMalformedBlock        = errors.New("malformed block")
+	ErrInconsistentBlockData = errors.New("block data inconsistent with stored projections")
+
+	// Errors caused by the block belonging to a different chain from the
+	// stored one, e.g. a node of another network or a forked chain
+	ErrBlockConflict       = errors.New("block conflicts with the stored block at the same height")
+	ErrParentBlockMismatch = errors.New("block does not follow the stored previous block")
+	ErrChainIDMismatch     = errors.New("block chain id differs from the chain id of the index")
+
+	ErrMalformedStateSnapshot = errors.New("malformed state snapshot")
+	ErrAlreadyIndexed         = errors.New("index already has stored blocks")
+	ErrIndexBootstrapped      = errors.New("index is bootstrapped from a state snapshot")
 )
+
+// IsBlockDataError returns true when the error is caused by the block being
+// processed rather than by an unavailable dependency
+func IsBlockDataError(err error) bool {
+	return errors.Is(err, ErrMalformedBlock) || errors.Is(err, ErrInconsistentBlockData)
+}
+
+// IsChainMismatchError returns true when the block does not belong to the
+// chain the index is following. Storing it would corrupt the index
+func IsChainMismatchError(err error) bool {
+	return errors.Is(err, ErrBlockConflict) ||
+		errors.Is(err, ErrParentBlockMismatch) ||
+		errors.Is(err, ErrChainIDMismatch)
+}
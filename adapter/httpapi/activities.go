@@ -16,18 +16,28 @@ import (
 type ActivitiesHandler struct {
 	logger usecase.Logger
 
-	routePath    RoutePath
-	activityView viewrepo.ActivityViewRepo
+	routePath              RoutePath
+	activityView           viewrepo.ActivityViewRepo
+	pendingTransactionView viewrepo.PendingTransactionViewRepo
+	indexBootstrapView     viewrepo.IndexBootstrapViewRepo
 }
 
-func NewActivitiesHandler(logger usecase.Logger, routePath RoutePath, activityView viewrepo.ActivityViewRepo) *ActivitiesHandler {
+func NewActivitiesHandler(
+	logger usecase.Logger,
+	routePath RoutePath,
+	activityView viewrepo.ActivityViewRepo,
+	pendingTransactionView viewrepo.PendingTransactionViewRepo,
+	indexBootstrapView viewrepo.IndexBootstrapViewRepo,
+) *ActivitiesHandler {
 	return &ActivitiesHandler{
 		logger: logger.WithFields(usecase.LogFields{
 			"module": "ActivitiesHandler",
 		}),
 
-		routePath:    routePath,
-		activityView: activityView,
+		routePath:              routePath,
+		activityView:           activityView,
+		pendingTransactionView: pendingTransactionView,
+		indexBootstrapView:     indexBootstrapView,
 	}
 }
 
@@ -62,6 +72,16 @@ func (handler *ActivitiesHandler) ListTransactions(resp http.ResponseWriter, req
 		filter.MaybeStakingAccountAddress = &filterStakingAccountAddress
 	}
 
+	filterStatus := req.URL.Query().Get("status")
+	if filterStatus != "" {
+		if !adapter.IsValidTransactionStatus(filterStatus) {
+			BadRequest(resp, fmt.Errorf("invalid transaction status filter: %s", filterStatus))
+			return
+		}
+		status := adapter.TransactionStatusFromString(filterStatus)
+		filter.MaybeStatus = &status
+	}
+
 	blockTransactions, paginationResult, err := handler.activityView.ListTransactions(filter, pagination)
 	if err != nil {
 		handler.logger.Errorf("error listing transactions: %v", err)
@@ -69,7 +89,14 @@ func (handler *ActivitiesHandler) ListTransactions(resp http.ResponseWriter, req
 		return
 	}
 
-	SuccessWithPagination(resp, blockTransactions, paginationResult)
+	maybeHistoryAvailableFromHeight, err := findMaybeHistoryAvailableFromHeight(handler.indexBootstrapView)
+	if err != nil {
+		handler.logger.Errorf("error listing transactions: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	SuccessWithPaginationAndHistory(resp, blockTransactions, paginationResult, maybeHistoryAvailableFromHeight)
 }
 
 func (handler *ActivitiesHandler) FindTransactionByTxId(resp http.ResponseWriter, req *http.Request) {
@@ -85,7 +112,7 @@ func (handler *ActivitiesHandler) FindTransactionByTxId(resp http.ResponseWriter
 	block, err := handler.activityView.FindTransactionByTxId(txid)
 	if err != nil {
 		if err == adapter.ErrNotFound {
-			NotFound(resp)
+			handler.findPendingTransactionByTxId(resp, txid)
 			return
 		}
 		handler.logger.Errorf("error finding transaction by txid: %v", err)
@@ -96,6 +123,42 @@ func (handler *ActivitiesHandler) FindTransactionByTxId(resp http.ResponseWriter
 	Success(resp, block)
 }
 
+// findPendingTransactionByTxId responds with the transaction in pending status
+// when it is observed in the mempool but not yet indexed from a block
+func (handler *ActivitiesHandler) findPendingTransactionByTxId(resp http.ResponseWriter, txid string) {
+	pendingTransaction, err := handler.pendingTransactionView.FindPendingTransactionByTxId(txid)
+	if err != nil {
+		if err == adapter.ErrNotFound {
+			NotFound(resp)
+			return
+		}
+		handler.logger.Errorf("error finding pending transaction by txid: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	Success(resp, pendingTransaction)
+}
+
+func (handler *ActivitiesHandler) ListPendingTransactions(resp http.ResponseWriter, req *http.Request) {
+	var err error
+
+	pagination, err := ParsePagination(req)
+	if err != nil {
+		BadRequest(resp, err)
+		return
+	}
+
+	pendingTransactions, paginationResult, err := handler.pendingTransactionView.ListPendingTransactions(pagination)
+	if err != nil {
+		handler.logger.Errorf("error listing pending transactions: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	SuccessWithPagination(resp, pendingTransactions, paginationResult)
+}
+
 func (handler *ActivitiesHandler) ListEvents(resp http.ResponseWriter, req *http.Request) {
 	var err error
 
@@ -133,7 +196,14 @@ func (handler *ActivitiesHandler) ListEvents(resp http.ResponseWriter, req *http
 		return
 	}
 
-	SuccessWithPagination(resp, blockTransactions, paginationResult)
+	maybeHistoryAvailableFromHeight, err := findMaybeHistoryAvailableFromHeight(handler.indexBootstrapView)
+	if err != nil {
+		handler.logger.Errorf("error listing events: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	SuccessWithPaginationAndHistory(resp, blockTransactions, paginationResult, maybeHistoryAvailableFromHeight)
 }
 
 func (handler *ActivitiesHandler) FindEventByBlockHeightEventPosition(resp http.ResponseWriter, req *http.Request) {
@@ -163,7 +233,17 @@ func (handler *ActivitiesHandler) FindEventByBlockHeightEventPosition(resp http.
 		return
 	}
 
-	block, err := handler.activityView.FindEventByBlockHeightEventPosition(blockHeight, eventPosition)
+	// Events are addressed by begin block event position unless the phase is given
+	eventPhase := chainindex.EVENT_PHASE_BEGIN_BLOCK
+	if eventPhaseParam := req.URL.Query().Get("phase"); eventPhaseParam != "" {
+		if !adapter.IsValidEventPhase(eventPhaseParam) {
+			BadRequest(resp, fmt.Errorf("invalid event phase: %s", eventPhaseParam))
+			return
+		}
+		eventPhase = adapter.EventPhaseFromString(eventPhaseParam)
+	}
+
+	block, err := handler.activityView.FindEventByBlockHeightEventPosition(blockHeight, eventPhase, eventPosition)
 	if err != nil {
 		if err == adapter.ErrNotFound {
 			NotFound(resp)
@@ -8,10 +8,12 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/crypto-com/chainindex"
 	"github.com/crypto-com/chainindex/adapter"
 	"github.com/crypto-com/chainindex/adapter/httpapi"
 	. "github.com/crypto-com/chainindex/adapter/httpapi/test"
 	. "github.com/crypto-com/chainindex/adapter/httpapi/test/mock"
+	"github.com/crypto-com/chainindex/internal/primptr"
 	. "github.com/crypto-com/chainindex/usecase/test/fake"
 	"github.com/crypto-com/chainindex/usecase/viewrepo"
 	. "github.com/crypto-com/chainindex/usecase/viewrepo/test/mock"
@@ -19,15 +21,21 @@ import (
 
 var _ = Describe("Activities", func() {
 	var mockActivityViewRepo *MockActivityViewRepo
+	var mockPendingTransactionViewRepo *MockPendingTransactionViewRepo
+	var mockIndexBootstrapViewRepo *MockIndexBootstrapViewRepo
 	var mockRoutePath *MockRoutePath
 	var mockHandler *httpapi.ActivitiesHandler
 
 	BeforeEach(func() {
 		fakeLogger := &FakeLogger{}
 		mockActivityViewRepo = &MockActivityViewRepo{}
+		mockPendingTransactionViewRepo = &MockPendingTransactionViewRepo{}
+		mockIndexBootstrapViewRepo = &MockIndexBootstrapViewRepo{}
 		mockRoutePath = &MockRoutePath{}
 
-		mockHandler = httpapi.NewActivitiesHandler(fakeLogger, mockRoutePath, mockActivityViewRepo)
+		mockHandler = httpapi.NewActivitiesHandler(
+			fakeLogger, mockRoutePath, mockActivityViewRepo, mockPendingTransactionViewRepo, mockIndexBootstrapViewRepo,
+		)
 	})
 
 	Describe("ListTransactions", func() {
@@ -74,6 +82,56 @@ var _ = Describe("Activities", func() {
 
 			Expect(respSpy.Result().StatusCode).To(Equal(400))
 		})
+
+		It("should return BadRequest when status filter is invalid", func() {
+			reqWithInvalidFilter := NewMockHTTPGetRequest(HTTPQueryParams{
+				"status": "invalid",
+			})
+			respSpy := httptest.NewRecorder()
+
+			mockHandler.ListTransactions(respSpy, reqWithInvalidFilter)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(400))
+		})
+
+		It("should list transactions with the status filter", func() {
+			expectedFilter := viewrepo.TransactionFilter{
+				MaybeTypes:  make([]chainindex.TransactionType, 0),
+				MaybeStatus: primptr.Uint8(chainindex.TRANSACTION_STATUS_FAILED),
+			}
+			mockActivityViewRepo.On(
+				"ListTransactions", expectedFilter, mock.Anything,
+			).Return([]viewrepo.Transaction{}, &viewrepo.PaginationResult{}, nil)
+			mockIndexBootstrapViewRepo.On("Find").Return((*viewrepo.IndexBootstrap)(nil), adapter.ErrNotFound)
+
+			reqWithStatusFilter := NewMockHTTPGetRequest(HTTPQueryParams{
+				"status": "failed",
+			})
+			respSpy := httptest.NewRecorder()
+
+			mockHandler.ListTransactions(respSpy, reqWithStatusFilter)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			Expect(respSpy.Body.String()).NotTo(ContainSubstring("history_available_from_height"))
+			mockActivityViewRepo.AssertExpectations(GinkgoT())
+		})
+
+		It("should mark the earliest height with history when the index is bootstrapped from a state snapshot", func() {
+			mockActivityViewRepo.On(
+				"ListTransactions", mock.Anything, mock.Anything,
+			).Return([]viewrepo.Transaction{}, &viewrepo.PaginationResult{}, nil)
+			mockIndexBootstrapViewRepo.On("Find").Return(&viewrepo.IndexBootstrap{
+				Height: uint64(100000),
+			}, nil)
+
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockHandler.ListTransactions(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			Expect(respSpy.Body.String()).To(ContainSubstring(`"history_available_from_height":100001`))
+		})
 	})
 
 	Describe("FindTransactionByTxId", func() {
@@ -96,6 +154,9 @@ var _ = Describe("Activities", func() {
 			mockActivityViewRepo.On(
 				"FindTransactionByTxId", anyTxID,
 			).Return((*viewrepo.Transaction)(nil), adapter.ErrNotFound)
+			mockPendingTransactionViewRepo.On(
+				"FindPendingTransactionByTxId", anyTxID,
+			).Return((*viewrepo.PendingTransaction)(nil), adapter.ErrNotFound)
 
 			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
 			respSpy := httptest.NewRecorder()
@@ -104,6 +165,86 @@ var _ = Describe("Activities", func() {
 
 			Expect(respSpy.Result().StatusCode).To(Equal(404))
 		})
+
+		It("should return transaction in pending status when it is only observed in the mempool", func() {
+			anyTxID := "any-txid"
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"txid": anyTxID,
+			})
+			mockActivityViewRepo.On(
+				"FindTransactionByTxId", anyTxID,
+			).Return((*viewrepo.Transaction)(nil), adapter.ErrNotFound)
+			mockPendingTransactionViewRepo.On(
+				"FindPendingTransactionByTxId", anyTxID,
+			).Return(&viewrepo.PendingTransaction{
+				TxID:   anyTxID,
+				Type:   "transfer",
+				Status: viewrepo.TRANSACTION_STATUS_PENDING,
+			}, nil)
+
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockHandler.FindTransactionByTxId(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			Expect(respSpy.Body.String()).To(ContainSubstring("\"status\":\"pending\""))
+		})
+
+		It("should not look up pending transactions when the transaction is indexed", func() {
+			anyTxID := "any-txid"
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"txid": anyTxID,
+			})
+			mockActivityViewRepo.On(
+				"FindTransactionByTxId", anyTxID,
+			).Return(&viewrepo.Transaction{
+				MaybeTxID: primptr.String(anyTxID),
+				Status:    "success",
+			}, nil)
+
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockHandler.FindTransactionByTxId(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			Expect(respSpy.Body.String()).To(ContainSubstring("\"status\":\"success\""))
+			mockPendingTransactionViewRepo.AssertNotCalled(GinkgoT(), "FindPendingTransactionByTxId", mock.Anything)
+		})
+	})
+
+	Describe("ListPendingTransactions", func() {
+		It("should return BadRequest when pagination is missing", func() {
+			reqWithInvalidPage := NewMockHTTPGetRequest(HTTPQueryParams{
+				"page": "invalid",
+			})
+			respSpy := httptest.NewRecorder()
+
+			mockHandler.ListPendingTransactions(respSpy, reqWithInvalidPage)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(400))
+		})
+
+		It("should list pending transactions", func() {
+			mockPendingTransactionViewRepo.On(
+				"ListPendingTransactions", mock.Anything,
+			).Return([]viewrepo.PendingTransaction{
+				{
+					TxID:   "any-txid",
+					Type:   "transfer",
+					Status: viewrepo.TRANSACTION_STATUS_PENDING,
+				},
+			}, &viewrepo.PaginationResult{}, nil)
+
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockHandler.ListPendingTransactions(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			Expect(respSpy.Body.String()).To(ContainSubstring("\"txid\":\"any-txid\""))
+		})
 	})
 
 	Describe("ListEvents", func() {
@@ -215,7 +356,7 @@ var _ = Describe("Activities", func() {
 				"position": strconv.FormatUint(anyPosition, 10),
 			})
 			mockActivityViewRepo.On(
-				"FindEventByBlockHeightEventPosition", anyHeight, anyPosition,
+				"FindEventByBlockHeightEventPosition", anyHeight, chainindex.EVENT_PHASE_BEGIN_BLOCK, anyPosition,
 			).Return((*viewrepo.Event)(nil), adapter.ErrNotFound)
 
 			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
@@ -225,5 +366,48 @@ var _ = Describe("Activities", func() {
 
 			Expect(respSpy.Result().StatusCode).To(Equal(404))
 		})
+
+		It("should return BadRequest when phase is invalid", func() {
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"height":   "1000",
+				"position": "0",
+			})
+
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{
+				"phase": "invalid",
+			})
+			respSpy := httptest.NewRecorder()
+
+			mockHandler.FindEventByBlockHeightEventPosition(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(400))
+		})
+
+		It("should find event in the end block phase when phase is end_block", func() {
+			anyHeight := uint64(1000)
+			anyPosition := uint64(0)
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"height":   strconv.FormatUint(anyHeight, 10),
+				"position": strconv.FormatUint(anyPosition, 10),
+			})
+			anyEvent := viewrepo.Event{
+				Type:          "jail",
+				BlockHeight:   anyHeight,
+				EventPosition: anyPosition,
+				EventPhase:    "end_block",
+			}
+			mockActivityViewRepo.On(
+				"FindEventByBlockHeightEventPosition", anyHeight, chainindex.EVENT_PHASE_END_BLOCK, anyPosition,
+			).Return(&anyEvent, nil)
+
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{
+				"phase": "end_block",
+			})
+			respSpy := httptest.NewRecorder()
+
+			mockHandler.FindEventByBlockHeightEventPosition(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+		})
 	})
 })
@@ -2,6 +2,7 @@ package httpapi
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"regexp"
 	"strconv"
@@ -15,18 +16,25 @@ import (
 type BlocksHandler struct {
 	logger usecase.Logger
 
-	routePath RoutePath
-	blockView viewrepo.BlockViewRepo
+	routePath          RoutePath
+	blockView          viewrepo.BlockViewRepo
+	indexBootstrapView viewrepo.IndexBootstrapViewRepo
 }
 
-func NewBlocksHandler(logger usecase.Logger, routePath RoutePath, blockView viewrepo.BlockViewRepo) *BlocksHandler {
+func NewBlocksHandler(
+	logger usecase.Logger,
+	routePath RoutePath,
+	blockView viewrepo.BlockViewRepo,
+	indexBootstrapView viewrepo.IndexBootstrapViewRepo,
+) *BlocksHandler {
 	return &BlocksHandler{
 		logger: logger.WithFields(usecase.LogFields{
 			"module": "BlocksHandler",
 		}),
 
-		routePath: routePath,
-		blockView: blockView,
+		routePath:          routePath,
+		blockView:          blockView,
+		indexBootstrapView: indexBootstrapView,
 	}
 }
 
@@ -64,7 +72,14 @@ func (handler *BlocksHandler) ListBlocks(resp http.ResponseWriter, req *http.Req
 		return
 	}
 
-	SuccessWithPagination(resp, blocks, paginationResult)
+	maybeHistoryAvailableFromHeight, err := findMaybeHistoryAvailableFromHeight(handler.indexBootstrapView)
+	if err != nil {
+		handler.logger.Errorf("error listing blocks: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	SuccessWithPaginationAndHistory(resp, blocks, paginationResult, maybeHistoryAvailableFromHeight)
 }
 
 func (handler *BlocksHandler) FindBlock(resp http.ResponseWriter, req *http.Request) {
@@ -77,6 +92,10 @@ func (handler *BlocksHandler) FindBlock(resp http.ResponseWriter, req *http.Requ
 		return
 	}
 
+	if handler.historyUnavailable(resp, blockIdentity) {
+		return
+	}
+
 	block, err := handler.blockView.FindBlock(*blockIdentity)
 	if err != nil {
 		if err == adapter.ErrNotFound {
@@ -107,6 +126,10 @@ func (handler *BlocksHandler) ListBlockTransactions(resp http.ResponseWriter, re
 		return
 	}
 
+	if handler.historyUnavailable(resp, blockIdentity) {
+		return
+	}
+
 	blockTransactions, paginationResult, err := handler.blockView.ListBlockTransactions(
 		*blockIdentity, pagination,
 	)
@@ -139,6 +162,10 @@ func (handler *BlocksHandler) ListBlockEvents(resp http.ResponseWriter, req *htt
 		return
 	}
 
+	if handler.historyUnavailable(resp, blockIdentity) {
+		return
+	}
+
 	blockEvents, paginationResult, err := handler.blockView.ListBlockEvents(
 		*blockIdentity, pagination,
 	)
@@ -155,6 +182,33 @@ func (handler *BlocksHandler) ListBlockEvents(resp http.ResponseWriter, req *htt
 	SuccessWithPagination(resp, blockEvents, paginationResult)
 }
 
+// historyUnavailable responds Gone when the block is at or before the state
+// snapshot the index is bootstrapped from, where it is stored without its
+// history. Hashes of blocks before the snapshot are not stored and are
+// responded NotFound as unknown blocks
+func (handler *BlocksHandler) historyUnavailable(resp http.ResponseWriter, blockIdentity *viewrepo.BlockIdentity) bool {
+	indexBootstrap, err := handler.indexBootstrapView.Find()
+	if err != nil {
+		if err == adapter.ErrNotFound {
+			return false
+		}
+		handler.logger.Errorf("error finding index bootstrap: %v", err)
+		InternalServerError(resp)
+		return true
+	}
+
+	historyAvailableFromHeight := HistoryAvailableFromHeight(indexBootstrap)
+	if (blockIdentity.MaybeHeight != nil && *blockIdentity.MaybeHeight < historyAvailableFromHeight) ||
+		(blockIdentity.MaybeHash != nil && strings.EqualFold(*blockIdentity.MaybeHash, indexBootstrap.BlockHash)) {
+		Gone(resp, fmt.Errorf(
+			"history before height %d is unavailable: index is bootstrapped from a state snapshot at height %d",
+			historyAvailableFromHeight, indexBootstrap.Height,
+		))
+		return true
+	}
+	return false
+}
+
 func parseBlockIdentity(routeVars map[string]string) (*viewrepo.BlockIdentity, error) {
 	identityVar, ok := routeVars["hash_or_height"]
 	if !ok {
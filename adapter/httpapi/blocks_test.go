@@ -2,6 +2,7 @@ package httpapi_test
 
 import (
 	"net/http/httptest"
+	"strings"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -18,15 +19,17 @@ import (
 
 var _ = Describe("Blocks", func() {
 	var mockBlockViewRepo *MockBlockViewRepo
+	var mockIndexBootstrapViewRepo *MockIndexBootstrapViewRepo
 	var mockRoutePath *MockRoutePath
 	var mockHandler *httpapi.BlocksHandler
 
 	BeforeEach(func() {
 		fakeLogger := &FakeLogger{}
 		mockBlockViewRepo = &MockBlockViewRepo{}
+		mockIndexBootstrapViewRepo = &MockIndexBootstrapViewRepo{}
 		mockRoutePath = &MockRoutePath{}
 
-		mockHandler = httpapi.NewBlocksHandler(fakeLogger, mockRoutePath, mockBlockViewRepo)
+		mockHandler = httpapi.NewBlocksHandler(fakeLogger, mockRoutePath, mockBlockViewRepo, mockIndexBootstrapViewRepo)
 	})
 
 	Describe("ListBlocks", func() {
@@ -159,6 +162,83 @@ var _ = Describe("Blocks", func() {
 			})
 
 			mockBlockViewRepo.On("FindBlock", mock.Anything).Return((*viewrepo.Block)(nil), adapter.ErrNotFound)
+			mockIndexBootstrapViewRepo.On("Find").Return((*viewrepo.IndexBootstrap)(nil), adapter.ErrNotFound)
+
+			mockHandler.FindBlock(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(404))
+		})
+
+		It("should return Gone when block height is before the state snapshot the index is bootstrapped from", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"hash_or_height": "10",
+			})
+
+			mockBlockViewRepo.On("FindBlock", mock.Anything).Return((*viewrepo.Block)(nil), adapter.ErrNotFound)
+			mockIndexBootstrapViewRepo.On("Find").Return(&viewrepo.IndexBootstrap{
+				Height: uint64(100000),
+			}, nil)
+
+			mockHandler.FindBlock(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(410))
+			Expect(respSpy.Body.String()).To(ContainSubstring("history before height 100001 is unavailable"))
+			mockBlockViewRepo.AssertNotCalled(GinkgoT(), "FindBlock", mock.Anything)
+		})
+
+		It("should return Gone when block height is the state snapshot the index is bootstrapped from", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"hash_or_height": "100000",
+			})
+
+			mockIndexBootstrapViewRepo.On("Find").Return(&viewrepo.IndexBootstrap{
+				Height: uint64(100000),
+			}, nil)
+
+			mockHandler.FindBlock(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(410))
+			mockBlockViewRepo.AssertNotCalled(GinkgoT(), "FindBlock", mock.Anything)
+		})
+
+		It("should return Gone when block hash is the state snapshot the index is bootstrapped from", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			blockHash := "B27A8E1FD5AB2F8B40C3A6C8B5B1E9FBDB12C8F3E8F5D1E1A8E3D2F7A5E4D8C2"
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"hash_or_height": strings.ToLower(blockHash),
+			})
+
+			mockIndexBootstrapViewRepo.On("Find").Return(&viewrepo.IndexBootstrap{
+				Height:    uint64(100000),
+				BlockHash: blockHash,
+			}, nil)
+
+			mockHandler.FindBlock(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(410))
+			mockBlockViewRepo.AssertNotCalled(GinkgoT(), "FindBlock", mock.Anything)
+		})
+
+		It("should return NotFound when block height is after the state snapshot the index is bootstrapped from", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"hash_or_height": "200000",
+			})
+
+			mockBlockViewRepo.On("FindBlock", mock.Anything).Return((*viewrepo.Block)(nil), adapter.ErrNotFound)
+			mockIndexBootstrapViewRepo.On("Find").Return(&viewrepo.IndexBootstrap{
+				Height: uint64(100000),
+			}, nil)
 
 			mockHandler.FindBlock(respSpy, anyReq)
 
@@ -243,6 +323,7 @@ var _ = Describe("Blocks", func() {
 				mock.Anything,
 				mock.Anything,
 			).Return(([]viewrepo.Transaction)(nil), (*viewrepo.PaginationResult)(nil), adapter.ErrNotFound)
+			mockIndexBootstrapViewRepo.On("Find").Return((*viewrepo.IndexBootstrap)(nil), adapter.ErrNotFound)
 
 			mockHandler.ListBlockTransactions(respSpy, anyReq)
 
@@ -341,6 +422,7 @@ var _ = Describe("Blocks", func() {
 				mock.Anything,
 				mock.Anything,
 			).Return(([]viewrepo.BlockEvent)(nil), (*viewrepo.PaginationResult)(nil), adapter.ErrNotFound)
+			mockIndexBootstrapViewRepo.On("Find").Return((*viewrepo.IndexBootstrap)(nil), adapter.ErrNotFound)
 
 			mockHandler.ListBlockEvents(respSpy, anyReq)
 
@@ -0,0 +1,41 @@
+package httpapi
+
+import (
+	"net/http"
+
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/usecase"
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+)
+
+type ChainParamsHandler struct {
+	logger usecase.Logger
+
+	chainParamsView viewrepo.ChainParamsViewRepo
+}
+
+func NewChainParamsHandler(logger usecase.Logger, chainParamsView viewrepo.ChainParamsViewRepo) *ChainParamsHandler {
+	return &ChainParamsHandler{
+		logger: logger.WithFields(usecase.LogFields{
+			"module": "ChainParamsHandler",
+		}),
+
+		chainParamsView: chainParamsView,
+	}
+}
+
+func (handler *ChainParamsHandler) GetChainParams(resp http.ResponseWriter, req *http.Request) {
+	chainParams, err := handler.chainParamsView.Find()
+	if err != nil {
+		// Genesis is not indexed yet
+		if err == adapter.ErrNotFound {
+			NotFound(resp)
+			return
+		}
+		handler.logger.Errorf("error finding chain params: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	Success(resp, chainParams)
+}
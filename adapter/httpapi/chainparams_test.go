@@ -0,0 +1,69 @@
+package httpapi_test
+
+import (
+	"errors"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/adapter/httpapi"
+	. "github.com/crypto-com/chainindex/adapter/httpapi/test"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+	. "github.com/crypto-com/chainindex/usecase/viewrepo/test/mock"
+)
+
+var _ = Describe("ChainParams", func() {
+	var mockChainParamsViewRepo *MockChainParamsViewRepo
+	var mockHandler *httpapi.ChainParamsHandler
+
+	BeforeEach(func() {
+		fakeLogger := &FakeLogger{}
+		mockChainParamsViewRepo = &MockChainParamsViewRepo{}
+
+		mockHandler = httpapi.NewChainParamsHandler(fakeLogger, mockChainParamsViewRepo)
+	})
+
+	Describe("GetChainParams", func() {
+		It("should return NotFound when genesis is not indexed yet", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockChainParamsViewRepo.On("Find").Return((*viewrepo.ChainParams)(nil), adapter.ErrNotFound)
+
+			mockHandler.GetChainParams(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(404))
+		})
+
+		It("should return InternalServerError when view repo returns error", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockChainParamsViewRepo.On("Find").Return((*viewrepo.ChainParams)(nil), errors.New("any error"))
+
+			mockHandler.GetChainParams(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(500))
+		})
+
+		It("should return chain params", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			chainParams := viewrepo.ChainParams{
+				ChainID: "testnet-thaler-crypto-com-chain-42",
+			}
+			chainParams.NetworkParams.JailingConfig.BlockSigningWindow = uint64(720)
+			mockChainParamsViewRepo.On("Find").Return(&chainParams, nil)
+
+			mockHandler.GetChainParams(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			Expect(respSpy.Body.String()).To(ContainSubstring("\"chain_id\":\"testnet-thaler-crypto-com-chain-42\""))
+			Expect(respSpy.Body.String()).To(ContainSubstring("\"block_signing_window\":720"))
+		})
+	})
+})
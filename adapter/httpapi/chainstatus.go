@@ -3,6 +3,7 @@ package httpapi
 import (
 	"net/http"
 
+	"github.com/crypto-com/chainindex/adapter"
 	"github.com/crypto-com/chainindex/internal/bignum"
 	"github.com/crypto-com/chainindex/usecase"
 	"github.com/crypto-com/chainindex/usecase/viewrepo"
@@ -17,6 +18,11 @@ type ChainStatus struct {
 	TotalRewardMinted      *bignum.WBigInt `json:"total_reward_minted"`
 	CouncilNodeCount       uint64          `json:"council_node_count"`
 	TotalCouncilNodeStaked *bignum.WBigInt `json:"total_council_node_staked"`
+	// Earliest block height with history available. It is the block after the
+	// state snapshot when the index is bootstrapped from one
+	HistoryAvailableFromHeight uint64                   `json:"history_available_from_height"`
+	MaybeIndexBootstrap        *viewrepo.IndexBootstrap `json:"index_bootstrap"`
+	MaybeSyncState             *viewrepo.SyncState      `json:"sync_state"`
 }
 
 type ChainStatusReward struct {
@@ -27,10 +33,14 @@ type ChainStatusReward struct {
 type ChainStatusHandler struct {
 	logger usecase.Logger
 
-	syncService     usecase.SyncService
-	activityView    viewrepo.ActivityViewRepo
-	rewardView      viewrepo.RewardViewRepo
-	councilNodeView viewrepo.CouncilNodeViewRepo
+	// Sync service is nil when synchronization runs in another process. Sync
+	// status is then reported from the checkpointed sync state
+	syncService        usecase.SyncService
+	activityView       viewrepo.ActivityViewRepo
+	rewardView         viewrepo.RewardViewRepo
+	councilNodeView    viewrepo.CouncilNodeViewRepo
+	indexBootstrapView viewrepo.IndexBootstrapViewRepo
+	syncStateView      viewrepo.SyncStateViewRepo
 }
 
 func NewChainStatusHandler(
@@ -40,16 +50,20 @@ func NewChainStatusHandler(
 	activityView viewrepo.ActivityViewRepo,
 	rewardView viewrepo.RewardViewRepo,
 	councilNodeView viewrepo.CouncilNodeViewRepo,
+	indexBootstrapView viewrepo.IndexBootstrapViewRepo,
+	syncStateView viewrepo.SyncStateViewRepo,
 ) *ChainStatusHandler {
 	return &ChainStatusHandler{
 		logger: logger.WithFields(usecase.LogFields{
 			"module": "ChainStatusHandler",
 		}),
 
-		syncService:     syncService,
-		activityView:    activityView,
-		rewardView:      rewardView,
-		councilNodeView: councilNodeView,
+		syncService:        syncService,
+		activityView:       activityView,
+		rewardView:         rewardView,
+		councilNodeView:    councilNodeView,
+		indexBootstrapView: indexBootstrapView,
+		syncStateView:      syncStateView,
 	}
 }
 
@@ -59,9 +73,25 @@ func (handler *ChainStatusHandler) GetChainStatus(resp http.ResponseWriter, req
 
 	chainStatus.Version = "0.5.2"
 
-	syncStatus := handler.syncService.GetStatus()
-	chainStatus.TendermintBlockHeight = syncStatus.TendermintBlockHeight
-	chainStatus.SyncBlockHeight = syncStatus.SyncBlockHeight
+	syncState, err := handler.syncStateView.FindSyncState()
+	if err != nil {
+		if err != adapter.ErrNotFound {
+			handler.logger.Errorf("error finding sync state: %v", err)
+			InternalServerError(resp)
+			return
+		}
+	} else {
+		chainStatus.MaybeSyncState = syncState
+	}
+
+	if handler.syncService != nil {
+		syncStatus := handler.syncService.GetStatus()
+		chainStatus.TendermintBlockHeight = syncStatus.TendermintBlockHeight
+		chainStatus.SyncBlockHeight = syncStatus.SyncBlockHeight
+	} else if syncState != nil {
+		chainStatus.TendermintBlockHeight = syncState.LastObservedTendermintHeight
+		chainStatus.SyncBlockHeight = syncState.LastStoredHeight
+	}
 
 	chainStatus.TransactionCount, err = handler.activityView.TransactionsCount()
 	if err != nil {
@@ -92,5 +122,18 @@ func (handler *ChainStatusHandler) GetChainStatus(resp http.ResponseWriter, req
 	chainStatus.CouncilNodeCount = councilNodeStats.Count
 	chainStatus.TotalCouncilNodeStaked = councilNodeStats.TotalStaked
 
+	chainStatus.HistoryAvailableFromHeight = uint64(1)
+	indexBootstrap, err := handler.indexBootstrapView.Find()
+	if err != nil {
+		if err != adapter.ErrNotFound {
+			handler.logger.Errorf("error finding index bootstrap: %v", err)
+			InternalServerError(resp)
+			return
+		}
+	} else {
+		chainStatus.HistoryAvailableFromHeight = HistoryAvailableFromHeight(indexBootstrap)
+		chainStatus.MaybeIndexBootstrap = indexBootstrap
+	}
+
 	Success(resp, chainStatus)
 }
@@ -16,18 +16,25 @@ import (
 type CouncilNodesHandler struct {
 	logger usecase.Logger
 
-	routePath       RoutePath
-	councilNodeView viewrepo.CouncilNodeViewRepo
+	routePath          RoutePath
+	councilNodeView    viewrepo.CouncilNodeViewRepo
+	indexBootstrapView viewrepo.IndexBootstrapViewRepo
 }
 
-func NewCouncilNodesHandler(logger usecase.Logger, routePath RoutePath, councilNodeView viewrepo.CouncilNodeViewRepo) *CouncilNodesHandler {
+func NewCouncilNodesHandler(
+	logger usecase.Logger,
+	routePath RoutePath,
+	councilNodeView viewrepo.CouncilNodeViewRepo,
+	indexBootstrapView viewrepo.IndexBootstrapViewRepo,
+) *CouncilNodesHandler {
 	return &CouncilNodesHandler{
 		logger: logger.WithFields(usecase.LogFields{
 			"module": "CouncilNodesHandler",
 		}),
 
-		routePath:       routePath,
-		councilNodeView: councilNodeView,
+		routePath:          routePath,
+		councilNodeView:    councilNodeView,
+		indexBootstrapView: indexBootstrapView,
 	}
 }
 
@@ -40,7 +47,16 @@ func (handler *CouncilNodesHandler) ListActive(resp http.ResponseWriter, req *ht
 		return
 	}
 
-	councilNodes, paginationResult, err := handler.councilNodeView.ListActive(pagination)
+	order := viewrepo.COUNCIL_NODE_LIST_ORDER_BONDED
+	if orderParam := req.URL.Query().Get("order"); orderParam != "" {
+		if !viewrepo.IsValidCouncilNodeListOrder(orderParam) {
+			BadRequest(resp, fmt.Errorf("invalid order query parameter: %s", orderParam))
+			return
+		}
+		order = orderParam
+	}
+
+	councilNodes, paginationResult, err := handler.councilNodeView.ListActive(order, pagination)
 	if err != nil {
 		handler.logger.Errorf("error listing council nodes: %v", err)
 		InternalServerError(resp)
@@ -79,6 +95,51 @@ func (handler *CouncilNodesHandler) FindById(resp http.ResponseWriter, req *http
 	Success(resp, councilNode)
 }
 
+func (handler *CouncilNodesHandler) FindStatsById(resp http.ResponseWriter, req *http.Request) {
+	var err error
+
+	routeVars := handler.routePath.Vars(req)
+	councilNodeIdVar, ok := routeVars["id"]
+	if !ok {
+		BadRequest(resp, errors.New("missing council node id path parameter"))
+		return
+	}
+	councilNodeId, err := strconv.ParseUint(councilNodeIdVar, 10, 64)
+	if err != nil {
+		BadRequest(resp, errors.New("invalid council node id path parameter"))
+		return
+	}
+
+	window := viewrepo.COUNCIL_NODE_PERFORMANCE_WINDOW_1K_BLOCKS
+	if windowParam := req.URL.Query().Get("window"); windowParam != "" {
+		if !viewrepo.IsValidCouncilNodePerformanceWindow(windowParam) {
+			BadRequest(resp, fmt.Errorf("invalid window query parameter: %s", windowParam))
+			return
+		}
+		window = windowParam
+	}
+
+	performance, err := handler.councilNodeView.FindPerformanceById(councilNodeId, window)
+	if err != nil {
+		if err == adapter.ErrNotFound {
+			NotFound(resp)
+			return
+		}
+		handler.logger.Errorf("error finding council node performance: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	maybeHistoryAvailableFromHeight, err := findMaybeHistoryAvailableFromHeight(handler.indexBootstrapView)
+	if err != nil {
+		handler.logger.Errorf("error finding council node performance: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	SuccessWithHistory(resp, performance, maybeHistoryAvailableFromHeight)
+}
+
 func (handler *CouncilNodesHandler) ListActivitiesById(resp http.ResponseWriter, req *http.Request) {
 	var err error
 
@@ -128,5 +189,116 @@ func (handler *CouncilNodesHandler) ListActivitiesById(resp http.ResponseWriter,
 		return
 	}
 
-	SuccessWithPagination(resp, activities, paginationResult)
+	maybeHistoryAvailableFromHeight, err := findMaybeHistoryAvailableFromHeight(handler.indexBootstrapView)
+	if err != nil {
+		handler.logger.Errorf("error listing council node activities: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	SuccessWithPaginationAndHistory(resp, activities, paginationResult, maybeHistoryAvailableFromHeight)
+}
+
+func (handler *CouncilNodesHandler) ListPowerHistoryById(resp http.ResponseWriter, req *http.Request) {
+	var err error
+
+	pagination, err := ParsePagination(req)
+	if err != nil {
+		BadRequest(resp, err)
+		return
+	}
+
+	routeVars := handler.routePath.Vars(req)
+	councilNodeIdVar, ok := routeVars["id"]
+	if !ok {
+		BadRequest(resp, errors.New("missing council node id path parameter"))
+		return
+	}
+	councilNodeId, err := strconv.ParseUint(councilNodeIdVar, 10, 64)
+	if err != nil {
+		BadRequest(resp, errors.New("invalid council node id path parameter"))
+		return
+	}
+
+	powerChanges, paginationResult, err := handler.councilNodeView.ListPowerChangesById(councilNodeId, pagination)
+	if err != nil {
+		if err == adapter.ErrNotFound {
+			NotFound(resp)
+			return
+		}
+		handler.logger.Errorf("error listing council node power changes: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	maybeHistoryAvailableFromHeight, err := findMaybeHistoryAvailableFromHeight(handler.indexBootstrapView)
+	if err != nil {
+		handler.logger.Errorf("error listing council node power changes: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	SuccessWithPaginationAndHistory(resp, powerChanges, paginationResult, maybeHistoryAvailableFromHeight)
+}
+
+func (handler *CouncilNodesHandler) ListEvidencesById(resp http.ResponseWriter, req *http.Request) {
+	var err error
+
+	pagination, err := ParsePagination(req)
+	if err != nil {
+		BadRequest(resp, err)
+		return
+	}
+
+	routeVars := handler.routePath.Vars(req)
+	councilNodeIdVar, ok := routeVars["id"]
+	if !ok {
+		BadRequest(resp, errors.New("missing council node id path parameter"))
+		return
+	}
+	councilNodeId, err := strconv.ParseUint(councilNodeIdVar, 10, 64)
+	if err != nil {
+		BadRequest(resp, errors.New("invalid council node id path parameter"))
+		return
+	}
+
+	evidences, paginationResult, err := handler.councilNodeView.ListEvidencesById(councilNodeId, pagination)
+	if err != nil {
+		if err == adapter.ErrNotFound {
+			NotFound(resp)
+			return
+		}
+		handler.logger.Errorf("error listing council node evidences: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	SuccessWithPagination(resp, evidences, paginationResult)
+}
+
+func (handler *CouncilNodesHandler) ListValidators(resp http.ResponseWriter, req *http.Request) {
+	var err error
+
+	var maybeHeight *uint64
+	if heightParam := req.URL.Query().Get("height"); heightParam != "" {
+		height, parseErr := strconv.ParseUint(heightParam, 10, 64)
+		if parseErr != nil {
+			BadRequest(resp, errors.New("invalid height query parameter"))
+			return
+		}
+		maybeHeight = &height
+	}
+
+	validatorSet, err := handler.councilNodeView.ListValidatorsAtHeight(maybeHeight)
+	if err != nil {
+		if err == adapter.ErrNotFound {
+			NotFound(resp)
+			return
+		}
+		handler.logger.Errorf("error listing validators: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	Success(resp, validatorSet)
 }
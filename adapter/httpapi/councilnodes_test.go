@@ -18,15 +18,19 @@ import (
 
 var _ = Describe("CouncilNodes", func() {
 	var mockCouncilNodeViewRepo *MockCouncilNodeViewRepo
+	var mockIndexBootstrapViewRepo *MockIndexBootstrapViewRepo
 	var mockRoutePath *MockRoutePath
 	var mockHandler *httpapi.CouncilNodesHandler
 
 	BeforeEach(func() {
 		fakeLogger := &FakeLogger{}
 		mockCouncilNodeViewRepo = &MockCouncilNodeViewRepo{}
+		mockIndexBootstrapViewRepo = &MockIndexBootstrapViewRepo{}
 		mockRoutePath = &MockRoutePath{}
 
-		mockHandler = httpapi.NewCouncilNodesHandler(fakeLogger, mockRoutePath, mockCouncilNodeViewRepo)
+		mockHandler = httpapi.NewCouncilNodesHandler(
+			fakeLogger, mockRoutePath, mockCouncilNodeViewRepo, mockIndexBootstrapViewRepo,
+		)
 	})
 
 	Describe("ListActive", func() {
@@ -40,6 +44,127 @@ var _ = Describe("CouncilNodes", func() {
 
 			Expect(respSpy.Result().StatusCode).To(Equal(400))
 		})
+
+		It("should return BadRequest when order is invalid", func() {
+			reqWithInvalidOrder := NewMockHTTPGetRequest(HTTPQueryParams{
+				"order": "invalid",
+			})
+			respSpy := httptest.NewRecorder()
+
+			mockHandler.ListActive(respSpy, reqWithInvalidOrder)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(400))
+		})
+
+		It("should list council nodes ordered by uptime", func() {
+			reqWithOrder := NewMockHTTPGetRequest(HTTPQueryParams{
+				"order": "uptime",
+			})
+			respSpy := httptest.NewRecorder()
+
+			mockCouncilNodeViewRepo.On(
+				"ListActive", viewrepo.COUNCIL_NODE_LIST_ORDER_UPTIME, mock.Anything,
+			).Return([]viewrepo.CouncilNodeListItem{}, &viewrepo.PaginationResult{}, nil)
+
+			mockHandler.ListActive(respSpy, reqWithOrder)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			mockCouncilNodeViewRepo.AssertExpectations(GinkgoT())
+		})
+	})
+
+	Describe("FindStatsById", func() {
+		It("should return BadRequest when id has invalid type", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"id": "invalid",
+			})
+
+			mockHandler.FindStatsById(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(400))
+		})
+
+		It("should return BadRequest when window is invalid", func() {
+			reqWithInvalidWindow := NewMockHTTPGetRequest(HTTPQueryParams{
+				"window": "1y",
+			})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"id": "10",
+			})
+
+			mockHandler.FindStatsById(respSpy, reqWithInvalidWindow)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(400))
+		})
+
+		It("should find stats over the latest 1k blocks when window is missing", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"id": "10",
+			})
+
+			mockCouncilNodeViewRepo.On(
+				"FindPerformanceById", uint64(10), viewrepo.COUNCIL_NODE_PERFORMANCE_WINDOW_1K_BLOCKS,
+			).Return(&viewrepo.CouncilNodePerformance{
+				Window: viewrepo.COUNCIL_NODE_PERFORMANCE_WINDOW_1K_BLOCKS,
+			}, nil)
+			mockIndexBootstrapViewRepo.On("Find").Return((*viewrepo.IndexBootstrap)(nil), adapter.ErrNotFound)
+
+			mockHandler.FindStatsById(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			Expect(respSpy.Body.String()).NotTo(ContainSubstring("history_available_from_height"))
+			mockCouncilNodeViewRepo.AssertExpectations(GinkgoT())
+		})
+
+		It("should mark the earliest height with history when the index is bootstrapped from a state snapshot", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"id": "10",
+			})
+
+			mockCouncilNodeViewRepo.On(
+				"FindPerformanceById", uint64(10), viewrepo.COUNCIL_NODE_PERFORMANCE_WINDOW_1K_BLOCKS,
+			).Return(&viewrepo.CouncilNodePerformance{
+				Window: viewrepo.COUNCIL_NODE_PERFORMANCE_WINDOW_1K_BLOCKS,
+			}, nil)
+			mockIndexBootstrapViewRepo.On("Find").Return(&viewrepo.IndexBootstrap{
+				Height: uint64(100000),
+			}, nil)
+
+			mockHandler.FindStatsById(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			Expect(respSpy.Body.String()).To(ContainSubstring(`"history_available_from_height":100001`))
+		})
+
+		It("should return NotFound when council node does not exist", func() {
+			reqWithWindow := NewMockHTTPGetRequest(HTTPQueryParams{
+				"window": "7d",
+			})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"id": "10",
+			})
+
+			mockCouncilNodeViewRepo.On(
+				"FindPerformanceById", uint64(10), viewrepo.COUNCIL_NODE_PERFORMANCE_WINDOW_7D,
+			).Return((*viewrepo.CouncilNodePerformance)(nil), adapter.ErrNotFound)
+
+			mockHandler.FindStatsById(respSpy, reqWithWindow)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(404))
+		})
 	})
 
 	Describe("FindById", func() {
@@ -221,4 +346,114 @@ var _ = Describe("CouncilNodes", func() {
 			Expect(respSpy.Result().StatusCode).To(Equal(404))
 		})
 	})
+
+	Describe("ListPowerHistoryById", func() {
+		It("should return BadRequest when id has invalid type", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"id": "invalid",
+			})
+
+			mockHandler.ListPowerHistoryById(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(400))
+		})
+
+		It("should return NotFound when council node does not exist", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"id": "10",
+			})
+
+			mockCouncilNodeViewRepo.On(
+				"ListPowerChangesById", mock.Anything, mock.Anything,
+			).Return(([]viewrepo.CouncilNodePowerChange)(nil), (*viewrepo.PaginationResult)(nil), adapter.ErrNotFound)
+
+			mockHandler.ListPowerHistoryById(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(404))
+		})
+	})
+
+	Describe("ListEvidencesById", func() {
+		It("should return BadRequest when id has invalid type", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"id": "invalid",
+			})
+
+			mockHandler.ListEvidencesById(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(400))
+		})
+
+		It("should return NotFound when council node does not exist", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"id": "10",
+			})
+
+			mockCouncilNodeViewRepo.On(
+				"ListEvidencesById", uint64(10), mock.Anything,
+			).Return(([]viewrepo.BlockEvidence)(nil), (*viewrepo.PaginationResult)(nil), adapter.ErrNotFound)
+
+			mockHandler.ListEvidencesById(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(404))
+		})
+	})
+
+	Describe("ListValidators", func() {
+		It("should return BadRequest when height is invalid", func() {
+			reqWithInvalidHeight := NewMockHTTPGetRequest(HTTPQueryParams{
+				"height": "-1",
+			})
+			respSpy := httptest.NewRecorder()
+
+			mockHandler.ListValidators(respSpy, reqWithInvalidHeight)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(400))
+		})
+
+		It("should list validators at the latest height when height is missing", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockCouncilNodeViewRepo.On(
+				"ListValidatorsAtHeight", (*uint64)(nil),
+			).Return(&viewrepo.ValidatorSet{
+				BlockHeight: uint64(100),
+				Validators:  []viewrepo.Validator{},
+			}, nil)
+
+			mockHandler.ListValidators(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			mockCouncilNodeViewRepo.AssertExpectations(GinkgoT())
+		})
+
+		It("should return NotFound when block at the height is not stored", func() {
+			reqWithHeight := NewMockHTTPGetRequest(HTTPQueryParams{
+				"height": "100",
+			})
+			respSpy := httptest.NewRecorder()
+
+			height := uint64(100)
+			mockCouncilNodeViewRepo.On(
+				"ListValidatorsAtHeight", &height,
+			).Return((*viewrepo.ValidatorSet)(nil), adapter.ErrNotFound)
+
+			mockHandler.ListValidators(respSpy, reqWithHeight)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(404))
+		})
+	})
 })
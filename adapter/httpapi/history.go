@@ -0,0 +1,31 @@
+package httpapi
+
+import (
+	"fmt"
+
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+)
+
+// HistoryAvailableFromHeight returns the earliest block height with history
+// available on an index bootstrapped from a state snapshot. The snapshot block
+// is stored without its activities and signatures, so history starts from the
+// block after it
+func HistoryAvailableFromHeight(indexBootstrap *viewrepo.IndexBootstrap) uint64 {
+	return indexBootstrap.Height + 1
+}
+
+// findMaybeHistoryAvailableFromHeight returns nil when the index is synced
+// from genesis and has the full history available
+func findMaybeHistoryAvailableFromHeight(indexBootstrapView viewrepo.IndexBootstrapViewRepo) (*uint64, error) {
+	indexBootstrap, err := indexBootstrapView.Find()
+	if err != nil {
+		if err == adapter.ErrNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding index bootstrap: %v", err)
+	}
+
+	historyAvailableFromHeight := HistoryAvailableFromHeight(indexBootstrap)
+	return &historyAvailableFromHeight, nil
+}
@@ -0,0 +1,46 @@
+package httpapi
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+// MetricsMiddleware records the latency and status code of requests by the
+// path template of the matched route
+func MetricsMiddleware(metrics usecase.Metrics, routePath RoutePath) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			startedAt := time.Now()
+			statusRecorder := &statusRecordingResponseWriter{
+				ResponseWriter: resp,
+				statusCode:     http.StatusOK,
+			}
+
+			next.ServeHTTP(statusRecorder, req)
+
+			metrics.ObserveHistogram(
+				usecase.METRIC_HTTPAPI_REQUEST_DURATION_SECONDS,
+				time.Since(startedAt).Seconds(),
+				usecase.MetricLabels{
+					"route":  routePath.Template(req),
+					"method": req.Method,
+					"status": strconv.Itoa(statusRecorder.statusCode),
+				},
+			)
+		})
+	}
+}
+
+type statusRecordingResponseWriter struct {
+	http.ResponseWriter
+
+	statusCode int
+}
+
+func (writer *statusRecordingResponseWriter) WriteHeader(statusCode int) {
+	writer.statusCode = statusCode
+	writer.ResponseWriter.WriteHeader(statusCode)
+}
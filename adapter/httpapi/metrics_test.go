@@ -0,0 +1,67 @@
+package httpapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/adapter/httpapi"
+	. "github.com/crypto-com/chainindex/adapter/httpapi/test"
+	. "github.com/crypto-com/chainindex/adapter/httpapi/test/mock"
+	"github.com/crypto-com/chainindex/usecase"
+	. "github.com/crypto-com/chainindex/usecase/test/mock"
+)
+
+var _ = Describe("MetricsMiddleware", func() {
+	It("should observe request duration by route template, method and status code", func() {
+		mockMetrics := new(MockMetrics)
+		mockMetrics.On(
+			"ObserveHistogram", usecase.METRIC_HTTPAPI_REQUEST_DURATION_SECONDS, mock.Anything, mock.Anything,
+		).Return()
+		mockRoutePath := new(MockRoutePath)
+		mockRoutePath.On("Template", mock.Anything).Return("/chain/blocks/{hash_or_height}")
+
+		handler := httpapi.MetricsMiddleware(mockMetrics, mockRoutePath)(
+			http.HandlerFunc(func(resp http.ResponseWriter, _ *http.Request) {
+				resp.WriteHeader(http.StatusNotFound)
+			}),
+		)
+		handler.ServeHTTP(httptest.NewRecorder(), NewMockHTTPGetRequest(HTTPQueryParams{}))
+
+		mockMetrics.AssertCalled(
+			GinkgoT(),
+			"ObserveHistogram",
+			usecase.METRIC_HTTPAPI_REQUEST_DURATION_SECONDS,
+			mock.Anything,
+			usecase.MetricLabels{
+				"route":  "/chain/blocks/{hash_or_height}",
+				"method": "GET",
+				"status": "404",
+			},
+		)
+	})
+
+	It("should record status code 200 when handler does not write header explicitly", func() {
+		var observedLabels usecase.MetricLabels
+		mockMetrics := new(MockMetrics)
+		mockMetrics.On(
+			"ObserveHistogram", usecase.METRIC_HTTPAPI_REQUEST_DURATION_SECONDS, mock.Anything, mock.Anything,
+		).Run(func(args mock.Arguments) {
+			observedLabels = args.Get(2).(usecase.MetricLabels)
+		}).Return()
+		mockRoutePath := new(MockRoutePath)
+		mockRoutePath.On("Template", mock.Anything).Return("/chain/status")
+
+		handler := httpapi.MetricsMiddleware(mockMetrics, mockRoutePath)(
+			http.HandlerFunc(func(resp http.ResponseWriter, _ *http.Request) {
+				_, _ = resp.Write([]byte("{}"))
+			}),
+		)
+		handler.ServeHTTP(httptest.NewRecorder(), NewMockHTTPGetRequest(HTTPQueryParams{}))
+
+		Expect(observedLabels["status"]).To(Equal("200"))
+	})
+})
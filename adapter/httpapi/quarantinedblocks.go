@@ -0,0 +1,95 @@
+package httpapi
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/usecase"
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+)
+
+// QuarantinedBlocksHandler serves the admin endpoints to inspect and retry
+// blocks failing deterministically
+type QuarantinedBlocksHandler struct {
+	logger usecase.Logger
+
+	routePath            RoutePath
+	quarantinedBlockView viewrepo.QuarantinedBlockViewRepo
+	retrier              usecase.QuarantinedBlockRetrier
+}
+
+func NewQuarantinedBlocksHandler(
+	logger usecase.Logger,
+	routePath RoutePath,
+	quarantinedBlockView viewrepo.QuarantinedBlockViewRepo,
+	retrier usecase.QuarantinedBlockRetrier,
+) *QuarantinedBlocksHandler {
+	return &QuarantinedBlocksHandler{
+		logger: logger.WithFields(usecase.LogFields{
+			"module": "QuarantinedBlocksHandler",
+		}),
+
+		routePath:            routePath,
+		quarantinedBlockView: quarantinedBlockView,
+		retrier:              retrier,
+	}
+}
+
+func (handler *QuarantinedBlocksHandler) ListQuarantinedBlocks(resp http.ResponseWriter, req *http.Request) {
+	var err error
+
+	pagination, err := ParsePagination(req)
+	if err != nil {
+		BadRequest(resp, err)
+		return
+	}
+
+	quarantinedBlocks, paginationResult, err := handler.quarantinedBlockView.ListQuarantinedBlocks(pagination)
+	if err != nil {
+		handler.logger.Errorf("error listing quarantined blocks: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	SuccessWithPagination(resp, quarantinedBlocks, paginationResult)
+}
+
+func (handler *QuarantinedBlocksHandler) RetryQuarantinedBlock(resp http.ResponseWriter, req *http.Request) {
+	var err error
+
+	routeVars := handler.routePath.Vars(req)
+	height, err := strconv.ParseUint(routeVars["height"], 10, 64)
+	if err != nil {
+		BadRequest(resp, errors.New("invalid height path parameter"))
+		return
+	}
+
+	if _, err = handler.quarantinedBlockView.FindQuarantinedBlock(height); err != nil {
+		if err == adapter.ErrNotFound {
+			NotFound(resp)
+			return
+		}
+		handler.logger.Errorf("error finding quarantined block: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	if err = handler.retrier.Retry(height); err != nil {
+		if errors.Is(err, usecase.ErrQuarantinedBlockOutOfOrder) {
+			Conflict(resp, err)
+			return
+		}
+		// The block stays in quarantine with the latest error
+		if adapter.IsBlockDataError(err) {
+			UnprocessableEntity(resp, err)
+			return
+		}
+		handler.logger.Errorf("error retrying quarantined block: %v", err)
+		InternalServerError(resp)
+		return
+	}
+
+	Success(resp, nil)
+}
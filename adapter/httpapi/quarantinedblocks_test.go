@@ -0,0 +1,182 @@
+package httpapi_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/adapter/httpapi"
+	. "github.com/crypto-com/chainindex/adapter/httpapi/test"
+	. "github.com/crypto-com/chainindex/adapter/httpapi/test/mock"
+	"github.com/crypto-com/chainindex/usecase"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+	. "github.com/crypto-com/chainindex/usecase/test/mock"
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+	. "github.com/crypto-com/chainindex/usecase/viewrepo/test/mock"
+)
+
+var _ = Describe("QuarantinedBlocks", func() {
+	var mockQuarantinedBlockViewRepo *MockQuarantinedBlockViewRepo
+	var mockRetrier *MockQuarantinedBlockRetrier
+	var mockRoutePath *MockRoutePath
+	var mockHandler *httpapi.QuarantinedBlocksHandler
+
+	BeforeEach(func() {
+		fakeLogger := &FakeLogger{}
+		mockQuarantinedBlockViewRepo = &MockQuarantinedBlockViewRepo{}
+		mockRetrier = &MockQuarantinedBlockRetrier{}
+		mockRoutePath = &MockRoutePath{}
+
+		mockHandler = httpapi.NewQuarantinedBlocksHandler(
+			fakeLogger, mockRoutePath, mockQuarantinedBlockViewRepo, mockRetrier,
+		)
+	})
+
+	Describe("ListQuarantinedBlocks", func() {
+		It("should return BadRequest when pagination is invalid", func() {
+			reqWithInvalidPage := NewMockHTTPGetRequest(HTTPQueryParams{
+				"page": "invalid",
+			})
+			respSpy := httptest.NewRecorder()
+
+			mockHandler.ListQuarantinedBlocks(respSpy, reqWithInvalidPage)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(400))
+		})
+
+		It("should return quarantined blocks", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockQuarantinedBlockViewRepo.On("ListQuarantinedBlocks", mock.Anything).Return(
+				[]viewrepo.QuarantinedBlock{
+					{
+						Height:   uint64(100),
+						Stage:    "parse",
+						Error:    "malformed block",
+						Payload:  []byte(`{}`),
+						Attempts: uint64(2),
+					},
+				},
+				viewrepo.NewOffsetPaginationResult(1, 1, 20),
+				nil,
+			)
+
+			mockHandler.ListQuarantinedBlocks(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			Expect(respSpy.Body.String()).To(ContainSubstring("\"height\":100"))
+			Expect(respSpy.Body.String()).To(ContainSubstring("\"attempts\":2"))
+		})
+	})
+
+	Describe("RetryQuarantinedBlock", func() {
+		It("should return BadRequest when height is invalid", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"height": "invalid",
+			})
+
+			mockHandler.RetryQuarantinedBlock(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(400))
+		})
+
+		It("should return NotFound when the block is not quarantined", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"height": "100",
+			})
+			mockQuarantinedBlockViewRepo.On("FindQuarantinedBlock", uint64(100)).Return(
+				(*viewrepo.QuarantinedBlock)(nil), adapter.ErrNotFound,
+			)
+
+			mockHandler.RetryQuarantinedBlock(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(404))
+			mockRetrier.AssertNotCalled(GinkgoT(), "Retry", mock.Anything)
+		})
+
+		It("should return UnprocessableEntity when the block still fails", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"height": "100",
+			})
+			mockQuarantinedBlockViewRepo.On("FindQuarantinedBlock", uint64(100)).Return(
+				&viewrepo.QuarantinedBlock{}, nil,
+			)
+			mockRetrier.On("Retry", uint64(100)).Return(
+				fmt.Errorf("error parsing block 100: %w", adapter.ErrMalformedBlock),
+			)
+
+			mockHandler.RetryQuarantinedBlock(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(422))
+			Expect(respSpy.Body.String()).To(ContainSubstring("malformed block"))
+		})
+
+		It("should return Conflict when the block does not follow the last stored block", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"height": "100",
+			})
+			mockQuarantinedBlockViewRepo.On("FindQuarantinedBlock", uint64(100)).Return(
+				&viewrepo.QuarantinedBlock{}, nil,
+			)
+			mockRetrier.On("Retry", uint64(100)).Return(
+				fmt.Errorf("error retrying quarantined block 100: %w", usecase.ErrQuarantinedBlockOutOfOrder),
+			)
+
+			mockHandler.RetryQuarantinedBlock(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(409))
+		})
+
+		It("should return InternalServerError when retrying fails for other reasons", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"height": "100",
+			})
+			mockQuarantinedBlockViewRepo.On("FindQuarantinedBlock", uint64(100)).Return(
+				&viewrepo.QuarantinedBlock{}, nil,
+			)
+			mockRetrier.On("Retry", uint64(100)).Return(errors.New("connection lost"))
+
+			mockHandler.RetryQuarantinedBlock(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(500))
+		})
+
+		It("should return Success when the block is released", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockRoutePath.On("Vars", mock.Anything).Return(map[string]string{
+				"height": "100",
+			})
+			mockQuarantinedBlockViewRepo.On("FindQuarantinedBlock", uint64(100)).Return(
+				&viewrepo.QuarantinedBlock{}, nil,
+			)
+			mockRetrier.On("Retry", uint64(100)).Return(nil)
+
+			mockHandler.RetryQuarantinedBlock(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+		})
+	})
+})
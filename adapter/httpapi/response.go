@@ -8,10 +8,22 @@ import (
 )
 
 func Success(resp http.ResponseWriter, result interface{}) {
+	SuccessWithHistory(resp, result, nil)
+}
+
+// SuccessWithHistory responds with the earliest block height with history
+// available when the result is incomplete because the index is bootstrapped
+// from a state snapshot
+func SuccessWithHistory(
+	resp http.ResponseWriter,
+	result interface{},
+	maybeHistoryAvailableFromHeight *uint64,
+) {
 	resp.Header().Set("Content-Type", "application/json")
 	err := jsoniter.NewEncoder(resp).Encode(Response{
-		Result: result,
-		Err:    "",
+		Result:                          result,
+		Err:                             "",
+		MaybeHistoryAvailableFromHeight: maybeHistoryAvailableFromHeight,
 	})
 	if err != nil {
 		InternalServerError(resp)
@@ -22,12 +34,22 @@ func SuccessWithPagination(
 	resp http.ResponseWriter,
 	result interface{},
 	paginationResult *viewrepo.PaginationResult,
+) {
+	SuccessWithPaginationAndHistory(resp, result, paginationResult, nil)
+}
+
+func SuccessWithPaginationAndHistory(
+	resp http.ResponseWriter,
+	result interface{},
+	paginationResult *viewrepo.PaginationResult,
+	maybeHistoryAvailableFromHeight *uint64,
 ) {
 	resp.Header().Set("Content-Type", "application/json")
 	err := jsoniter.NewEncoder(resp).Encode(PagedResponse{
 		Response: Response{
-			Result: result,
-			Err:    "",
+			Result:                          result,
+			Err:                             "",
+			MaybeHistoryAvailableFromHeight: maybeHistoryAvailableFromHeight,
 		},
 		OffsetPagination: OptPaginationOffsetResponseFromResult(paginationResult.OffsetResult()),
 	})
@@ -64,6 +86,45 @@ func BadRequest(resp http.ResponseWriter, errResp error) {
 	http.Error(resp, string(message), 400)
 }
 
+func Conflict(resp http.ResponseWriter, errResp error) {
+	resp.Header().Set("Content-Type", "application/json")
+	message, err := jsoniter.Marshal(Response{
+		Err: errResp.Error(),
+	})
+	if err != nil {
+		InternalServerError(resp)
+		return
+	}
+
+	http.Error(resp, string(message), 409)
+}
+
+func UnprocessableEntity(resp http.ResponseWriter, errResp error) {
+	resp.Header().Set("Content-Type", "application/json")
+	message, err := jsoniter.Marshal(Response{
+		Err: errResp.Error(),
+	})
+	if err != nil {
+		InternalServerError(resp)
+		return
+	}
+
+	http.Error(resp, string(message), 422)
+}
+
+func Gone(resp http.ResponseWriter, errResp error) {
+	resp.Header().Set("Content-Type", "application/json")
+	message, err := jsoniter.Marshal(Response{
+		Err: errResp.Error(),
+	})
+	if err != nil {
+		InternalServerError(resp)
+		return
+	}
+
+	http.Error(resp, string(message), 410)
+}
+
 func InternalServerError(resp http.ResponseWriter) {
 	resp.Header().Set("Content-Type", "application/json")
 	message, _ := jsoniter.Marshal(Response{
@@ -81,6 +142,9 @@ type PagedResponse struct {
 type Response struct {
 	Result interface{} `json:"result"`
 	Err    string      `json:"error,omitempty"`
+	// Only present when the index is bootstrapped from a state snapshot and
+	// results before the height are unavailable
+	MaybeHistoryAvailableFromHeight *uint64 `json:"history_available_from_height,omitempty"`
 }
 
 type PaginationOffsetResponse struct {
@@ -5,9 +5,12 @@ import "net/http"
 type Router interface {
 	Use(middlewar func(http.Handler) http.Handler)
 	Get(path string, handler func(http.ResponseWriter, *http.Request))
+	Post(path string, handler func(http.ResponseWriter, *http.Request))
 	Handler() http.Handler
 }
 
 type RoutePath interface {
 	Vars(*http.Request) map[string]string
+	// Template returns the path template of the route matching the request
+	Template(*http.Request) string
 }
@@ -5,10 +5,14 @@ type RoutesRegistry struct {
 
 	statusHandler       *StatusHandler
 	chainStatusHandler  *ChainStatusHandler
+	chainParamsHandler  *ChainParamsHandler
 	activitiesHandler   *ActivitiesHandler
 	blocksHandler       *BlocksHandler
 	councilNodesHandler *CouncilNodesHandler
 	searchHandler       *SearchHandler
+
+	// Admin endpoints are only registered when the handler is provided
+	quarantinedBlocksHandler *QuarantinedBlocksHandler
 }
 
 func NewRoutesRegistry(
@@ -16,20 +20,26 @@ func NewRoutesRegistry(
 
 	statusHandler *StatusHandler,
 	chainStatusHandler *ChainStatusHandler,
+	chainParamsHandler *ChainParamsHandler,
 	activitiesHandler *ActivitiesHandler,
 	blocksHandler *BlocksHandler,
 	councilNodesHandler *CouncilNodesHandler,
 	searchHandler *SearchHandler,
+
+	quarantinedBlocksHandler *QuarantinedBlocksHandler,
 ) *RoutesRegistry {
 	return &RoutesRegistry{
 		router,
 
 		statusHandler,
 		chainStatusHandler,
+		chainParamsHandler,
 		activitiesHandler,
 		blocksHandler,
 		councilNodesHandler,
 		searchHandler,
+
+		quarantinedBlocksHandler,
 	}
 }
 
@@ -38,6 +48,7 @@ func (api *RoutesRegistry) RegisterHandlers() {
 	api.router.Get("/status", api.statusHandler.Status)
 
 	api.router.Get("/chain/status", api.chainStatusHandler.GetChainStatus)
+	api.router.Get("/chain/params", api.chainParamsHandler.GetChainParams)
 
 	api.router.Get("/chain/blocks", api.blocksHandler.ListBlocks)
 	api.router.Get("/chain/blocks/{hash_or_height}", api.blocksHandler.FindBlock)
@@ -45,13 +56,24 @@ func (api *RoutesRegistry) RegisterHandlers() {
 	api.router.Get("/chain/blocks/{hash_or_height}/events", api.blocksHandler.ListBlockEvents)
 
 	api.router.Get("/chain/transactions", api.activitiesHandler.ListTransactions)
+	// Registered before the txid route so that it is not matched as a txid
+	api.router.Get("/chain/transactions/pending", api.activitiesHandler.ListPendingTransactions)
 	api.router.Get("/chain/transactions/{txid}", api.activitiesHandler.FindTransactionByTxId)
 	api.router.Get("/chain/events", api.activitiesHandler.ListEvents)
 	api.router.Get("/chain/events/{height}-{position}", api.activitiesHandler.FindEventByBlockHeightEventPosition)
 
 	api.router.Get("/chain/council-nodes", api.councilNodesHandler.ListActive)
 	api.router.Get("/chain/council-nodes/{id}", api.councilNodesHandler.FindById)
+	api.router.Get("/chain/council-nodes/{id}/stats", api.councilNodesHandler.FindStatsById)
 	api.router.Get("/chain/council-nodes/{id}/activities", api.councilNodesHandler.ListActivitiesById)
+	api.router.Get("/chain/council-nodes/{id}/power-history", api.councilNodesHandler.ListPowerHistoryById)
+	api.router.Get("/chain/council-nodes/{id}/evidence", api.councilNodesHandler.ListEvidencesById)
+	api.router.Get("/chain/validators", api.councilNodesHandler.ListValidators)
 
 	api.router.Get("/chain/search/all", api.searchHandler.All)
+
+	if api.quarantinedBlocksHandler != nil {
+		api.router.Get("/admin/quarantined-blocks", api.quarantinedBlocksHandler.ListQuarantinedBlocks)
+		api.router.Post("/admin/quarantined-blocks/{height}/retry", api.quarantinedBlocksHandler.RetryQuarantinedBlock)
+	}
 }
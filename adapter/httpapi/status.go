@@ -2,14 +2,23 @@ package httpapi
 
 import (
 	"net/http"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
+
+	"github.com/crypto-com/chainindex/adapter/tendermint"
 )
 
-type StatusHandler struct{}
+type StatusHandler struct {
+	tendermintEndpoints tendermint.EndpointStatusProvider
+}
 
-func NewStatusHandler() *StatusHandler {
-	return &StatusHandler{}
+// NewStatusHandler creates the handler. Tendermint is always reported healthy
+// when the endpoint status provider is nil
+func NewStatusHandler(tendermintEndpoints tendermint.EndpointStatusProvider) *StatusHandler {
+	return &StatusHandler{
+		tendermintEndpoints,
+	}
 }
 
 func (handler *StatusHandler) Health(resp http.ResponseWriter, _ *http.Request) {
@@ -17,17 +26,61 @@ func (handler *StatusHandler) Health(resp http.ResponseWriter, _ *http.Request)
 }
 
 func (handler *StatusHandler) Status(resp http.ResponseWriter, _ *http.Request) {
-	resp.Header().Set("Content-Type", "application/json")
-	message, _ := jsoniter.Marshal(Status{
+	status := Status{
 		ServerStatus:     SERVER_STATUS_HEALTHY,
 		TendermintStatus: TENDERMINT_STATUS_HEALTHY,
-	})
+	}
+	if handler.tendermintEndpoints != nil {
+		status.TendermintStatus, status.TendermintEndpoints = handler.tendermintEndpointsStatus()
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	message, _ := jsoniter.Marshal(status)
 	http.Error(resp, string(message), 200)
 }
 
+// Tendermint is degraded when some of the endpoints are unhealthy, and offline
+// when all of them are
+func (handler *StatusHandler) tendermintEndpointsStatus() (TendermintStatus, []TendermintEndpointStatus) {
+	endpointStatuses := handler.tendermintEndpoints.EndpointStatuses()
+
+	healthyCount := 0
+	endpoints := make([]TendermintEndpointStatus, 0, len(endpointStatuses))
+	for _, endpointStatus := range endpointStatuses {
+		if endpointStatus.Healthy {
+			healthyCount += 1
+		}
+		endpoints = append(endpoints, TendermintEndpointStatus{
+			URL:               endpointStatus.URL,
+			Healthy:           endpointStatus.Healthy,
+			LatestBlockHeight: endpointStatus.LatestBlockHeight,
+			LastError:         endpointStatus.LastError,
+			LastCheckedAt:     endpointStatus.LastCheckedAt,
+		})
+	}
+
+	switch healthyCount {
+	case len(endpointStatuses):
+		return TENDERMINT_STATUS_HEALTHY, endpoints
+	case 0:
+		return TENDERMINT_STATUS_OFFLINE, endpoints
+	default:
+		return TENDERMINT_STATUS_DEGRADED, endpoints
+	}
+}
+
 type Status struct {
-	ServerStatus     ServerStatus     `json:"server_status"`
-	TendermintStatus TendermintStatus `json:"tendermint_status"`
+	ServerStatus        ServerStatus               `json:"server_status"`
+	TendermintStatus    TendermintStatus           `json:"tendermint_status"`
+	TendermintEndpoints []TendermintEndpointStatus `json:"tendermint_endpoints,omitempty"`
+}
+
+type TendermintEndpointStatus struct {
+	URL               string     `json:"url"`
+	Healthy           bool       `json:"healthy"`
+	LatestBlockHeight uint64     `json:"latest_block_height"`
+	LastError         *string    `json:"last_error"`
+	LastCheckedAt     *time.Time `json:"last_checked_at"`
 }
 
 type ServerStatus = string
@@ -0,0 +1,73 @@
+package httpapi_test
+
+import (
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chainindex/adapter/httpapi"
+	. "github.com/crypto-com/chainindex/adapter/httpapi/test"
+	"github.com/crypto-com/chainindex/adapter/tendermint"
+	. "github.com/crypto-com/chainindex/adapter/tendermint/test/mock"
+	"github.com/crypto-com/chainindex/internal/primptr"
+)
+
+var _ = Describe("Status", func() {
+	Describe("Status", func() {
+		It("should return healthy Tendermint status when no endpoint status provider is given", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			httpapi.NewStatusHandler(nil).Status(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			Expect(respSpy.Body.String()).To(ContainSubstring("\"tendermint_status\":\"healthy\""))
+			Expect(respSpy.Body.String()).NotTo(ContainSubstring("tendermint_endpoints"))
+		})
+
+		It("should return degraded Tendermint status with per-endpoint status when some endpoints are unhealthy", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockProvider := new(MockEndpointStatusProvider)
+			mockProvider.On("EndpointStatuses").Return([]tendermint.EndpointStatus{
+				{
+					URL:               "http://node-1:26657",
+					Healthy:           true,
+					LatestBlockHeight: uint64(3600),
+				},
+				{
+					URL:               "http://node-2:26657",
+					Healthy:           false,
+					LatestBlockHeight: uint64(3000),
+					LastError:         primptr.String("connection refused"),
+				},
+			})
+
+			httpapi.NewStatusHandler(mockProvider).Status(respSpy, anyReq)
+
+			Expect(respSpy.Result().StatusCode).To(Equal(200))
+			Expect(respSpy.Body.String()).To(ContainSubstring("\"tendermint_status\":\"degraded\""))
+			Expect(respSpy.Body.String()).To(ContainSubstring("\"url\":\"http://node-2:26657\""))
+			Expect(respSpy.Body.String()).To(ContainSubstring("\"last_error\":\"connection refused\""))
+		})
+
+		It("should return offline Tendermint status when all endpoints are unhealthy", func() {
+			anyReq := NewMockHTTPGetRequest(HTTPQueryParams{})
+			respSpy := httptest.NewRecorder()
+
+			mockProvider := new(MockEndpointStatusProvider)
+			mockProvider.On("EndpointStatuses").Return([]tendermint.EndpointStatus{
+				{
+					URL:     "http://node-1:26657",
+					Healthy: false,
+				},
+			})
+
+			httpapi.NewStatusHandler(mockProvider).Status(respSpy, anyReq)
+
+			Expect(respSpy.Body.String()).To(ContainSubstring("\"tendermint_status\":\"offline\""))
+		})
+	})
+})
@@ -15,3 +15,9 @@ func (path *MockRoutePath) Vars(req *http.Request) map[string]string {
 
 	return args.Get(0).(map[string]string)
 }
+
+func (path *MockRoutePath) Template(req *http.Request) string {
+	args := path.Called(req)
+
+	return args.String(0)
+}
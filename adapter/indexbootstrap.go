@@ -0,0 +1,240 @@
+package adapter
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/crypto-com/chainindex"
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+// RDbIndexBootstrapRepo seeds an empty index with a state snapshot. The block
+// at the snapshot height is stored without its signatures and activities, so
+// that synchronization resumes from the next height
+type RDbIndexBootstrapRepo struct {
+	conn        RDbConn
+	stmtBuilder sq.StatementBuilderType
+	typeConv    RDbTypeConv
+
+	blockDataRepo *RDbBlockDataRepo
+}
+
+func NewRDbIndexBootstrapRepo(
+	conn RDbConn,
+	stmtBuilder sq.StatementBuilderType,
+	typeConv RDbTypeConv,
+
+	blockDataRepo *RDbBlockDataRepo,
+) *RDbIndexBootstrapRepo {
+	return &RDbIndexBootstrapRepo{
+		conn,
+		stmtBuilder,
+		typeConv,
+
+		blockDataRepo,
+	}
+}
+
+// Bootstrap stores the snapshot block, council nodes, staking accounts, chain
+// params and sync state in a single transaction. It returns ErrAlreadyIndexed
+// when any block is already stored
+func (repo *RDbIndexBootstrapRepo) Bootstrap(snapshot *usecase.StateSnapshot, bootstrappedAt time.Time) error {
+	var err error
+
+	tx, err := repo.conn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v: %w", err, ErrRepoOpen)
+	}
+	defer func() {
+		// Calling rollback on committed transaction has no effect
+		_ = tx.Rollback()
+	}()
+
+	if err = repo.ensureNoStoredBlock(tx); err != nil {
+		return err
+	}
+
+	isInserted, err := repo.blockDataRepo.insertBlock(tx, &snapshot.Block, nil)
+	if err != nil {
+		return err
+	}
+	// A block stored concurrently after the check is not overwritten
+	if !isInserted {
+		return ErrAlreadyIndexed
+	}
+
+	for i := range snapshot.StakingAccounts {
+		if err = repo.insertStakingAccount(tx, &snapshot.StakingAccounts[i]); err != nil {
+			return err
+		}
+	}
+
+	if snapshot.ChainParams != nil {
+		if err = repo.blockDataRepo.insertChainParams(tx, snapshot.ChainParams); err != nil {
+			return err
+		}
+	}
+
+	if err = repo.insertIndexBootstrap(tx, snapshot, bootstrappedAt); err != nil {
+		return err
+	}
+
+	// Synchronization resumes from the block after the snapshot
+	if err = repo.blockDataRepo.checkpointSyncState(tx, &syncStateCheckpoint{
+		lastStoredHeight:             snapshot.Block.Height,
+		lastObservedTendermintHeight: snapshot.Block.Height,
+		maybeChainID:                 &snapshot.ChainID,
+	}, bootstrappedAt); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error commiting index bootstrap: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+func (repo *RDbIndexBootstrapRepo) ensureNoStoredBlock(tx RDbTx) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Select(
+		"height",
+	).From(
+		"blocks",
+	).Limit(1).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building stored block query SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	var height uint64
+	if err = tx.QueryRow(sql, sqlArgs...).Scan(&height); err != nil {
+		if err == ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("error querying stored block: %v: %w", err, ErrRepoQuery)
+	}
+
+	return fmt.Errorf("error bootstrapping index: block %d is already stored: %w", height, ErrAlreadyIndexed)
+}
+
+func (repo *RDbIndexBootstrapRepo) insertStakingAccount(tx RDbTx, account *chainindex.StakingAccount) error {
+	var err error
+
+	var councilNodeId *uint64
+	if account.MaybeCurrentCouncilNode != nil {
+		if councilNodeId, err = repo.insertCouncilNode(tx, account.MaybeCurrentCouncilNode); err != nil {
+			return err
+		}
+	}
+
+	sql, sqlArgs, err := repo.stmtBuilder.Insert(
+		"staking_accounts",
+	).Columns(
+		"address",
+		"nonce",
+		"bonded",
+		"unbonded",
+		"unbonded_from",
+		"jailed_until",
+		"punishment_kind",
+		"current_council_node_id",
+	).Values(
+		account.Address,
+		account.Nonce,
+		repo.typeConv.Bton(account.Bonded),
+		repo.typeConv.Bton(account.Unbonded),
+		repo.typeConv.Tton(account.MaybeUnbondedFrom),
+		repo.typeConv.Tton(account.MaybeJailedUntil),
+		OptPunishmentKindToString(account.MaybePunishmentKind),
+		councilNodeId,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building staking account insert SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	result, err := tx.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting staking account into the table: %v: %w", err, ErrRepoWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting staking account into the table: no row inserted: %w", ErrRepoWrite)
+	}
+
+	return nil
+}
+
+func (repo *RDbIndexBootstrapRepo) insertCouncilNode(tx RDbTx, node *chainindex.CouncilNode) (*uint64, error) {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Insert(
+		"council_nodes",
+	).Columns(
+		"name",
+		"security_contact",
+		"pubkey_type",
+		"pubkey",
+		"address",
+		"created_at_block_height",
+		"last_left_at_block_height",
+	).Values(
+		node.Name,
+		node.MaybeSecurityContact,
+		PubKeyTypeToString(node.PubKeyType),
+		node.PubKey,
+		node.Address,
+		node.CreatedAtBlockHeight,
+		node.MaybeLastLeftAtBlockHeight,
+	).Suffix(
+		"RETURNING id",
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building council node insertion SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	var councilNodeId uint64
+	if err = tx.QueryRow(sql, sqlArgs...).Scan(&councilNodeId); err != nil {
+		return nil, fmt.Errorf("error inserting council node into table: %v: %w", err, ErrRepoWrite)
+	}
+
+	return &councilNodeId, nil
+}
+
+func (repo *RDbIndexBootstrapRepo) insertIndexBootstrap(
+	tx RDbTx,
+	snapshot *usecase.StateSnapshot,
+	bootstrappedAt time.Time,
+) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Insert(
+		"index_bootstrap",
+	).Columns(
+		"height",
+		"block_hash",
+		"chain_id",
+		"source",
+		"bootstrapped_at",
+	).Values(
+		snapshot.Block.Height,
+		snapshot.Block.Hash,
+		snapshot.ChainID,
+		snapshot.Source,
+		repo.typeConv.Tton(&bootstrappedAt),
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building index bootstrap insertion SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	result, err := tx.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting index bootstrap into the table: %v: %w", err, ErrRepoWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting index bootstrap into the table: no row inserted: %w", ErrRepoWrite)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package adapter_test
+
+import (
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex"
+	"github.com/crypto-com/chainindex/adapter"
+	. "github.com/crypto-com/chainindex/adapter/test/fake"
+	. "github.com/crypto-com/chainindex/adapter/test/mock"
+	"github.com/crypto-com/chainindex/internal/bignum"
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+const (
+	SQL_STORED_BLOCK_SELECT    = "SELECT height FROM blocks LIMIT 1"
+	SQL_INDEX_BOOTSTRAP_INSERT = "INSERT INTO index_bootstrap (height,block_hash,chain_id,source,bootstrapped_at) VALUES (?,?,?,?,?)"
+)
+
+var _ = Describe("RDbIndexBootstrapRepo", func() {
+	var mockConn *MockRDbConn
+	var mockTx *MockRDbTx
+	var repo *adapter.RDbIndexBootstrapRepo
+	BeforeEach(func() {
+		mockTx = new(MockRDbTx)
+		mockTx.On("Rollback").Return(nil)
+		mockConn = new(MockRDbConn)
+		mockConn.On("Begin").Return(mockTx, nil)
+
+		typeConv := new(PrimRDbTypeConv)
+		blockDataRepo := adapter.NewRDbBlockDataRepo(
+			mockConn, sq.StatementBuilder, typeConv, new(MockRDbBlockActivityDataRepo),
+		)
+		repo = adapter.NewRDbIndexBootstrapRepo(mockConn, sq.StatementBuilder, typeConv, blockDataRepo)
+	})
+
+	It("should implement IndexBootstrapRepository", func() {
+		var _ usecase.IndexBootstrapRepository = repo
+	})
+
+	Describe("Bootstrap", func() {
+		var snapshot *usecase.StateSnapshot
+		BeforeEach(func() {
+			snapshot = &usecase.StateSnapshot{
+				Block: chainindex.Block{
+					Height:  uint64(100000),
+					Hash:    "B0E3C0A1",
+					Time:    time.Unix(1591000000, 0),
+					AppHash: "F1A1C3B8",
+				},
+				StakingAccounts: []chainindex.StakingAccount{
+					{
+						Address:  "0x6dbd5b8fe0dad494465aa7574defba711c184102",
+						Nonce:    uint64(3),
+						Bonded:   bignum.Int0().SetUint64(600000),
+						Unbonded: bignum.Int0(),
+						MaybeCurrentCouncilNode: &chainindex.CouncilNode{
+							Name:                 "Crypto.com Council Node",
+							PubKeyType:           chainindex.PUBKEY_TYPE_ED25519,
+							PubKey:               "2lBGOGSKD8ffqaUDOHTamIoqfSUcKEXULwJqrbnQtzU=",
+							Address:              "1BBDF2D8E9E4E58FA4F7E0D8E8BB7E6D0B4E5A8C",
+							CreatedAtBlockHeight: uint64(100000),
+						},
+					},
+				},
+				ChainID: "testnet-thaler-crypto-com-chain-42",
+				Source:  "snapshot.json",
+			}
+		})
+
+		It("should return ErrAlreadyIndexed when any block is already stored", func() {
+			mockRowResult := new(MockRDbRowResult)
+			mockRowResult.On("Scan", mock.Anything).Return(nil)
+			mockTx.On("QueryRow", SQL_STORED_BLOCK_SELECT).Return(mockRowResult)
+
+			err := repo.Bootstrap(snapshot, time.Unix(1592000000, 0))
+
+			Expect(errors.Is(err, adapter.ErrAlreadyIndexed)).To(BeTrue())
+			mockTx.AssertNotCalled(GinkgoT(), "Commit")
+		})
+
+		It("should store the snapshot block, council nodes, staking accounts, bootstrap record and sync state", func() {
+			mockNoRowResult := new(MockRDbRowResult)
+			mockNoRowResult.On("Scan", mock.Anything).Return(adapter.ErrNoRows)
+			mockTx.On("QueryRow", SQL_STORED_BLOCK_SELECT).Return(mockNoRowResult)
+
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			OnTxInsertAnyBlock(mockTx).Return(mockExecResult, nil)
+
+			mockCouncilNodeIdResult := new(MockRDbRowResult)
+			mockCouncilNodeIdResult.On("Scan", mock.MatchedBy(func(id *uint64) bool {
+				*id = uint64(1)
+				return true
+			})).Return(nil)
+			mockTx.On("QueryRow", MockSQLWithAnyArgs(SQL_COUNCIL_NODE_INSERT, 7)...).Return(mockCouncilNodeIdResult)
+
+			mockTx.On("Exec",
+				SQL_STAKING_ACCOUNT_INSERT,
+				"0x6dbd5b8fe0dad494465aa7574defba711c184102",
+				uint64(3),
+				mock.Anything,
+				mock.Anything,
+				nil,
+				nil,
+				(*string)(nil),
+				mock.MatchedBy(func(councilNodeId *uint64) bool {
+					return *councilNodeId == uint64(1)
+				}),
+			).Return(mockExecResult, nil)
+
+			bootstrappedAt := time.Unix(1592000000, 0)
+			mockTx.On("Exec",
+				SQL_INDEX_BOOTSTRAP_INSERT,
+				uint64(100000),
+				"B0E3C0A1",
+				"testnet-thaler-crypto-com-chain-42",
+				"snapshot.json",
+				&bootstrappedAt,
+			).Return(mockExecResult, nil)
+			mockTx.On("Exec",
+				SQL_SYNC_STATE_CHECKPOINT_UPSERT,
+				uint64(100000),
+				uint64(100000),
+				mock.MatchedBy(func(chainID *string) bool {
+					return *chainID == "testnet-thaler-crypto-com-chain-42"
+				}),
+				adapter.BLOCK_DATA_PARSER_VERSION,
+				&bootstrappedAt,
+				&bootstrappedAt,
+			).Return(mockExecResult, nil)
+			mockTx.On("Commit").Return(nil)
+
+			err := repo.Bootstrap(snapshot, bootstrappedAt)
+
+			Expect(err).To(BeNil())
+			mockTx.AssertExpectations(GinkgoT())
+		})
+	})
+})
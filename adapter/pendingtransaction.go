@@ -0,0 +1,135 @@
+package adapter
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+// Transactions which left the mempool without being indexed are kept for this
+// long since they were last seen. It covers synchronization lagging behind the
+// committed blocks, while transactions evicted from the mempool still expire
+const PENDING_TRANSACTION_EXPIRY = 30 * time.Minute
+
+// RDbPendingTransactionRepo keeps transactions observed in the Tendermint
+// mempool in the pending_transactions table
+type RDbPendingTransactionRepo struct {
+	conn        RDbConn
+	stmtBuilder sq.StatementBuilderType
+	typeConv    RDbTypeConv
+}
+
+func NewRDbPendingTransactionRepo(
+	conn RDbConn,
+	stmtBuilder sq.StatementBuilderType,
+	typeConv RDbTypeConv,
+) *RDbPendingTransactionRepo {
+	return &RDbPendingTransactionRepo{
+		conn,
+		stmtBuilder,
+		typeConv,
+	}
+}
+
+// Replace deletes transactions no longer pending and upserts the pending
+// transactions in a single transaction, so that readers never see a partially
+// replaced set. A transaction no longer in the mempool is only deleted once it
+// is indexed or expired, so that it is still found while synchronization lags
+// behind the block including it
+func (repo *RDbPendingTransactionRepo) Replace(txs []usecase.PendingTransaction, observedAt time.Time) error {
+	var err error
+
+	tx, err := repo.conn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v: %w", err, ErrRepoOpen)
+	}
+	defer func() {
+		// Calling rollback on committed transaction has no effect
+		_ = tx.Rollback()
+	}()
+
+	expiredBefore := observedAt.Add(-PENDING_TRANSACTION_EXPIRY)
+	deleteStmtBuilder := repo.stmtBuilder.Delete(
+		"pending_transactions",
+	).Where(sq.Or{
+		sq.Expr("EXISTS (SELECT 1 FROM activities WHERE activities.txid = pending_transactions.txid)"),
+		sq.Lt{"last_seen_at": repo.typeConv.Tton(&expiredBefore)},
+	})
+	if len(txs) > 0 {
+		txids := make([]string, 0, len(txs))
+		for _, pendingTx := range txs {
+			txids = append(txids, pendingTx.TxID)
+		}
+		deleteStmtBuilder = deleteStmtBuilder.Where(sq.NotEq{"txid": txids})
+	}
+	sql, sqlArgs, err := deleteStmtBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("error building pending transactions deletion SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+	if _, err = tx.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error deleting pending transactions from the table: %v: %w", err, ErrRepoWrite)
+	}
+
+	if len(txs) > 0 {
+		stmtBuilder := repo.stmtBuilder.Insert(
+			"pending_transactions",
+		).Columns(
+			"txid",
+			"type",
+			"inputs",
+			"output_count",
+			"staking_account_address",
+			"first_seen_at",
+			"last_seen_at",
+		)
+		for _, pendingTx := range txs {
+			var inputsJSON *string
+			if len(pendingTx.MaybeTxInputs) > 0 {
+				var jsonStr string
+				jsonStr, err = jsoniter.MarshalToString(TxInputsToRDbTransferInputs(pendingTx.MaybeTxInputs))
+				if err != nil {
+					return fmt.Errorf("error marshalling pending transaction inputs: %v", err)
+				}
+
+				inputsJSON = &jsonStr
+			}
+
+			stmtBuilder = stmtBuilder.Values(
+				pendingTx.TxID,
+				ActivityTypeToString(pendingTx.Type),
+				inputsJSON,
+				pendingTx.MaybeOutputCount,
+				pendingTx.MaybeStakingAccountAddress,
+				repo.typeConv.Tton(&observedAt),
+				repo.typeConv.Tton(&observedAt),
+			)
+		}
+		sql, sqlArgs, err = stmtBuilder.Suffix(
+			"ON CONFLICT (txid) DO UPDATE SET last_seen_at = EXCLUDED.last_seen_at",
+		).ToSql()
+		if err != nil {
+			return fmt.Errorf("error building pending transactions insertion SQL: %v: %w", err, ErrBuildSQLStmt)
+		}
+
+		var result RDbExecResult
+		result, err = tx.Exec(sql, sqlArgs...)
+		if err != nil {
+			return fmt.Errorf("error inserting pending transactions into the table: %v: %w", err, ErrRepoWrite)
+		}
+		if result.RowsAffected() != int64(len(txs)) {
+			return fmt.Errorf(
+				"error inserting pending transactions into the table: %d rows inserted: %w", result.RowsAffected(), ErrRepoWrite,
+			)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error commiting pending transactions: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package adapter_test
+
+import (
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex"
+	"github.com/crypto-com/chainindex/adapter"
+	. "github.com/crypto-com/chainindex/adapter/test/fake"
+	. "github.com/crypto-com/chainindex/adapter/test/mock"
+	"github.com/crypto-com/chainindex/internal/primptr"
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+const (
+	SQL_PENDING_TRANSACTIONS_DELETE_ALL = "DELETE FROM pending_transactions " +
+		"WHERE (EXISTS (SELECT 1 FROM activities WHERE activities.txid = pending_transactions.txid) OR last_seen_at < ?)"
+	SQL_PENDING_TRANSACTIONS_DELETE = SQL_PENDING_TRANSACTIONS_DELETE_ALL + " AND txid NOT IN (?,?)"
+	SQL_PENDING_TRANSACTIONS_INSERT = "INSERT INTO pending_transactions (txid,type,inputs,output_count,staking_account_address,first_seen_at,last_seen_at) " +
+		"VALUES (?,?,?,?,?,?,?),(?,?,?,?,?,?,?) ON CONFLICT (txid) DO UPDATE SET last_seen_at = EXCLUDED.last_seen_at"
+)
+
+var _ = Describe("RDbPendingTransactionRepo", func() {
+	var mockConn *MockRDbConn
+	var mockTx *MockRDbTx
+	var repo *adapter.RDbPendingTransactionRepo
+	BeforeEach(func() {
+		mockTx = new(MockRDbTx)
+		mockTx.On("Rollback").Return(nil)
+		mockConn = new(MockRDbConn)
+		mockConn.On("Begin").Return(mockTx, nil)
+
+		repo = adapter.NewRDbPendingTransactionRepo(mockConn, sq.StatementBuilder, new(PrimRDbTypeConv))
+	})
+
+	It("should implement PendingTransactionRepository", func() {
+		var _ usecase.PendingTransactionRepository = repo
+	})
+
+	Describe("Replace", func() {
+		It("should delete transactions no longer pending and upsert the pending transactions", func() {
+			observedAt := time.Unix(1591000000, 0)
+			mockDeleteResult := new(MockRDbExecResult)
+			expiredBefore := observedAt.Add(-adapter.PENDING_TRANSACTION_EXPIRY)
+			mockTx.On("Exec",
+				SQL_PENDING_TRANSACTIONS_DELETE, &expiredBefore, "txid1", "txid2",
+			).Return(mockDeleteResult, nil)
+			mockInsertResult := new(MockRDbExecResult)
+			mockInsertResult.On("RowsAffected").Return(int64(2))
+			mockTx.On("Exec",
+				SQL_PENDING_TRANSACTIONS_INSERT,
+				"txid1",
+				"transfer",
+				primptr.String(`[{"prev_txid":"prevtxid","prev_output_index":1}]`),
+				primptr.Uint32(2),
+				(*string)(nil),
+				&observedAt,
+				&observedAt,
+				"txid2",
+				"unbond",
+				(*string)(nil),
+				(*uint32)(nil),
+				primptr.String("0xb328a39002ede64c33bb60f1dc43f5df9eb47043"),
+				&observedAt,
+				&observedAt,
+			).Return(mockInsertResult, nil)
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Replace([]usecase.PendingTransaction{
+				{
+					TxID: "txid1",
+					Type: chainindex.ACTIVITY_TRANSFER,
+					MaybeTxInputs: []chainindex.TxInput{
+						{TxId: "prevtxid", Index: uint32(1)},
+					},
+					MaybeOutputCount: primptr.Uint32(2),
+				},
+				{
+					TxID:                       "txid2",
+					Type:                       chainindex.ACTIVITY_UNBOND,
+					MaybeStakingAccountAddress: primptr.String("0xb328a39002ede64c33bb60f1dc43f5df9eb47043"),
+				},
+			}, observedAt)
+			Expect(err).To(BeNil())
+			mockTx.AssertExpectations(GinkgoT())
+		})
+
+		It("should delete indexed or expired transactions when the mempool is empty", func() {
+			observedAt := time.Unix(1591000000, 0)
+			expiredBefore := observedAt.Add(-adapter.PENDING_TRANSACTION_EXPIRY)
+			mockDeleteResult := new(MockRDbExecResult)
+			mockTx.On("Exec", SQL_PENDING_TRANSACTIONS_DELETE_ALL, &expiredBefore).Return(mockDeleteResult, nil)
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Replace([]usecase.PendingTransaction{}, observedAt)
+			Expect(err).To(BeNil())
+			mockTx.AssertExpectations(GinkgoT())
+			mockTx.AssertNumberOfCalls(GinkgoT(), "Exec", 1)
+		})
+
+		It("should keep transactions committed but not yet indexed after they leave the mempool", func() {
+			observedAt := time.Unix(1591000000, 0)
+			mockDeleteResult := new(MockRDbExecResult)
+			mockTx.On("Exec", SQL_PENDING_TRANSACTIONS_DELETE_ALL, mock.Anything).Return(mockDeleteResult, nil)
+			mockTx.On("Commit").Once().Return(nil)
+
+			err := repo.Replace([]usecase.PendingTransaction{}, observedAt)
+			Expect(err).To(BeNil())
+			// Only rows of indexed transactions or last seen before the expiry
+			// are deleted
+			mockTx.AssertNotCalled(GinkgoT(), "Exec", "DELETE FROM pending_transactions")
+			mockTx.AssertCalled(GinkgoT(), "Exec", mock.MatchedBy(func(sql string) bool {
+				return strings.Contains(sql, "EXISTS (SELECT 1 FROM activities WHERE activities.txid = pending_transactions.txid)")
+			}), mock.MatchedBy(func(expiredBefore *time.Time) bool {
+				return expiredBefore.Equal(observedAt.Add(-adapter.PENDING_TRANSACTION_EXPIRY))
+			}))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package adapter
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+// RDbQuarantinedBlockRepo keeps blocks failing deterministically in the
+// quarantined_blocks table
+type RDbQuarantinedBlockRepo struct {
+	conn        RDbConn
+	stmtBuilder sq.StatementBuilderType
+	typeConv    RDbTypeConv
+}
+
+func NewRDbQuarantinedBlockRepo(
+	conn RDbConn,
+	stmtBuilder sq.StatementBuilderType,
+	typeConv RDbTypeConv,
+) *RDbQuarantinedBlockRepo {
+	return &RDbQuarantinedBlockRepo{
+		conn,
+		stmtBuilder,
+		typeConv,
+	}
+}
+
+// Quarantine inserts the block into the table. When the block is already
+// quarantined, the error and payload are replaced and the attempts are
+// incremented
+func (repo *RDbQuarantinedBlockRepo) Quarantine(block *usecase.QuarantinedBlock) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Insert(
+		"quarantined_blocks",
+	).Columns(
+		"height",
+		"stage",
+		"error",
+		"payload",
+		"attempts",
+		"quarantined_at",
+		"last_attempted_at",
+	).Values(
+		block.Height,
+		block.Stage,
+		block.Error,
+		string(block.Payload),
+		1,
+		repo.typeConv.Tton(&block.QuarantinedAt),
+		repo.typeConv.Tton(&block.QuarantinedAt),
+	).Suffix(
+		"ON CONFLICT (height) DO UPDATE SET " +
+			"stage = EXCLUDED.stage, " +
+			"error = EXCLUDED.error, " +
+			"payload = EXCLUDED.payload, " +
+			"attempts = quarantined_blocks.attempts + 1, " +
+			"last_attempted_at = EXCLUDED.last_attempted_at",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building quarantined block insertion SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	result, err := repo.conn.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting quarantined block into the table: %v: %w", err, ErrRepoWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting quarantined block into the table: no rows inserted: %w", ErrRepoWrite)
+	}
+
+	return nil
+}
+
+// Release deletes the block from the table. It returns ErrNotFound when the
+// block is not quarantined
+func (repo *RDbQuarantinedBlockRepo) Release(height uint64) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Delete(
+		"quarantined_blocks",
+	).Where(
+		"height = ?", height,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building quarantined block deletion SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	result, err := repo.conn.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error deleting quarantined block from the table: %v: %w", err, ErrRepoWrite)
+	}
+	if result.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package adapter_test
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chainindex/adapter"
+	. "github.com/crypto-com/chainindex/adapter/test/fake"
+	. "github.com/crypto-com/chainindex/adapter/test/mock"
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+const (
+	SQL_QUARANTINED_BLOCK_INSERT = "INSERT INTO quarantined_blocks (height,stage,error,payload,attempts,quarantined_at,last_attempted_at) VALUES (?,?,?,?,?,?,?) " +
+		"ON CONFLICT (height) DO UPDATE SET stage = EXCLUDED.stage, error = EXCLUDED.error, payload = EXCLUDED.payload, " +
+		"attempts = quarantined_blocks.attempts + 1, last_attempted_at = EXCLUDED.last_attempted_at"
+	SQL_QUARANTINED_BLOCK_DELETE = "DELETE FROM quarantined_blocks WHERE height = ?"
+)
+
+var _ = Describe("RDbQuarantinedBlockRepo", func() {
+	var mockConn *MockRDbConn
+	var repo *adapter.RDbQuarantinedBlockRepo
+	BeforeEach(func() {
+		mockConn = new(MockRDbConn)
+		repo = adapter.NewRDbQuarantinedBlockRepo(mockConn, sq.StatementBuilder, new(PrimRDbTypeConv))
+	})
+
+	It("should implement QuarantinedBlockRepository", func() {
+		var _ usecase.QuarantinedBlockRepository = repo
+	})
+
+	Describe("Quarantine", func() {
+		It("should upsert the block with its error and payload", func() {
+			quarantinedAt := time.Unix(1591000000, 0)
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			mockConn.On("Exec",
+				SQL_QUARANTINED_BLOCK_INSERT,
+				uint64(100),
+				usecase.QUARANTINE_STAGE_PARSE,
+				"error parsing block: malformed block",
+				`{"height":"100"}`,
+				1,
+				&quarantinedAt,
+				&quarantinedAt,
+			).Return(mockExecResult, nil)
+
+			err := repo.Quarantine(&usecase.QuarantinedBlock{
+				Height:  uint64(100),
+				Stage:   usecase.QUARANTINE_STAGE_PARSE,
+				Error:   "error parsing block: malformed block",
+				Payload: []byte(`{"height":"100"}`),
+
+				QuarantinedAt: quarantinedAt,
+			})
+			Expect(err).To(BeNil())
+			mockConn.AssertExpectations(GinkgoT())
+		})
+	})
+
+	Describe("Release", func() {
+		It("should delete the block", func() {
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			mockConn.On("Exec", SQL_QUARANTINED_BLOCK_DELETE, uint64(100)).Return(mockExecResult, nil)
+
+			err := repo.Release(uint64(100))
+			Expect(err).To(BeNil())
+		})
+
+		It("should return ErrNotFound when the block is not quarantined", func() {
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(0))
+			mockConn.On("Exec", SQL_QUARANTINED_BLOCK_DELETE, uint64(100)).Return(mockExecResult, nil)
+
+			err := repo.Release(uint64(100))
+			Expect(err).To(Equal(adapter.ErrNotFound))
+		})
+	})
+})
@@ -0,0 +1,169 @@
+package adapter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/crypto-com/chainindex/adapter/tendermint"
+)
+
+const (
+	RAW_BLOCK_ARCHIVE_KIND_GENESIS       = "genesis"
+	RAW_BLOCK_ARCHIVE_KIND_BLOCK         = "block"
+	RAW_BLOCK_ARCHIVE_KIND_BLOCK_RESULTS = "block_results"
+)
+
+// Genesis is archived at a height before the first block
+const RAW_BLOCK_ARCHIVE_GENESIS_HEIGHT = uint64(0)
+
+// RDbRawBlockArchive archives gzip-compressed Tendermint responses in the
+// raw_block_archive table
+type RDbRawBlockArchive struct {
+	conn        RDbConn
+	stmtBuilder sq.StatementBuilderType
+}
+
+func NewRDbRawBlockArchive(conn RDbConn, stmtBuilder sq.StatementBuilderType) *RDbRawBlockArchive {
+	return &RDbRawBlockArchive{
+		conn,
+		stmtBuilder,
+	}
+}
+
+func (archive *RDbRawBlockArchive) StoreGenesis(rawGenesis []byte) error {
+	return archive.store(RAW_BLOCK_ARCHIVE_GENESIS_HEIGHT, RAW_BLOCK_ARCHIVE_KIND_GENESIS, rawGenesis)
+}
+
+func (archive *RDbRawBlockArchive) StoreBlock(height uint64, rawBlock []byte) error {
+	return archive.store(height, RAW_BLOCK_ARCHIVE_KIND_BLOCK, rawBlock)
+}
+
+func (archive *RDbRawBlockArchive) StoreBlockResults(height uint64, rawBlockResults []byte) error {
+	return archive.store(height, RAW_BLOCK_ARCHIVE_KIND_BLOCK_RESULTS, rawBlockResults)
+}
+
+func (archive *RDbRawBlockArchive) store(height uint64, kind string, raw []byte) error {
+	var err error
+
+	compressed, err := compressRawResp(raw)
+	if err != nil {
+		return fmt.Errorf("error compressing raw %s: %v: %w", kind, err, ErrRepoPrepare)
+	}
+
+	// Archive is append-only. Existing record is kept untouched
+	sql, sqlArgs, err := archive.stmtBuilder.Insert(
+		"raw_block_archive",
+	).Columns(
+		"height",
+		"kind",
+		"data",
+	).Values(height, kind, compressed).Suffix("ON CONFLICT (height, kind) DO NOTHING").ToSql()
+	if err != nil {
+		return fmt.Errorf("error building raw %s insertion SQL: %v: %w", kind, err, ErrBuildSQLStmt)
+	}
+
+	if _, err = archive.conn.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error inserting raw %s into the table: %v: %w", kind, err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+func (archive *RDbRawBlockArchive) FindGenesis() ([]byte, error) {
+	return archive.find(RAW_BLOCK_ARCHIVE_GENESIS_HEIGHT, RAW_BLOCK_ARCHIVE_KIND_GENESIS)
+}
+
+func (archive *RDbRawBlockArchive) FindBlock(height uint64) ([]byte, error) {
+	return archive.find(height, RAW_BLOCK_ARCHIVE_KIND_BLOCK)
+}
+
+func (archive *RDbRawBlockArchive) FindBlockResults(height uint64) ([]byte, error) {
+	return archive.find(height, RAW_BLOCK_ARCHIVE_KIND_BLOCK_RESULTS)
+}
+
+func (archive *RDbRawBlockArchive) find(height uint64, kind string) ([]byte, error) {
+	var err error
+
+	sql, sqlArgs, err := archive.stmtBuilder.Select(
+		"data",
+	).From(
+		"raw_block_archive",
+	).Where(
+		"height = ? AND kind = ?", height, kind,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building raw %s selection SQL: %v: %w", kind, err, ErrBuildSQLStmt)
+	}
+
+	var compressed []byte
+	if err = archive.conn.QueryRow(sql, sqlArgs...).Scan(&compressed); err != nil {
+		if err == ErrNoRows {
+			return nil, tendermint.ErrNotArchived
+		}
+		return nil, fmt.Errorf("error scanning raw %s row: %v: %w", kind, err, ErrRepoQuery)
+	}
+
+	raw, err := decompressRawResp(compressed)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing raw %s: %v: %w", kind, err, ErrRepoQuery)
+	}
+
+	return raw, nil
+}
+
+func (archive *RDbRawBlockArchive) LatestHeight() (uint64, error) {
+	var err error
+
+	sql, sqlArgs, err := archive.stmtBuilder.Select(
+		"MAX(b.height)",
+	).From(
+		"raw_block_archive b",
+	).Join(
+		"raw_block_archive r ON r.height = b.height AND r.kind = ?", RAW_BLOCK_ARCHIVE_KIND_BLOCK_RESULTS,
+	).Where(
+		"b.kind = ?", RAW_BLOCK_ARCHIVE_KIND_BLOCK,
+	).ToSql()
+	if err != nil {
+		return uint64(0), fmt.Errorf("error building raw block archive latest height selection SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	var latestHeight *uint64
+	if err = archive.conn.QueryRow(sql, sqlArgs...).Scan(&latestHeight); err != nil {
+		if err == ErrNoRows {
+			return uint64(0), nil
+		}
+		return uint64(0), fmt.Errorf("error scanning raw block archive latest height: %v: %w", err, ErrRepoQuery)
+	}
+
+	if latestHeight == nil {
+		return uint64(0), nil
+	}
+	return *latestHeight, nil
+}
+
+func compressRawResp(raw []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(raw); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decompressRawResp(compressed []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return ioutil.ReadAll(reader)
+}
@@ -0,0 +1,96 @@
+package adapter_test
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/adapter/tendermint"
+	. "github.com/crypto-com/chainindex/adapter/test/mock"
+)
+
+const (
+	SQL_RAW_BLOCK_ARCHIVE_INSERT = "INSERT INTO raw_block_archive (height,kind,data) VALUES (?,?,?) ON CONFLICT (height, kind) DO NOTHING"
+	SQL_RAW_BLOCK_ARCHIVE_SELECT = "SELECT data FROM raw_block_archive WHERE height = ? AND kind = ?"
+)
+
+var _ = Describe("RDbRawBlockArchive", func() {
+	var mockConn *MockRDbConn
+	var archive *adapter.RDbRawBlockArchive
+	BeforeEach(func() {
+		mockConn = new(MockRDbConn)
+		archive = adapter.NewRDbRawBlockArchive(mockConn, sq.StatementBuilder)
+	})
+
+	It("should implement RawBlockArchive", func() {
+		var _ tendermint.RawBlockArchive = archive
+	})
+
+	Describe("StoreBlock", func() {
+		It("should insert compressed block which can be found afterwards", func() {
+			rawBlock := []byte(`{"jsonrpc":"2.0","id":-1,"result":{}}`)
+
+			var archivedData []byte
+			mockConn.On("Exec",
+				SQL_RAW_BLOCK_ARCHIVE_INSERT,
+				uint64(100),
+				adapter.RAW_BLOCK_ARCHIVE_KIND_BLOCK,
+				mock.MatchedBy(func(data []byte) bool {
+					archivedData = data
+					return true
+				}),
+			).Return(new(MockRDbExecResult), nil)
+
+			err := archive.StoreBlock(uint64(100), rawBlock)
+			Expect(err).To(BeNil())
+			Expect(archivedData).NotTo(Equal(rawBlock))
+
+			mockRowResult := new(MockRDbRowResult)
+			mockRowResult.On("Scan", mock.MatchedBy(func(data *[]byte) bool {
+				*data = archivedData
+				return true
+			})).Return(nil)
+			mockConn.On("QueryRow",
+				SQL_RAW_BLOCK_ARCHIVE_SELECT,
+				uint64(100),
+				adapter.RAW_BLOCK_ARCHIVE_KIND_BLOCK,
+			).Return(mockRowResult)
+
+			foundBlock, err := archive.FindBlock(uint64(100))
+			Expect(err).To(BeNil())
+			Expect(foundBlock).To(Equal(rawBlock))
+		})
+	})
+
+	Describe("StoreGenesis", func() {
+		It("should insert genesis at genesis height", func() {
+			mockConn.On("Exec",
+				SQL_RAW_BLOCK_ARCHIVE_INSERT,
+				adapter.RAW_BLOCK_ARCHIVE_GENESIS_HEIGHT,
+				adapter.RAW_BLOCK_ARCHIVE_KIND_GENESIS,
+				mock.Anything,
+			).Return(new(MockRDbExecResult), nil)
+
+			err := archive.StoreGenesis([]byte("{}"))
+			Expect(err).To(BeNil())
+			mockConn.AssertExpectations(GinkgoT())
+		})
+	})
+
+	Describe("FindBlockResults", func() {
+		It("should return ErrNotArchived when block results is not archived", func() {
+			mockRowResult := new(MockRDbRowResult)
+			mockRowResult.On("Scan", mock.Anything).Return(adapter.ErrNoRows)
+			mockConn.On("QueryRow",
+				SQL_RAW_BLOCK_ARCHIVE_SELECT,
+				uint64(100),
+				adapter.RAW_BLOCK_ARCHIVE_KIND_BLOCK_RESULTS,
+			).Return(mockRowResult)
+
+			_, err := archive.FindBlockResults(uint64(100))
+			Expect(err).To(Equal(tendermint.ErrNotArchived))
+		})
+	})
+})
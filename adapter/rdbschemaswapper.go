@@ -0,0 +1,123 @@
+package adapter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RDbSchemaSwapper promotes a shadow schema to the live schema by renaming
+// them in a single transaction. Connections are switched to a fresh connection
+// to the live schema afterwards so that no cached statement keeps referring to
+// the retired schema
+type RDbSchemaSwapper struct {
+	conn         RDbConn
+	liveSchema   string
+	shadowSchema string
+
+	switchableConns []*SwitchableRDbConn
+	newLiveConn     func() (RDbConn, error)
+}
+
+func NewRDbSchemaSwapper(
+	conn RDbConn,
+	liveSchema string,
+	shadowSchema string,
+
+	switchableConns []*SwitchableRDbConn,
+	newLiveConn func() (RDbConn, error),
+) *RDbSchemaSwapper {
+	return &RDbSchemaSwapper{
+		conn,
+		liveSchema,
+		shadowSchema,
+
+		switchableConns,
+		newLiveConn,
+	}
+}
+
+// Swap renames the live schema to `<live>_retired_<timestamp>` and the shadow
+// schema to the live schema. The raw block archive is shared between the
+// schemas, so it is moved over from the live schema and the one in the shadow
+// schema is dropped. It returns the name of the retired schema
+func (swapper *RDbSchemaSwapper) Swap() (string, error) {
+	retiredSchema := fmt.Sprintf("%s_retired_%s", swapper.liveSchema, time.Now().UTC().Format("20060102150405"))
+
+	err := swapper.switchAll(swapper.switchableConns, func() (RDbConn, error) {
+		if err := swapper.renameSchemas(retiredSchema); err != nil {
+			return nil, err
+		}
+
+		liveConn, err := swapper.newLiveConn()
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to live schema: %v: %w", err, ErrRepoOpen)
+		}
+		return liveConn, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return retiredSchema, nil
+}
+
+// switchAll switches every connection to the connection returned by switchFn.
+// switchFn is run once while all connections are locked
+func (swapper *RDbSchemaSwapper) switchAll(
+	conns []*SwitchableRDbConn, switchFn func() (RDbConn, error),
+) error {
+	if len(conns) == 0 {
+		_, err := switchFn()
+		return err
+	}
+
+	var switchedConn RDbConn
+	return conns[0].Switch(func() (RDbConn, error) {
+		if err := swapper.switchAll(conns[1:], func() (RDbConn, error) {
+			var err error
+			switchedConn, err = switchFn()
+			return switchedConn, err
+		}); err != nil {
+			return nil, err
+		}
+		return switchedConn, nil
+	})
+}
+
+func (swapper *RDbSchemaSwapper) renameSchemas(retiredSchema string) error {
+	var err error
+
+	tx, err := swapper.conn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v: %w", err, ErrRepoOpen)
+	}
+	defer func() {
+		// Calling rollback on committed transaction has no effect
+		_ = tx.Rollback()
+	}()
+
+	liveSchema := quoteRDbIdentifier(swapper.liveSchema)
+	shadowSchema := quoteRDbIdentifier(swapper.shadowSchema)
+	stmts := []string{
+		"DROP TABLE IF EXISTS " + shadowSchema + ".raw_block_archive",
+		"ALTER TABLE IF EXISTS " + liveSchema + ".raw_block_archive SET SCHEMA " + shadowSchema,
+		"ALTER SCHEMA " + liveSchema + " RENAME TO " + quoteRDbIdentifier(retiredSchema),
+		"ALTER SCHEMA " + shadowSchema + " RENAME TO " + liveSchema,
+	}
+	for _, stmt := range stmts {
+		if _, err = tx.Exec(stmt); err != nil {
+			return fmt.Errorf("error swapping schemas: %v: %w", err, ErrRepoWrite)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error commiting schema swap: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+func quoteRDbIdentifier(identifier string) string {
+	return "\"" + strings.ReplaceAll(identifier, "\"", "\"\"") + "\""
+}
@@ -0,0 +1,88 @@
+package adapter_test
+
+import (
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/adapter"
+	. "github.com/crypto-com/chainindex/adapter/test/mock"
+)
+
+var _ = Describe("RDbSchemaSwapper", func() {
+	var mockConn *MockRDbConn
+	var mockTx *MockRDbTx
+	var switchableConn *adapter.SwitchableRDbConn
+	BeforeEach(func() {
+		mockTx = new(MockRDbTx)
+		mockTx.On("Commit").Return(nil)
+		mockTx.On("Rollback").Return(nil)
+		mockConn = new(MockRDbConn)
+		mockConn.On("Begin").Return(mockTx, nil)
+
+		switchableConn = adapter.NewSwitchableRDbConn(new(MockRDbConn))
+	})
+
+	Describe("Swap", func() {
+		It("should rename the schemas in a transaction and switch the connections to the live schema", func() {
+			var executedStmts []string
+			mockTx.On("Exec", mock.Anything).Run(func(args mock.Arguments) {
+				executedStmts = append(executedStmts, args.String(0))
+			}).Return(new(MockRDbExecResult), nil)
+
+			liveConn := new(MockRDbConn)
+			liveConn.On("Exec", "SELECT 1").Return(new(MockRDbExecResult), nil)
+			swapper := adapter.NewRDbSchemaSwapper(
+				mockConn,
+				"public",
+				"shadow",
+				[]*adapter.SwitchableRDbConn{switchableConn},
+				func() (adapter.RDbConn, error) {
+					return liveConn, nil
+				},
+			)
+
+			retiredSchema, err := swapper.Swap()
+			Expect(err).To(BeNil())
+			Expect(retiredSchema).To(HavePrefix("public_retired_"))
+
+			Expect(executedStmts).To(Equal([]string{
+				"DROP TABLE IF EXISTS \"shadow\".raw_block_archive",
+				"ALTER TABLE IF EXISTS \"public\".raw_block_archive SET SCHEMA \"shadow\"",
+				"ALTER SCHEMA \"public\" RENAME TO \"" + retiredSchema + "\"",
+				"ALTER SCHEMA \"shadow\" RENAME TO \"public\"",
+			}))
+			mockTx.AssertCalled(GinkgoT(), "Commit")
+
+			_, err = switchableConn.Exec("SELECT 1")
+			Expect(err).To(BeNil())
+			liveConn.AssertCalled(GinkgoT(), "Exec", "SELECT 1")
+		})
+
+		It("should not switch the connections when renaming fails", func() {
+			mockTx.On("Exec", mock.MatchedBy(func(sql string) bool {
+				return strings.HasPrefix(sql, "ALTER SCHEMA")
+			})).Return(nil, errors.New("permission denied"))
+			mockTx.On("Exec", mock.Anything).Return(new(MockRDbExecResult), nil)
+
+			swapper := adapter.NewRDbSchemaSwapper(
+				mockConn,
+				"public",
+				"shadow",
+				[]*adapter.SwitchableRDbConn{switchableConn},
+				func() (adapter.RDbConn, error) {
+					Fail("should not connect to live schema")
+					return nil, nil
+				},
+			)
+
+			_, err := swapper.Swap()
+			Expect(err).NotTo(BeNil())
+			Expect(errors.Is(err, adapter.ErrRepoWrite)).To(BeTrue())
+			mockTx.AssertNotCalled(GinkgoT(), "Commit")
+		})
+	})
+})
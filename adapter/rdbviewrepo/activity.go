@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/crypto-com/chainindex"
 	"github.com/crypto-com/chainindex/adapter"
 	"github.com/crypto-com/chainindex/usecase/viewrepo"
 	jsoniter "github.com/json-iterator/go"
@@ -40,6 +41,9 @@ func (repo *RDbActivityViewRepo) ListTransactions(filter viewrepo.TransactionFil
 		"b.time",
 		"b.hash",
 		"a.txid",
+		"a.tx_status",
+		"a.tx_log",
+		"a.tx_codespace",
 		"a.fee",
 		"a.inputs",
 		"a.joined_council_node",
@@ -76,6 +80,10 @@ func (repo *RDbActivityViewRepo) ListTransactions(filter viewrepo.TransactionFil
 		stmtBuilder = stmtBuilder.Where("a.staking_account_address = ?", *filter.MaybeStakingAccountAddress)
 	}
 
+	if filter.MaybeStatus != nil {
+		stmtBuilder = stmtBuilder.Where("a.tx_status = ?", adapter.TransactionStatusToString(*filter.MaybeStatus))
+	}
+
 	rDbPagination := adapter.NewRDbPaginationBuilder(
 		pagination,
 		repo.conn,
@@ -109,6 +117,9 @@ func (repo *RDbActivityViewRepo) ListTransactions(filter viewrepo.TransactionFil
 			blockTimeReader.ScannableArg(),
 			&transaction.BlockHash,
 			&transaction.MaybeTxID,
+			&transaction.Status,
+			&transaction.MaybeLog,
+			&transaction.MaybeCodespace,
 			feeReader.ScannableArg(),
 			&transferInputsJSON,
 			&joinedCouncilNodeJSON,
@@ -185,6 +196,9 @@ func (repo *RDbActivityViewRepo) FindTransactionByTxId(txid string) (*viewrepo.T
 		"b.time",
 		"b.hash",
 		"a.txid",
+		"a.tx_status",
+		"a.tx_log",
+		"a.tx_codespace",
 		"a.fee",
 		"a.inputs",
 		"a.joined_council_node",
@@ -222,6 +236,9 @@ func (repo *RDbActivityViewRepo) FindTransactionByTxId(txid string) (*viewrepo.T
 		blockTimeReader.ScannableArg(),
 		&transaction.BlockHash,
 		&transaction.MaybeTxID,
+		&transaction.Status,
+		&transaction.MaybeLog,
+		&transaction.MaybeCodespace,
 		feeReader.ScannableArg(),
 		&transferInputsJSON,
 		&joinedCouncilNodeJSON,
@@ -292,6 +309,7 @@ func (repo *RDbActivityViewRepo) ListEvents(filter viewrepo.EventFilter, paginat
 		"b.time",
 		"b.hash",
 		"a.event_position",
+		"a.event_phase",
 		"a.staking_account_address",
 		"a.staking_account_nonce",
 		"a.bonded",
@@ -355,6 +373,7 @@ func (repo *RDbActivityViewRepo) ListEvents(filter viewrepo.EventFilter, paginat
 			blockTimeReader.ScannableArg(),
 			&event.BlockHash,
 			&event.EventPosition,
+			&event.EventPhase,
 			&event.StakingAccountAddress,
 			&event.MaybeStakingAccountNonce,
 			bondedReader.ScannableArg(),
@@ -400,7 +419,11 @@ func (repo *RDbActivityViewRepo) ListEvents(filter viewrepo.EventFilter, paginat
 	return events, paginationResult, nil
 }
 
-func (repo *RDbActivityViewRepo) FindEventByBlockHeightEventPosition(blockHeight uint64, eventPosition uint64) (*viewrepo.Event, error) {
+func (repo *RDbActivityViewRepo) FindEventByBlockHeightEventPosition(
+	blockHeight uint64,
+	eventPhase chainindex.EventPhase,
+	eventPosition uint64,
+) (*viewrepo.Event, error) {
 	var err error
 
 	sql, sqlArgs, err := repo.stmtBuilder.Select(
@@ -409,6 +432,7 @@ func (repo *RDbActivityViewRepo) FindEventByBlockHeightEventPosition(blockHeight
 		"b.time",
 		"b.hash",
 		"a.event_position",
+		"a.event_phase",
 		"a.staking_account_address",
 		"a.staking_account_nonce",
 		"a.bonded",
@@ -421,7 +445,8 @@ func (repo *RDbActivityViewRepo) FindEventByBlockHeightEventPosition(blockHeight
 	).LeftJoin(
 		"blocks b ON a.block_height = b.height",
 	).Where(
-		"a.block_height = ? AND a.event_position = ?", blockHeight, eventPosition,
+		"a.block_height = ? AND a.event_phase = ? AND a.event_position = ?",
+		blockHeight, adapter.EventPhaseToString(eventPhase), eventPosition,
 	).ToSql()
 	if err != nil {
 		if err != adapter.ErrNoRows {
@@ -443,6 +468,7 @@ func (repo *RDbActivityViewRepo) FindEventByBlockHeightEventPosition(blockHeight
 		blockTimeReader.ScannableArg(),
 		&event.BlockHash,
 		&event.EventPosition,
+		&event.EventPhase,
 		&event.StakingAccountAddress,
 		&event.MaybeStakingAccountNonce,
 		bondedReader.ScannableArg(),
@@ -514,6 +540,9 @@ func (repo *RDbActivityViewRepo) SearchTransactions(keyword string, pagination *
 		"b.time",
 		"b.hash",
 		"a.txid",
+		"a.tx_status",
+		"a.tx_log",
+		"a.tx_codespace",
 		"a.fee",
 		"a.inputs",
 		"a.joined_council_node",
@@ -564,6 +593,9 @@ func (repo *RDbActivityViewRepo) SearchTransactions(keyword string, pagination *
 			blockTimeReader.ScannableArg(),
 			&transaction.BlockHash,
 			&transaction.MaybeTxID,
+			&transaction.Status,
+			&transaction.MaybeLog,
+			&transaction.MaybeCodespace,
 			feeReader.ScannableArg(),
 			&transferInputsJSON,
 			&joinedCouncilNodeJSON,
@@ -643,6 +675,7 @@ func (repo *RDbActivityViewRepo) SearchEvents(keyword string, pagination *viewre
 		"b.time",
 		"b.hash",
 		"a.event_position",
+		"a.event_phase",
 		"a.staking_account_address",
 		"a.staking_account_nonce",
 		"a.bonded",
@@ -685,6 +718,7 @@ func (repo *RDbActivityViewRepo) SearchEvents(keyword string, pagination *viewre
 			blockTimeReader.ScannableArg(),
 			&event.BlockHash,
 			&event.EventPosition,
+			&event.EventPhase,
 			&event.StakingAccountAddress,
 			&event.MaybeStakingAccountNonce,
 			bondedReader.ScannableArg(),
@@ -2,7 +2,6 @@ package rdbviewrepo
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
@@ -60,16 +59,18 @@ func (repo *RDbBlockViewRepo) ListBlocks(filter viewrepo.BlockFilter, pagination
 
 	stmtBuilder := repo.stmtBuilder.Select(
 		"hash", "height", "time", "app_hash",
-		"committed_council_nodes->0",
+		"p.id", "p.name", "p.address",
 		"SUM(CASE WHEN a.type IN "+RDB_SQL_TRANSACTION_TYPES+" THEN 1 ELSE 0 END) AS transaction_count",
 		"SUM(CASE WHEN a.type IN "+RDB_SQL_EVENT_TYPES+" THEN 1 ELSE 0 END) AS event_count",
 		"committed_council_nodes",
 	).From(
 		"blocks b",
+	).LeftJoin(
+		"council_nodes p ON b.proposer_council_node_id = p.id",
 	).LeftJoin(
 		"activities a ON b.height = a.block_height",
 	).GroupBy(
-		"b.hash, b.height",
+		"b.hash, b.height, p.id",
 	).OrderBy(
 		"b.height DESC",
 	)
@@ -77,10 +78,10 @@ func (repo *RDbBlockViewRepo) ListBlocks(filter viewrepo.BlockFilter, pagination
 	if filter.MaybeProposers != nil {
 		filterTypesSize := len(filter.MaybeProposers)
 		if filterTypesSize != 0 {
-			preparedTypesQuery := "(committed_council_nodes->0->'id')::integer IN (" + strings.TrimRight(strings.Repeat("?,", filterTypesSize), ",") + ")"
+			preparedTypesQuery := "b.proposer_council_node_id IN (" + strings.TrimRight(strings.Repeat("?,", filterTypesSize), ",") + ")"
 			typeValues := make([]interface{}, 0, filterTypesSize)
 			for _, proposerId := range filter.MaybeProposers {
-				typeValues = append(typeValues, strconv.FormatUint(proposerId, 10))
+				typeValues = append(typeValues, proposerId)
 			}
 			stmtBuilder = stmtBuilder.Where(preparedTypesQuery, typeValues...)
 		}
@@ -105,7 +106,9 @@ func (repo *RDbBlockViewRepo) ListBlocks(filter viewrepo.BlockFilter, pagination
 	blocks := make([]viewrepo.Block, 0)
 	for rowsResult.Next() {
 		var block viewrepo.Block
-		var proposerJSON *string
+		var maybeProposerId *uint64
+		var maybeProposerName *string
+		var maybeProposerAddress *string
 		var commmittedCouncilNodesJSON *string
 		timeReader := repo.typeConv.NtotReader()
 		if err = rowsResult.Scan(
@@ -113,7 +116,9 @@ func (repo *RDbBlockViewRepo) ListBlocks(filter viewrepo.BlockFilter, pagination
 			&block.Height,
 			timeReader.ScannableArg(),
 			&block.AppHash,
-			&proposerJSON,
+			&maybeProposerId,
+			&maybeProposerName,
+			&maybeProposerAddress,
 			&block.TransactionCount,
 			&block.EventCount,
 			&commmittedCouncilNodesJSON,
@@ -127,15 +132,6 @@ func (repo *RDbBlockViewRepo) ListBlocks(filter viewrepo.BlockFilter, pagination
 		}
 		block.Time = *blockTime
 
-		if proposerJSON != nil {
-			var proposer *viewrepo.BlockCouncilNode
-			if err = jsoniter.Unmarshal([]byte(*proposerJSON), &proposer); err != nil {
-				return nil, nil, fmt.Errorf("error unmarshalling proposer JSON: %v: %w", err, adapter.ErrRepoQuery)
-			}
-
-			block.MaybeProposer = proposer
-		}
-
 		if commmittedCouncilNodesJSON != nil {
 			var committedCouncilNodes []viewrepo.BlockCouncilNode
 			if err = jsoniter.Unmarshal([]byte(*commmittedCouncilNodesJSON), &committedCouncilNodes); err != nil {
@@ -145,6 +141,10 @@ func (repo *RDbBlockViewRepo) ListBlocks(filter viewrepo.BlockFilter, pagination
 			block.MaybeCommittedCouncilNodes = committedCouncilNodes
 		}
 
+		block.MaybeProposer = parseBlockProposer(
+			maybeProposerId, maybeProposerName, maybeProposerAddress, block.MaybeCommittedCouncilNodes,
+		)
+
 		blocks = append(blocks, block)
 	}
 
@@ -161,16 +161,18 @@ func (repo *RDbBlockViewRepo) FindBlock(blockIdentity viewrepo.BlockIdentity) (*
 
 	selectBuilder := repo.stmtBuilder.Select(
 		"hash", "height", "time", "app_hash",
-		"committed_council_nodes->0",
+		"p.id", "p.name", "p.address",
 		"SUM(CASE WHEN a.type IN "+RDB_SQL_TRANSACTION_TYPES+" THEN 1 ELSE 0 END) AS transaction_count",
 		"SUM(CASE WHEN a.type IN "+RDB_SQL_EVENT_TYPES+" THEN 1 ELSE 0 END) AS event_count",
 		"committed_council_nodes",
 	).From(
 		"blocks b",
+	).LeftJoin(
+		"council_nodes p ON b.proposer_council_node_id = p.id",
 	).LeftJoin(
 		"activities a ON b.height = a.block_height",
 	).GroupBy(
-		"b.hash, b.height",
+		"b.hash, b.height, p.id",
 	).OrderBy(
 		"b.height DESC",
 	)
@@ -186,7 +188,9 @@ func (repo *RDbBlockViewRepo) FindBlock(blockIdentity viewrepo.BlockIdentity) (*
 	}
 
 	var block viewrepo.Block
-	var proposerJSON *string
+	var maybeProposerId *uint64
+	var maybeProposerName *string
+	var maybeProposerAddress *string
 	var committedCouncilNodesJSON *string
 	timeReader := repo.typeConv.NtotReader()
 	if err = repo.conn.QueryRow(sql, sqlArgs...).Scan(
@@ -194,7 +198,9 @@ func (repo *RDbBlockViewRepo) FindBlock(blockIdentity viewrepo.BlockIdentity) (*
 		&block.Height,
 		timeReader.ScannableArg(),
 		&block.AppHash,
-		&proposerJSON,
+		&maybeProposerId,
+		&maybeProposerName,
+		&maybeProposerAddress,
 		&block.TransactionCount,
 		&block.EventCount,
 		&committedCouncilNodesJSON,
@@ -210,15 +216,6 @@ func (repo *RDbBlockViewRepo) FindBlock(blockIdentity viewrepo.BlockIdentity) (*
 	}
 	block.Time = *blockTime
 
-	if proposerJSON != nil {
-		var proposer *viewrepo.BlockCouncilNode
-		if err = jsoniter.Unmarshal([]byte(*proposerJSON), &proposer); err != nil {
-			return nil, fmt.Errorf("error unmarshalling proposer JSON: %v: %w", err, adapter.ErrRepoQuery)
-		}
-
-		block.MaybeProposer = proposer
-	}
-
 	if committedCouncilNodesJSON != nil {
 		var committedCouncilNodes []viewrepo.BlockCouncilNode
 		if err = jsoniter.Unmarshal([]byte(*committedCouncilNodesJSON), &committedCouncilNodes); err != nil {
@@ -228,9 +225,44 @@ func (repo *RDbBlockViewRepo) FindBlock(blockIdentity viewrepo.BlockIdentity) (*
 		block.MaybeCommittedCouncilNodes = committedCouncilNodes
 	}
 
+	block.MaybeProposer = parseBlockProposer(
+		maybeProposerId, maybeProposerName, maybeProposerAddress, block.MaybeCommittedCouncilNodes,
+	)
+
+	if block.MaybeEvidences, err = repo.listEvidencesByHeight(block.Height); err != nil {
+		return nil, err
+	}
+
 	return &block, nil
 }
 
+func (repo *RDbBlockViewRepo) listEvidencesByHeight(height uint64) ([]viewrepo.BlockEvidence, error) {
+	var err error
+
+	sql, sqlArgs, err := evidencesSelectBuilder(repo.stmtBuilder).Where(
+		"e.block_height = ?", height,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building block evidences select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := repo.conn.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing block evidences select SQL: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	evidences, err := scanEvidences(rowsResult, repo.typeConv)
+	rowsResult.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = fillEvidencePunishments(repo.conn, repo.stmtBuilder, repo.typeConv, evidences); err != nil {
+		return nil, err
+	}
+
+	return evidences, nil
+}
+
 func (repo *RDbBlockViewRepo) ListBlockTransactions(
 	blockIdentity viewrepo.BlockIdentity,
 	pagination *viewrepo.Pagination,
@@ -245,6 +277,9 @@ func (repo *RDbBlockViewRepo) ListBlockTransactions(
 		"b.time",
 		"b.hash",
 		"a.txid",
+		"a.tx_status",
+		"a.tx_log",
+		"a.tx_codespace",
 		"a.fee",
 		"a.inputs",
 		"a.joined_council_node",
@@ -304,6 +339,9 @@ func (repo *RDbBlockViewRepo) ListBlockTransactions(
 			blockTimeReader.ScannableArg(),
 			&blockTransaction.BlockHash,
 			&blockTransaction.MaybeTxID,
+			&blockTransaction.Status,
+			&blockTransaction.MaybeLog,
+			&blockTransaction.MaybeCodespace,
 			feeReader.ScannableArg(),
 			&inputsJSON,
 			&joinedCouncilNodeJSON,
@@ -402,6 +440,7 @@ FROM (
 		b.time,
 		b.hash,
 		NULL AS event_position,
+		a.event_phase,
 		NULL AS staking_account_address,
 		NULL AS staking_account_nonce,
 		NULL AS bonded,
@@ -420,7 +459,7 @@ FROM (
 	LEFT JOIN blocks b ON a.block_height = b.height
 	LEFT JOIN block_rewards br ON a.block_height = br.block_height
 	WHERE `+strings.Replace(whereClause, "?", "$1", 1)+` AND a.type = 'reward'
-	GROUP BY a.type, a.block_height, b.time, b.hash, br.minted
+	GROUP BY a.type, a.block_height, b.time, b.hash, a.event_phase, br.minted
 
 	UNION ALL
 
@@ -430,6 +469,7 @@ FROM (
 		b.time,
 		b.hash,
 		a.event_position,
+		a.event_phase,
 		a.staking_account_address,
 		a.staking_account_nonce,
 		a.bonded,
@@ -443,7 +483,7 @@ FROM (
 	LEFT JOIN blocks b ON a.block_height = b.height
 	WHERE `+strings.Replace(whereClause, "?", "$2", 1)+" AND a.type IN "+RDB_SQL_NON_REWARD_EVENT_TYPES+`
 ) t
-ORDER BY block_height`,
+ORDER BY block_height, event_phase, event_position`,
 		whereArgs, whereArgs,
 	)
 
@@ -474,6 +514,7 @@ ORDER BY block_height`,
 			blockTimeReader.ScannableArg(),
 			&blockEvent.BlockHash,
 			&blockEvent.MaybeEventPosition,
+			&blockEvent.MaybeEventPhase,
 			&blockEvent.MaybeStakingAccountAddress,
 			&blockEvent.MaybeStakingAccountNonce,
 			bondedReader.ScannableArg(),
@@ -540,18 +581,20 @@ func (repo *RDbBlockViewRepo) Search(keyword string, pagination *viewrepo.Pagina
 		repo.conn,
 	).BuildStmt(repo.stmtBuilder.Select(
 		"hash", "height", "time", "app_hash",
-		"committed_council_nodes->0",
+		"p.id", "p.name", "p.address",
 		"SUM(CASE WHEN a.type IN "+RDB_SQL_TRANSACTION_TYPES+" THEN 1 ELSE 0 END) AS transaction_count",
 		"SUM(CASE WHEN a.type IN "+RDB_SQL_EVENT_TYPES+" THEN 1 ELSE 0 END) AS event_count",
 		"committed_council_nodes",
 	).From(
 		"blocks b",
+	).LeftJoin(
+		"council_nodes p ON b.proposer_council_node_id = p.id",
 	).LeftJoin(
 		"activities a ON b.height = a.block_height",
 	).Where(
 		"b.height::text = ? OR b.hash = ?", keyword, keyword,
 	).GroupBy(
-		"b.hash, b.height",
+		"b.hash, b.height, p.id",
 	).OrderBy(
 		"b.height DESC",
 	))
@@ -569,7 +612,9 @@ func (repo *RDbBlockViewRepo) Search(keyword string, pagination *viewrepo.Pagina
 	blocks := make([]viewrepo.Block, 0)
 	for rowsResult.Next() {
 		var block viewrepo.Block
-		var proposerJSON *string
+		var maybeProposerId *uint64
+		var maybeProposerName *string
+		var maybeProposerAddress *string
 		var committedCouncilNodesJSON *string
 		timeReader := repo.typeConv.NtotReader()
 		if err = rowsResult.Scan(
@@ -577,7 +622,9 @@ func (repo *RDbBlockViewRepo) Search(keyword string, pagination *viewrepo.Pagina
 			&block.Height,
 			timeReader.ScannableArg(),
 			&block.AppHash,
-			&proposerJSON,
+			&maybeProposerId,
+			&maybeProposerName,
+			&maybeProposerAddress,
 			&block.TransactionCount,
 			&block.EventCount,
 			&committedCouncilNodesJSON,
@@ -591,15 +638,6 @@ func (repo *RDbBlockViewRepo) Search(keyword string, pagination *viewrepo.Pagina
 		}
 		block.Time = *blockTime
 
-		if proposerJSON != nil {
-			var proposer *viewrepo.BlockCouncilNode
-			if err = jsoniter.Unmarshal([]byte(*proposerJSON), &proposer); err != nil {
-				return nil, nil, fmt.Errorf("error unmarshalling proposer JSON: %v: %w", err, adapter.ErrRepoQuery)
-			}
-
-			block.MaybeProposer = proposer
-		}
-
 		if committedCouncilNodesJSON != nil {
 			var committedCouncilNodes []viewrepo.BlockCouncilNode
 			if err = jsoniter.Unmarshal([]byte(*committedCouncilNodesJSON), &committedCouncilNodes); err != nil {
@@ -609,6 +647,10 @@ func (repo *RDbBlockViewRepo) Search(keyword string, pagination *viewrepo.Pagina
 			block.MaybeCommittedCouncilNodes = committedCouncilNodes
 		}
 
+		block.MaybeProposer = parseBlockProposer(
+			maybeProposerId, maybeProposerName, maybeProposerAddress, block.MaybeCommittedCouncilNodes,
+		)
+
 		blocks = append(blocks, block)
 	}
 
@@ -619,3 +661,31 @@ func (repo *RDbBlockViewRepo) Search(keyword string, pagination *viewrepo.Pagina
 
 	return blocks, paginationResult, nil
 }
+
+// parseBlockProposer returns nil when the proposer is not a council node. The
+// proposer signature is known only after the commit of the block is stored
+func parseBlockProposer(
+	maybeId *uint64,
+	maybeName *string,
+	maybeAddress *string,
+	committedCouncilNodes []viewrepo.BlockCouncilNode,
+) *viewrepo.BlockCouncilNode {
+	if maybeId == nil {
+		return nil
+	}
+
+	proposer := viewrepo.BlockCouncilNode{
+		Id:         *maybeId,
+		Name:       *maybeName,
+		Address:    *maybeAddress,
+		IsProposer: true,
+	}
+	for _, committedCouncilNode := range committedCouncilNodes {
+		if committedCouncilNode.IsProposer {
+			proposer.Signature = committedCouncilNode.Signature
+			break
+		}
+	}
+
+	return &proposer
+}
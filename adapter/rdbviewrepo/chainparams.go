@@ -0,0 +1,68 @@
+package rdbviewrepo
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type RDbChainParamsViewRepo struct {
+	conn adapter.RDbConn
+
+	stmtBuilder sq.StatementBuilderType
+	typeConv    adapter.RDbTypeConv
+}
+
+func NewRDbChainParamsViewRepo(
+	conn adapter.RDbConn,
+	stmtBuilder sq.StatementBuilderType,
+	typeConv adapter.RDbTypeConv,
+) *RDbChainParamsViewRepo {
+	return &RDbChainParamsViewRepo{
+		conn,
+
+		stmtBuilder,
+		typeConv,
+	}
+}
+
+func (repo *RDbChainParamsViewRepo) Find() (*viewrepo.ChainParams, error) {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Select(
+		"chain_id",
+		"network_params",
+		"consensus_params",
+	).From(
+		"chain_params",
+	).Limit(1).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building chain params select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	var chainParams viewrepo.ChainParams
+	var networkParamsJSON string
+	var consensusParamsJSON string
+	if err = repo.conn.QueryRow(sql, sqlArgs...).Scan(
+		&chainParams.ChainID,
+		&networkParamsJSON,
+		&consensusParamsJSON,
+	); err != nil {
+		if err == adapter.ErrNoRows {
+			return nil, adapter.ErrNotFound
+		}
+		return nil, fmt.Errorf("error scanning chain params row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	if err = jsoniter.Unmarshal([]byte(networkParamsJSON), &chainParams.NetworkParams); err != nil {
+		return nil, fmt.Errorf("error unmarshalling network params JSON: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	if err = jsoniter.Unmarshal([]byte(consensusParamsJSON), &chainParams.ConsensusParams); err != nil {
+		return nil, fmt.Errorf("error unmarshalling consensus params JSON: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	return &chainParams, nil
+}
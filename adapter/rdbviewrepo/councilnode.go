@@ -7,7 +7,9 @@ import (
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/crypto-com/chainindex"
 	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/internal/bignum"
 	"github.com/crypto-com/chainindex/usecase/viewrepo"
 	jsoniter "github.com/json-iterator/go"
 )
@@ -32,17 +34,43 @@ func NewRDbCouncilNodeViewRepo(
 	}
 }
 
-func (repo *RDbCouncilNodeViewRepo) ListActive(pagination *viewrepo.Pagination) ([]viewrepo.CouncilNodeListItem, *viewrepo.PaginationResult, error) {
+// Size of the 1k blocks performance window, which is also the window of the
+// uptime in the council node list
+const COUNCIL_NODE_PERFORMANCE_BLOCK_WINDOW_SIZE = uint64(1000)
+
+func (repo *RDbCouncilNodeViewRepo) ListActive(
+	order viewrepo.CouncilNodeListOrder,
+	pagination *viewrepo.Pagination,
+) ([]viewrepo.CouncilNodeListItem, *viewrepo.PaginationResult, error) {
 	var err error
 
+	var orderBys []string
+	if order == viewrepo.COUNCIL_NODE_LIST_ORDER_UPTIME {
+		orderBys = []string{"u.uptime_percentage DESC NULLS LAST", "sa.bonded DESC", "c.id"}
+	} else {
+		orderBys = []string{"sa.bonded DESC"}
+	}
 	baseStmtBuilder := repo.stmtBuilder.Select().From(
 		"council_nodes c",
 	).LeftJoin(
 		"staking_accounts sa ON c.id = sa.current_council_node_id",
+	).LeftJoin(
+		`(
+			SELECT council_node_id, (COUNT(*) FILTER (WHERE signed) * 100.0 / COUNT(*))::FLOAT8 AS uptime_percentage
+			FROM (
+				SELECT council_node_id, TRUE AS signed FROM block_committed_council_nodes
+				WHERE block_height > (SELECT COALESCE(MAX(height), 0) FROM blocks) - ?
+				UNION ALL
+				SELECT council_node_id, FALSE AS signed FROM block_missed_council_nodes
+				WHERE block_height > (SELECT COALESCE(MAX(height), 0) FROM blocks) - ?
+			) r
+			GROUP BY council_node_id
+		) u ON c.id = u.council_node_id`,
+		COUNCIL_NODE_PERFORMANCE_BLOCK_WINDOW_SIZE, COUNCIL_NODE_PERFORMANCE_BLOCK_WINDOW_SIZE,
 	).Where(
 		"c.last_left_at_block_height IS NULL",
 	).OrderBy(
-		"sa.bonded DESC",
+		orderBys...,
 	)
 
 	totalCumulativeSql, totalCumulativeSqlArgs, err := repo.stmtBuilder.Select(
@@ -117,6 +145,7 @@ func (repo *RDbCouncilNodeViewRepo) ListActive(pagination *viewrepo.Pagination)
 		"sa.jailed_until",
 		"c.created_at_block_height",
 		"c.last_left_at_block_height",
+		"u.uptime_percentage",
 	))
 	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
 	if err != nil {
@@ -151,6 +180,7 @@ func (repo *RDbCouncilNodeViewRepo) ListActive(pagination *viewrepo.Pagination)
 			jailedUntilReader.ScannableArg(),
 			&councilNode.CreatedAtBlockHeight,
 			&councilNode.MaybeLastLeftAtBlockHeight,
+			&councilNode.MaybeUptimePercentage,
 		); err != nil {
 			return nil, nil, fmt.Errorf("error scanning council node row: %v: %w", err, adapter.ErrRepoQuery)
 		}
@@ -276,9 +306,259 @@ func (repo *RDbCouncilNodeViewRepo) FindById(id uint64) (*viewrepo.CouncilNode,
 		councilNode.StakingAccount = &stakingAccount
 	}
 
+	if councilNode.MaybeLiveness, err = repo.findLivenessById(id); err != nil {
+		return nil, err
+	}
+
 	return &councilNode, nil
 }
 
+// findLivenessById returns nil when the chain params are not stored or the
+// council node has no signing record
+func (repo *RDbCouncilNodeViewRepo) findLivenessById(id uint64) (*viewrepo.CouncilNodeLiveness, error) {
+	var err error
+
+	paramsSql, paramsSqlArgs, err := repo.stmtBuilder.Select(
+		"(network_params->'jailing_config'->>'block_signing_window')::BIGINT",
+		"(network_params->'jailing_config'->>'missed_block_threshold')::BIGINT",
+	).From(
+		"chain_params",
+	).Limit(1).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building jailing config select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	var liveness viewrepo.CouncilNodeLiveness
+	if err = repo.conn.QueryRow(paramsSql, paramsSqlArgs...).Scan(
+		&liveness.BlockSigningWindow,
+		&liveness.MissedBlockThreshold,
+	); err != nil {
+		if err == adapter.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error scanning jailing config row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	// Signing records of the council node within the window are its latest
+	// signed and missed blocks
+	sql, sqlArgs, err := repo.stmtBuilder.Select(
+		"COUNT(*) FILTER (WHERE w.signed)",
+		"COUNT(*) FILTER (WHERE NOT w.signed)",
+	).FromSelect(
+		repo.stmtBuilder.Select(
+			"block_height",
+			"TRUE AS signed",
+		).From(
+			"block_committed_council_nodes",
+		).Where(
+			"council_node_id = ?", id,
+		).Suffix(
+			"UNION ALL "+
+				"SELECT block_height, FALSE AS signed FROM block_missed_council_nodes WHERE council_node_id = ? "+
+				"ORDER BY block_height DESC LIMIT ?",
+			id, liveness.BlockSigningWindow,
+		),
+		"w",
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building council node signing records select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	if err = repo.conn.QueryRow(sql, sqlArgs...).Scan(
+		&liveness.SignedBlockCount,
+		&liveness.MissedBlockCount,
+	); err != nil {
+		return nil, fmt.Errorf("error scanning council node signing records row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	total := liveness.SignedBlockCount + liveness.MissedBlockCount
+	if total == 0 {
+		return nil, nil
+	}
+	liveness.SigningRatio = float64(liveness.SignedBlockCount) / float64(total)
+
+	return &liveness, nil
+}
+
+func (repo *RDbCouncilNodeViewRepo) FindPerformanceById(
+	id uint64,
+	window viewrepo.CouncilNodePerformanceWindow,
+) (*viewrepo.CouncilNodePerformance, error) {
+	var err error
+
+	existSql, existSqlArgs, err := repo.stmtBuilder.Select(
+		"id",
+	).From(
+		"council_nodes",
+	).Where(
+		"id = ?", id,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building council node existence select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+	var existingId uint64
+	if err = repo.conn.QueryRow(existSql, existSqlArgs...).Scan(&existingId); err != nil {
+		if err == adapter.ErrNoRows {
+			return nil, adapter.ErrNotFound
+		}
+		return nil, fmt.Errorf("error scanning council node existence row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	performance := viewrepo.CouncilNodePerformance{
+		Window:          window,
+		SlashedBonded:   new(bignum.WBigInt).FromBigInt(bignum.Int0()),
+		SlashedUnbonded: new(bignum.WBigInt).FromBigInt(bignum.Int0()),
+	}
+
+	fromHeight, toHeight, err := repo.findPerformanceWindowHeights(window)
+	if err != nil {
+		return nil, err
+	}
+	if toHeight == 0 {
+		return &performance, nil
+	}
+	performance.FromBlockHeight = fromHeight
+	performance.ToBlockHeight = toHeight
+
+	slashType := adapter.ActivityTypeToString(chainindex.ACTIVITY_SLASH)
+	sql, sqlArgs, err := repo.stmtBuilder.Select().Column(
+		"(SELECT COUNT(*) FROM block_committed_council_nodes "+
+			"WHERE council_node_id = ? AND block_height BETWEEN ? AND ?)",
+		id, fromHeight, toHeight,
+	).Column(
+		"(SELECT COUNT(*) FROM blocks "+
+			"WHERE proposer_council_node_id = ? AND height BETWEEN ? AND ?)",
+		id, fromHeight, toHeight,
+	).Column(
+		"(SELECT COUNT(*) FROM block_missed_council_nodes "+
+			"WHERE council_node_id = ? AND block_height BETWEEN ? AND ?)",
+		id, fromHeight, toHeight,
+	).Column(
+		"(SELECT COUNT(*) FROM activities "+
+			"WHERE affected_council_node_id = ? AND type = ? AND block_height BETWEEN ? AND ?)",
+		id, adapter.ActivityTypeToString(chainindex.ACTIVITY_JAIL), fromHeight, toHeight,
+	).Column(
+		"(SELECT COUNT(*) FROM activities "+
+			"WHERE affected_council_node_id = ? AND type = ? AND block_height BETWEEN ? AND ?)",
+		id, slashType, fromHeight, toHeight,
+	).Column(
+		// Slashed amounts are recorded as negative staking diffs
+		"(SELECT COALESCE(-SUM(bonded), 0) FROM activities "+
+			"WHERE affected_council_node_id = ? AND type = ? AND block_height BETWEEN ? AND ?)",
+		id, slashType, fromHeight, toHeight,
+	).Column(
+		"(SELECT COALESCE(-SUM(unbonded), 0) FROM activities "+
+			"WHERE affected_council_node_id = ? AND type = ? AND block_height BETWEEN ? AND ?)",
+		id, slashType, fromHeight, toHeight,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building council node performance select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	slashedBondedReader := repo.typeConv.NtobReader()
+	slashedUnbondedReader := repo.typeConv.NtobReader()
+	if err = repo.conn.QueryRow(sql, sqlArgs...).Scan(
+		&performance.SignedBlockCount,
+		&performance.ProposedBlockCount,
+		&performance.MissedBlockCount,
+		&performance.JailCount,
+		&performance.SlashCount,
+		slashedBondedReader.ScannableArg(),
+		slashedUnbondedReader.ScannableArg(),
+	); err != nil {
+		return nil, fmt.Errorf("error scanning council node performance row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	if performance.SlashedBonded, err = slashedBondedReader.ParseW(); err != nil {
+		return nil, fmt.Errorf("error parsing slashed bonded: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	if performance.SlashedUnbonded, err = slashedUnbondedReader.ParseW(); err != nil {
+		return nil, fmt.Errorf("error parsing slashed unbonded: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	signingRecordCount := performance.SignedBlockCount + performance.MissedBlockCount
+	if signingRecordCount > 0 {
+		uptimePercentage := float64(performance.SignedBlockCount) * 100 / float64(signingRecordCount)
+		performance.MaybeUptimePercentage = &uptimePercentage
+	}
+
+	return &performance, nil
+}
+
+// findPerformanceWindowHeights returns the height range of the window ending at
+// the latest stored block. Time windows are relative to the latest block time
+// so that a lagging index is not counted as missed blocks. Returns 0 heights
+// when no block is stored
+func (repo *RDbCouncilNodeViewRepo) findPerformanceWindowHeights(
+	window viewrepo.CouncilNodePerformanceWindow,
+) (uint64, uint64, error) {
+	var err error
+
+	latestSql, latestSqlArgs, err := repo.stmtBuilder.Select(
+		"height",
+		"time",
+	).From(
+		"blocks",
+	).OrderBy(
+		"height DESC",
+	).Limit(1).ToSql()
+	if err != nil {
+		return 0, 0, fmt.Errorf("error building latest block select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	var latestHeight uint64
+	latestTimeReader := repo.typeConv.NtotReader()
+	if err = repo.conn.QueryRow(latestSql, latestSqlArgs...).Scan(
+		&latestHeight,
+		latestTimeReader.ScannableArg(),
+	); err != nil {
+		if err == adapter.ErrNoRows {
+			return 0, 0, nil
+		}
+		return 0, 0, fmt.Errorf("error scanning latest block row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	var duration time.Duration
+	switch window {
+	case viewrepo.COUNCIL_NODE_PERFORMANCE_WINDOW_1K_BLOCKS:
+		if latestHeight <= COUNCIL_NODE_PERFORMANCE_BLOCK_WINDOW_SIZE {
+			return 1, latestHeight, nil
+		}
+		return latestHeight - COUNCIL_NODE_PERFORMANCE_BLOCK_WINDOW_SIZE + 1, latestHeight, nil
+	case viewrepo.COUNCIL_NODE_PERFORMANCE_WINDOW_24H:
+		duration = 24 * time.Hour
+	case viewrepo.COUNCIL_NODE_PERFORMANCE_WINDOW_7D:
+		duration = 7 * 24 * time.Hour
+	case viewrepo.COUNCIL_NODE_PERFORMANCE_WINDOW_30D:
+		duration = 30 * 24 * time.Hour
+	default:
+		panic(fmt.Sprintf("unsupported council node performance window: %s", window))
+	}
+
+	latestTime, err := latestTimeReader.Parse()
+	if err != nil {
+		return 0, 0, fmt.Errorf("error parsing latest block time: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	fromTime := latestTime.Add(-duration)
+
+	fromSql, fromSqlArgs, err := repo.stmtBuilder.Select(
+		"MIN(height)",
+	).From(
+		"blocks",
+	).Where(
+		"time >= ?", repo.typeConv.Tton(&fromTime),
+	).ToSql()
+	if err != nil {
+		return 0, 0, fmt.Errorf("error building window first block select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	var fromHeight uint64
+	if err = repo.conn.QueryRow(fromSql, fromSqlArgs...).Scan(&fromHeight); err != nil {
+		return 0, 0, fmt.Errorf("error scanning window first block row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	return fromHeight, latestHeight, nil
+}
+
 func (repo *RDbCouncilNodeViewRepo) ListActivitiesById(councilNodeId uint64, filter viewrepo.ActivityFilter, pagination *viewrepo.Pagination) ([]viewrepo.StakingAccountActivity, *viewrepo.PaginationResult, error) {
 	var err error
 
@@ -288,7 +568,11 @@ func (repo *RDbCouncilNodeViewRepo) ListActivitiesById(councilNodeId uint64, fil
 		"b.time",
 		"b.hash",
 		"a.txid",
+		"a.tx_status",
+		"a.tx_log",
+		"a.tx_codespace",
 		"a.event_position",
+		"a.event_phase",
 		"a.fee",
 		"a.inputs",
 		"a.joined_council_node",
@@ -373,7 +657,11 @@ func (repo *RDbCouncilNodeViewRepo) ListActivitiesById(councilNodeId uint64, fil
 			blockTimeReader.ScannableArg(),
 			&activity.BlockHash,
 			&activity.MaybeTxID,
+			&activity.MaybeTxStatus,
+			&activity.MaybeTxLog,
+			&activity.MaybeTxCodespace,
 			&activity.MaybeEventPosition,
+			&activity.MaybeEventPhase,
 			feeReader.ScannableArg(),
 			&inputsJSON,
 			&joinedCouncilNodeJSON,
@@ -446,6 +734,220 @@ func (repo *RDbCouncilNodeViewRepo) ListActivitiesById(councilNodeId uint64, fil
 	return activities, paginationResult, nil
 }
 
+func (repo *RDbCouncilNodeViewRepo) ListPowerChangesById(
+	id uint64,
+	pagination *viewrepo.Pagination,
+) ([]viewrepo.CouncilNodePowerChange, *viewrepo.PaginationResult, error) {
+	var err error
+
+	existSql, existSqlArgs, err := repo.stmtBuilder.Select(
+		"id",
+	).From(
+		"council_nodes",
+	).Where(
+		"id = ?", id,
+	).ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building council node select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+	var councilNodeId uint64
+	if err = repo.conn.QueryRow(existSql, existSqlArgs...).Scan(&councilNodeId); err != nil {
+		if err == adapter.ErrNoRows {
+			return nil, nil, adapter.ErrNotFound
+		}
+		return nil, nil, fmt.Errorf("error scanning council node row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	rDbPagination := adapter.NewRDbPaginationBuilder(
+		pagination,
+		repo.conn,
+	).BuildStmt(repo.stmtBuilder.Select(
+		"pc.block_height",
+		"b.time",
+		"pc.power",
+	).From(
+		"council_node_power_changes pc",
+	).LeftJoin(
+		"blocks b ON pc.block_height = b.height",
+	).Where(
+		"pc.council_node_id = ?", id,
+	).OrderBy(
+		"pc.block_height DESC",
+	))
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building council node power changes select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := repo.conn.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing council node power changes select SQL: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	powerChanges := make([]viewrepo.CouncilNodePowerChange, 0)
+	for rowsResult.Next() {
+		var powerChange viewrepo.CouncilNodePowerChange
+
+		blockTimeReader := repo.typeConv.NtotReader()
+		if err = rowsResult.Scan(
+			&powerChange.BlockHeight,
+			blockTimeReader.ScannableArg(),
+			&powerChange.Power,
+		); err != nil {
+			return nil, nil, fmt.Errorf("error scanning council node power change row: %v: %w", err, adapter.ErrRepoQuery)
+		}
+		blockTime, err := blockTimeReader.Parse()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing block time: %v: %w", err, adapter.ErrRepoQuery)
+		}
+		powerChange.BlockTime = *blockTime
+
+		powerChanges = append(powerChanges, powerChange)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return powerChanges, paginationResult, nil
+}
+
+func (repo *RDbCouncilNodeViewRepo) ListEvidencesById(
+	id uint64,
+	pagination *viewrepo.Pagination,
+) ([]viewrepo.BlockEvidence, *viewrepo.PaginationResult, error) {
+	var err error
+
+	existSql, existSqlArgs, err := repo.stmtBuilder.Select(
+		"id",
+	).From(
+		"council_nodes",
+	).Where(
+		"id = ?", id,
+	).ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building council node select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+	var councilNodeId uint64
+	if err = repo.conn.QueryRow(existSql, existSqlArgs...).Scan(&councilNodeId); err != nil {
+		if err == adapter.ErrNoRows {
+			return nil, nil, adapter.ErrNotFound
+		}
+		return nil, nil, fmt.Errorf("error scanning council node row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	rDbPagination := adapter.NewRDbPaginationBuilder(
+		pagination,
+		repo.conn,
+	).BuildStmt(evidencesSelectBuilder(repo.stmtBuilder).Where(
+		"e.council_node_id = ?", id,
+	))
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building council node evidences select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := repo.conn.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing council node evidences select SQL: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	evidences, err := scanEvidences(rowsResult, repo.typeConv)
+	rowsResult.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = fillEvidencePunishments(repo.conn, repo.stmtBuilder, repo.typeConv, evidences); err != nil {
+		return nil, nil, err
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return evidences, paginationResult, nil
+}
+
+func (repo *RDbCouncilNodeViewRepo) ListValidatorsAtHeight(maybeHeight *uint64) (*viewrepo.ValidatorSet, error) {
+	var err error
+
+	heightStmtBuilder := repo.stmtBuilder.Select(
+		"MAX(height)",
+	).From(
+		"blocks",
+	)
+	if maybeHeight != nil {
+		heightStmtBuilder = heightStmtBuilder.Where("height = ?", *maybeHeight)
+	}
+	heightSql, heightSqlArgs, err := heightStmtBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building block height select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+	var maybeBlockHeight *uint64
+	if err = repo.conn.QueryRow(heightSql, heightSqlArgs...).Scan(&maybeBlockHeight); err != nil {
+		return nil, fmt.Errorf("error scanning block height row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	if maybeBlockHeight == nil {
+		return nil, adapter.ErrNotFound
+	}
+	height := *maybeBlockHeight
+
+	// Council nodes in the validator set signing the block at the height
+	// together with their latest power change in effect, in the same way as
+	// the missed signatures of the block are recorded. Tendermint omits the
+	// power of a validator update removing the validator, which is recorded as
+	// the council node leaving with a power change to 0. Power is recorded from
+	// the genesis validators and the validator updates. It is unknown, and
+	// listed as null, for council nodes seeded from a state snapshot or
+	// indexed before the genesis power was recorded until it next changes
+	sql, sqlArgs, err := adapter.SelectValidatorCouncilNodes(
+		repo.stmtBuilder,
+		height,
+		"c.id",
+		"c.name",
+		"c.pubkey_type",
+		"c.pubkey",
+		"c.address",
+		"pc.power",
+		"pc.block_height",
+	).OrderBy(
+		"pc.power DESC NULLS LAST, c.id",
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building validators select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := repo.conn.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing validators select SQL: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	validators := make([]viewrepo.Validator, 0)
+	for rowsResult.Next() {
+		var validator viewrepo.Validator
+		if err = rowsResult.Scan(
+			&validator.CouncilNodeId,
+			&validator.Name,
+			&validator.PubKeyType,
+			&validator.PubKey,
+			&validator.Address,
+			&validator.MaybePower,
+			&validator.MaybePowerChangedAtBlockHeight,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning validator row: %v: %w", err, adapter.ErrRepoQuery)
+		}
+
+		validators = append(validators, validator)
+	}
+
+	return &viewrepo.ValidatorSet{
+		BlockHeight: height,
+		Validators:  validators,
+	}, nil
+}
+
 func (repo *RDbCouncilNodeViewRepo) Stats() (*viewrepo.CouncilNodeStats, error) {
 	var err error
 
@@ -0,0 +1,183 @@
+package rdbviewrepo
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/crypto-com/chainindex"
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+)
+
+// evidencesSelectBuilder selects evidences together with the block carrying
+// them and their council node. Rows are scanned by scanEvidences
+func evidencesSelectBuilder(stmtBuilder sq.StatementBuilderType) sq.SelectBuilder {
+	return stmtBuilder.Select(
+		"e.block_height",
+		"b.time",
+		"b.hash",
+		"e.position",
+		"e.type",
+		"e.council_node_id",
+		"c.name",
+		"e.council_node_address",
+		"e.vote_height",
+		"e.vote_a",
+		"e.vote_b",
+	).From(
+		"block_evidences e",
+	).LeftJoin(
+		"blocks b ON e.block_height = b.height",
+	).LeftJoin(
+		"council_nodes c ON e.council_node_id = c.id",
+	).OrderBy(
+		"e.block_height DESC",
+		"e.position",
+	)
+}
+
+func scanEvidences(rowsResult adapter.RDbRowsResult, typeConv adapter.RDbTypeConv) ([]viewrepo.BlockEvidence, error) {
+	var err error
+
+	evidences := make([]viewrepo.BlockEvidence, 0)
+	for rowsResult.Next() {
+		var evidence viewrepo.BlockEvidence
+		var voteAJSON string
+		var voteBJSON string
+
+		blockTimeReader := typeConv.NtotReader()
+		if err = rowsResult.Scan(
+			&evidence.BlockHeight,
+			blockTimeReader.ScannableArg(),
+			&evidence.BlockHash,
+			&evidence.Position,
+			&evidence.Type,
+			&evidence.MaybeCouncilNodeId,
+			&evidence.MaybeCouncilNodeName,
+			&evidence.CouncilNodeAddress,
+			&evidence.VoteHeight,
+			&voteAJSON,
+			&voteBJSON,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning evidence row: %v: %w", err, adapter.ErrRepoQuery)
+		}
+		blockTime, err := blockTimeReader.Parse()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing block time: %v: %w", err, adapter.ErrRepoQuery)
+		}
+		evidence.BlockTime = *blockTime
+
+		if err = jsoniter.Unmarshal([]byte(voteAJSON), &evidence.VoteA); err != nil {
+			return nil, fmt.Errorf("error unmarshalling evidence vote A JSON: %v: %w", err, adapter.ErrRepoQuery)
+		}
+		if err = jsoniter.Unmarshal([]byte(voteBJSON), &evidence.VoteB); err != nil {
+			return nil, fmt.Errorf("error unmarshalling evidence vote B JSON: %v: %w", err, adapter.ErrRepoQuery)
+		}
+
+		evidences = append(evidences, evidence)
+	}
+	if err = rowsResult.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating evidence rows: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	return evidences, nil
+}
+
+// fillEvidencePunishments fills each evidence with the slash and jail
+// activities for Byzantine fault of its council node in the block carrying it.
+// Evidence of an unknown council node has no punishment
+func fillEvidencePunishments(
+	conn adapter.RDbConn,
+	stmtBuilder sq.StatementBuilderType,
+	typeConv adapter.RDbTypeConv,
+	evidences []viewrepo.BlockEvidence,
+) error {
+	for i := range evidences {
+		evidence := &evidences[i]
+		evidence.Punishments = make([]viewrepo.BlockEvidencePunishment, 0)
+		if evidence.MaybeCouncilNodeId == nil {
+			continue
+		}
+
+		sql, sqlArgs, err := stmtBuilder.Select(
+			"type",
+			"event_position",
+			"staking_account_address",
+			"bonded",
+			"unbonded",
+			"jailed_until",
+		).From(
+			"activities",
+		).Where(
+			"block_height = ? AND affected_council_node_id = ? AND type IN (?,?) AND punishment_kind = ?",
+			evidence.BlockHeight,
+			*evidence.MaybeCouncilNodeId,
+			adapter.ActivityTypeToString(chainindex.ACTIVITY_SLASH),
+			adapter.ActivityTypeToString(chainindex.ACTIVITY_JAIL),
+			adapter.PunishmentKindToString(chainindex.PUNISHMENT_KIND_BYZANTINE_FAULT),
+		).OrderBy(
+			"event_position",
+		).ToSql()
+		if err != nil {
+			return fmt.Errorf("error building evidence punishments select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+		}
+
+		if err = scanEvidencePunishments(conn, typeConv, evidence, sql, sqlArgs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func scanEvidencePunishments(
+	conn adapter.RDbConn,
+	typeConv adapter.RDbTypeConv,
+	evidence *viewrepo.BlockEvidence,
+	sql string,
+	sqlArgs []interface{},
+) error {
+	var err error
+
+	rowsResult, err := conn.Query(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error executing evidence punishments select SQL: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	defer rowsResult.Close()
+
+	for rowsResult.Next() {
+		var punishment viewrepo.BlockEvidencePunishment
+
+		bondedReader := typeConv.NtobReader()
+		unbondedReader := typeConv.NtobReader()
+		jailedUntilReader := typeConv.NtotReader()
+		if err = rowsResult.Scan(
+			&punishment.Type,
+			&punishment.MaybeEventPosition,
+			&punishment.MaybeStakingAccountAddress,
+			bondedReader.ScannableArg(),
+			unbondedReader.ScannableArg(),
+			jailedUntilReader.ScannableArg(),
+		); err != nil {
+			return fmt.Errorf("error scanning evidence punishment row: %v: %w", err, adapter.ErrRepoQuery)
+		}
+		if punishment.MaybeBonded, err = bondedReader.ParseW(); err != nil {
+			return fmt.Errorf("error parsing bonded: %v: %w", err, adapter.ErrRepoQuery)
+		}
+		if punishment.MaybeUnbonded, err = unbondedReader.ParseW(); err != nil {
+			return fmt.Errorf("error parsing unbonded: %v: %w", err, adapter.ErrRepoQuery)
+		}
+		if punishment.MaybeJailedUntil, err = jailedUntilReader.Parse(); err != nil {
+			return fmt.Errorf("error parsing jailed until: %v: %w", err, adapter.ErrRepoQuery)
+		}
+
+		evidence.Punishments = append(evidence.Punishments, punishment)
+	}
+	if err = rowsResult.Err(); err != nil {
+		return fmt.Errorf("error iterating evidence punishment rows: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package rdbviewrepo
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+)
+
+type RDbIndexBootstrapViewRepo struct {
+	conn adapter.RDbConn
+
+	stmtBuilder sq.StatementBuilderType
+	typeConv    adapter.RDbTypeConv
+}
+
+func NewRDbIndexBootstrapViewRepo(
+	conn adapter.RDbConn,
+	stmtBuilder sq.StatementBuilderType,
+	typeConv adapter.RDbTypeConv,
+) *RDbIndexBootstrapViewRepo {
+	return &RDbIndexBootstrapViewRepo{
+		conn,
+
+		stmtBuilder,
+		typeConv,
+	}
+}
+
+func (repo *RDbIndexBootstrapViewRepo) Find() (*viewrepo.IndexBootstrap, error) {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Select(
+		"height",
+		"block_hash",
+		"chain_id",
+		"source",
+		"bootstrapped_at",
+	).From(
+		"index_bootstrap",
+	).Limit(1).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building index bootstrap select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	var indexBootstrap viewrepo.IndexBootstrap
+	bootstrappedAtReader := repo.typeConv.NtotReader()
+	if err = repo.conn.QueryRow(sql, sqlArgs...).Scan(
+		&indexBootstrap.Height,
+		&indexBootstrap.BlockHash,
+		&indexBootstrap.ChainID,
+		&indexBootstrap.Source,
+		bootstrappedAtReader.ScannableArg(),
+	); err != nil {
+		if err == adapter.ErrNoRows {
+			return nil, adapter.ErrNotFound
+		}
+		return nil, fmt.Errorf("error scanning index bootstrap row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	var bootstrappedAt *time.Time
+	if bootstrappedAt, err = bootstrappedAtReader.Parse(); err != nil {
+		return nil, fmt.Errorf("error parsing index bootstrap time: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	indexBootstrap.BootstrappedAt = *bootstrappedAt
+
+	return &indexBootstrap, nil
+}
@@ -0,0 +1,161 @@
+package rdbviewrepo
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type RDbPendingTransactionViewRepo struct {
+	conn adapter.RDbConn
+
+	stmtBuilder sq.StatementBuilderType
+	typeConv    adapter.RDbTypeConv
+}
+
+func NewRDbPendingTransactionViewRepo(
+	conn adapter.RDbConn,
+	stmtBuilder sq.StatementBuilderType,
+	typeConv adapter.RDbTypeConv,
+) *RDbPendingTransactionViewRepo {
+	return &RDbPendingTransactionViewRepo{
+		conn,
+
+		stmtBuilder,
+		typeConv,
+	}
+}
+
+// ListPendingTransactions lists the most recently seen pending transactions
+// first
+func (repo *RDbPendingTransactionViewRepo) ListPendingTransactions(
+	pagination *viewrepo.Pagination,
+) ([]viewrepo.PendingTransaction, *viewrepo.PaginationResult, error) {
+	var err error
+
+	stmtBuilder := repo.selectStmtBuilder().OrderBy("p.first_seen_at DESC", "p.txid")
+
+	rDbPagination := adapter.NewRDbPaginationBuilder(
+		pagination,
+		repo.conn,
+	).BuildStmt(stmtBuilder)
+
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building pending transactions select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := repo.conn.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing pending transactions select SQL: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	defer rowsResult.Close()
+
+	pendingTransactions := make([]viewrepo.PendingTransaction, 0)
+	for rowsResult.Next() {
+		var pendingTransaction *viewrepo.PendingTransaction
+		if pendingTransaction, err = repo.scanPendingTransaction(rowsResult); err != nil {
+			return nil, nil, err
+		}
+
+		pendingTransactions = append(pendingTransactions, *pendingTransaction)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return pendingTransactions, paginationResult, nil
+}
+
+func (repo *RDbPendingTransactionViewRepo) FindPendingTransactionByTxId(txid string) (*viewrepo.PendingTransaction, error) {
+	var err error
+
+	sql, sqlArgs, err := repo.selectStmtBuilder().Where(
+		"p.txid = ?", txid,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building pending transaction select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	pendingTransaction, err := repo.scanPendingTransaction(repo.conn.QueryRow(sql, sqlArgs...))
+	if err != nil {
+		if err == adapter.ErrNoRows {
+			return nil, adapter.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return pendingTransaction, nil
+}
+
+// selectStmtBuilder excludes transactions already indexed from a block but not
+// yet removed from the table by the next poll of the mempool
+func (repo *RDbPendingTransactionViewRepo) selectStmtBuilder() sq.SelectBuilder {
+	return repo.stmtBuilder.Select(
+		"p.txid",
+		"p.type",
+		"p.inputs",
+		"p.output_count",
+		"p.staking_account_address",
+		"p.first_seen_at",
+		"p.last_seen_at",
+	).From(
+		"pending_transactions p",
+	).Where(
+		"NOT EXISTS (SELECT 1 FROM activities a WHERE a.txid = p.txid)",
+	)
+}
+
+func (repo *RDbPendingTransactionViewRepo) scanPendingTransaction(
+	row adapter.RDbRowResult,
+) (*viewrepo.PendingTransaction, error) {
+	var err error
+
+	var pendingTransaction viewrepo.PendingTransaction
+	var inputsJSON *string
+	firstSeenAtReader := repo.typeConv.NtotReader()
+	lastSeenAtReader := repo.typeConv.NtotReader()
+	if err = row.Scan(
+		&pendingTransaction.TxID,
+		&pendingTransaction.Type,
+		&inputsJSON,
+		&pendingTransaction.MaybeOutputCount,
+		&pendingTransaction.MaybeStakingAccountAddress,
+		firstSeenAtReader.ScannableArg(),
+		lastSeenAtReader.ScannableArg(),
+	); err != nil {
+		if err == adapter.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error scanning pending transaction row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	pendingTransaction.Status = viewrepo.TRANSACTION_STATUS_PENDING
+
+	if inputsJSON != nil {
+		var inputs []viewrepo.TransactionInput
+		if err = jsoniter.Unmarshal([]byte(*inputsJSON), &inputs); err != nil {
+			return nil, fmt.Errorf("error unmarshalling inputs JSON: %v: %w", err, adapter.ErrRepoQuery)
+		}
+
+		pendingTransaction.MaybeInputs = inputs
+	}
+
+	var firstSeenAt *time.Time
+	if firstSeenAt, err = firstSeenAtReader.Parse(); err != nil {
+		return nil, fmt.Errorf("error parsing pending transaction first seen time: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	pendingTransaction.FirstSeenAt = *firstSeenAt
+	var lastSeenAt *time.Time
+	if lastSeenAt, err = lastSeenAtReader.Parse(); err != nil {
+		return nil, fmt.Errorf("error parsing pending transaction last seen time: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	pendingTransaction.LastSeenAt = *lastSeenAt
+
+	return &pendingTransaction, nil
+}
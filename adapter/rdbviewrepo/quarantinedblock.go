@@ -0,0 +1,143 @@
+package rdbviewrepo
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+)
+
+type RDbQuarantinedBlockViewRepo struct {
+	conn adapter.RDbConn
+
+	stmtBuilder sq.StatementBuilderType
+	typeConv    adapter.RDbTypeConv
+}
+
+func NewRDbQuarantinedBlockViewRepo(
+	conn adapter.RDbConn,
+	stmtBuilder sq.StatementBuilderType,
+	typeConv adapter.RDbTypeConv,
+) *RDbQuarantinedBlockViewRepo {
+	return &RDbQuarantinedBlockViewRepo{
+		conn,
+
+		stmtBuilder,
+		typeConv,
+	}
+}
+
+func (repo *RDbQuarantinedBlockViewRepo) ListQuarantinedBlocks(
+	pagination *viewrepo.Pagination,
+) ([]viewrepo.QuarantinedBlock, *viewrepo.PaginationResult, error) {
+	var err error
+
+	stmtBuilder := repo.selectStmtBuilder().OrderBy("height")
+
+	rDbPagination := adapter.NewRDbPaginationBuilder(
+		pagination,
+		repo.conn,
+	).BuildStmt(stmtBuilder)
+
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building quarantined blocks select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := repo.conn.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing quarantined blocks select SQL: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	defer rowsResult.Close()
+
+	quarantinedBlocks := make([]viewrepo.QuarantinedBlock, 0)
+	for rowsResult.Next() {
+		var quarantinedBlock *viewrepo.QuarantinedBlock
+		if quarantinedBlock, err = repo.scanQuarantinedBlock(rowsResult); err != nil {
+			return nil, nil, err
+		}
+
+		quarantinedBlocks = append(quarantinedBlocks, *quarantinedBlock)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return quarantinedBlocks, paginationResult, nil
+}
+
+func (repo *RDbQuarantinedBlockViewRepo) FindQuarantinedBlock(height uint64) (*viewrepo.QuarantinedBlock, error) {
+	var err error
+
+	sql, sqlArgs, err := repo.selectStmtBuilder().Where(
+		"height = ?", height,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building quarantined block select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	quarantinedBlock, err := repo.scanQuarantinedBlock(repo.conn.QueryRow(sql, sqlArgs...))
+	if err != nil {
+		if err == adapter.ErrNoRows {
+			return nil, adapter.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return quarantinedBlock, nil
+}
+
+func (repo *RDbQuarantinedBlockViewRepo) selectStmtBuilder() sq.SelectBuilder {
+	return repo.stmtBuilder.Select(
+		"height",
+		"stage",
+		"error",
+		"payload",
+		"attempts",
+		"quarantined_at",
+		"last_attempted_at",
+	).From(
+		"quarantined_blocks",
+	)
+}
+
+func (repo *RDbQuarantinedBlockViewRepo) scanQuarantinedBlock(row adapter.RDbRowResult) (*viewrepo.QuarantinedBlock, error) {
+	var err error
+
+	var quarantinedBlock viewrepo.QuarantinedBlock
+	var payloadJSON string
+	quarantinedAtReader := repo.typeConv.NtotReader()
+	lastAttemptedAtReader := repo.typeConv.NtotReader()
+	if err = row.Scan(
+		&quarantinedBlock.Height,
+		&quarantinedBlock.Stage,
+		&quarantinedBlock.Error,
+		&payloadJSON,
+		&quarantinedBlock.Attempts,
+		quarantinedAtReader.ScannableArg(),
+		lastAttemptedAtReader.ScannableArg(),
+	); err != nil {
+		if err == adapter.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error scanning quarantined block row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	quarantinedBlock.Payload = []byte(payloadJSON)
+
+	var quarantinedAt *time.Time
+	if quarantinedAt, err = quarantinedAtReader.Parse(); err != nil {
+		return nil, fmt.Errorf("error parsing quarantined block quarantined time: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	quarantinedBlock.QuarantinedAt = *quarantinedAt
+	var lastAttemptedAt *time.Time
+	if lastAttemptedAt, err = lastAttemptedAtReader.Parse(); err != nil {
+		return nil, fmt.Errorf("error parsing quarantined block last attempted time: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	quarantinedBlock.LastAttemptedAt = *lastAttemptedAt
+
+	return &quarantinedBlock, nil
+}
@@ -0,0 +1,86 @@
+package rdbviewrepo
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+)
+
+type RDbSyncStateViewRepo struct {
+	conn adapter.RDbConn
+
+	stmtBuilder sq.StatementBuilderType
+	typeConv    adapter.RDbTypeConv
+}
+
+func NewRDbSyncStateViewRepo(
+	conn adapter.RDbConn,
+	stmtBuilder sq.StatementBuilderType,
+	typeConv adapter.RDbTypeConv,
+) *RDbSyncStateViewRepo {
+	return &RDbSyncStateViewRepo{
+		conn,
+
+		stmtBuilder,
+		typeConv,
+	}
+}
+
+func (repo *RDbSyncStateViewRepo) FindSyncState() (*viewrepo.SyncState, error) {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Select(
+		"last_stored_height",
+		"last_observed_tendermint_height",
+		"chain_id",
+		"parser_version",
+		"last_error",
+		"last_error_at",
+		"created_at",
+		"updated_at",
+	).From(
+		"sync_state",
+	).Limit(1).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building sync state select SQL: %v, %w", err, adapter.ErrBuildSQLStmt)
+	}
+
+	var syncState viewrepo.SyncState
+	lastErrorAtReader := repo.typeConv.NtotReader()
+	createdAtReader := repo.typeConv.NtotReader()
+	updatedAtReader := repo.typeConv.NtotReader()
+	if err = repo.conn.QueryRow(sql, sqlArgs...).Scan(
+		&syncState.LastStoredHeight,
+		&syncState.LastObservedTendermintHeight,
+		&syncState.MaybeChainID,
+		&syncState.ParserVersion,
+		&syncState.MaybeLastError,
+		lastErrorAtReader.ScannableArg(),
+		createdAtReader.ScannableArg(),
+		updatedAtReader.ScannableArg(),
+	); err != nil {
+		if err == adapter.ErrNoRows {
+			return nil, adapter.ErrNotFound
+		}
+		return nil, fmt.Errorf("error scanning sync state row: %v: %w", err, adapter.ErrRepoQuery)
+	}
+
+	if syncState.MaybeLastErrorAt, err = lastErrorAtReader.Parse(); err != nil {
+		return nil, fmt.Errorf("error parsing sync state last error time: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	var createdAt, updatedAt *time.Time
+	if createdAt, err = createdAtReader.Parse(); err != nil {
+		return nil, fmt.Errorf("error parsing sync state created time: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	syncState.CreatedAt = *createdAt
+	if updatedAt, err = updatedAtReader.Parse(); err != nil {
+		return nil, fmt.Errorf("error parsing sync state updated time: %v: %w", err, adapter.ErrRepoQuery)
+	}
+	syncState.UpdatedAt = *updatedAt
+
+	return &syncState, nil
+}
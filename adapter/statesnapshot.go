@@ -0,0 +1,187 @@
+package adapter
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/crypto-com/chainindex"
+	tenderminttypes "github.com/crypto-com/chainindex/adapter/tendermint/types"
+	"github.com/crypto-com/chainindex/internal/bignum"
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+// StateSnapshotJSON is the JSON export of a state snapshot. Heights, nonces
+// and amounts are strings as in Tendermint responses
+type StateSnapshotJSON struct {
+	ChainID string `json:"chain_id"`
+	Block   struct {
+		Height  string    `json:"height"`
+		Hash    string    `json:"hash"`
+		Time    time.Time `json:"time"`
+		AppHash string    `json:"app_hash"`
+	} `json:"block"`
+	StakingAccounts []StateSnapshotStakingAccountJSON `json:"staking_accounts"`
+}
+
+type StateSnapshotStakingAccountJSON struct {
+	Address        string     `json:"address"`
+	Nonce          string     `json:"nonce"`
+	Bonded         string     `json:"bonded"`
+	Unbonded       string     `json:"unbonded"`
+	UnbondedFrom   *time.Time `json:"unbonded_from"`
+	JailedUntil    *time.Time `json:"jailed_until"`
+	PunishmentKind *string    `json:"punishment_kind"`
+	CouncilNode    *struct {
+		Name            string  `json:"name"`
+		SecurityContact *string `json:"security_contact"`
+		PubKeyType      string  `json:"pubkey_type"`
+		PubKey          string  `json:"pubkey"`
+		Address         string  `json:"address"`
+	} `json:"council_node"`
+}
+
+// ParseStateSnapshot parses the JSON export of a state snapshot. Chain params
+// are parsed from the genesis when it is provided. Errors caused by malformed
+// snapshot wrap ErrMalformedStateSnapshot
+func ParseStateSnapshot(data []byte, maybeGenesis *tenderminttypes.Genesis) (*usecase.StateSnapshot, error) {
+	var err error
+
+	var snapshotJSON StateSnapshotJSON
+	if err = jsoniter.Unmarshal(data, &snapshotJSON); err != nil {
+		return nil, fmt.Errorf("error decoding state snapshot JSON: %v: %w", err, ErrMalformedStateSnapshot)
+	}
+
+	if snapshotJSON.ChainID == "" {
+		return nil, fmt.Errorf("error parsing state snapshot: missing chain id: %w", ErrMalformedStateSnapshot)
+	}
+	height, err := strconv.ParseUint(snapshotJSON.Block.Height, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing state snapshot block height: %v: %w", err, ErrMalformedStateSnapshot)
+	}
+	if height == uint64(0) {
+		return nil, fmt.Errorf("error parsing state snapshot: block height must be positive: %w", ErrMalformedStateSnapshot)
+	}
+	if snapshotJSON.Block.Hash == "" {
+		return nil, fmt.Errorf("error parsing state snapshot: missing block hash: %w", ErrMalformedStateSnapshot)
+	}
+
+	stakingAccounts := make([]chainindex.StakingAccount, 0, len(snapshotJSON.StakingAccounts))
+	for _, accountJSON := range snapshotJSON.StakingAccounts {
+		var account *chainindex.StakingAccount
+		if account, err = parseStateSnapshotStakingAccount(&accountJSON, height); err != nil {
+			return nil, err
+		}
+		stakingAccounts = append(stakingAccounts, *account)
+	}
+
+	var chainParams *chainindex.ChainParams
+	if maybeGenesis != nil {
+		if maybeGenesis.ChainID != snapshotJSON.ChainID {
+			return nil, fmt.Errorf(
+				"error parsing state snapshot: chain id %s mismatches genesis chain id %s: %w",
+				snapshotJSON.ChainID, maybeGenesis.ChainID, ErrMalformedStateSnapshot,
+			)
+		}
+		if chainParams, err = parseGenesisChainParams(maybeGenesis); err != nil {
+			return nil, err
+		}
+	}
+
+	return &usecase.StateSnapshot{
+		Block: chainindex.Block{
+			Height:  height,
+			Hash:    snapshotJSON.Block.Hash,
+			Time:    snapshotJSON.Block.Time,
+			AppHash: snapshotJSON.Block.AppHash,
+		},
+		StakingAccounts: stakingAccounts,
+		ChainParams:     chainParams,
+
+		ChainID: snapshotJSON.ChainID,
+	}, nil
+}
+
+func parseStateSnapshotStakingAccount(
+	accountJSON *StateSnapshotStakingAccountJSON,
+	height uint64,
+) (*chainindex.StakingAccount, error) {
+	var err error
+
+	if accountJSON.Address == "" {
+		return nil, fmt.Errorf("error parsing state snapshot staking account: missing address: %w", ErrMalformedStateSnapshot)
+	}
+
+	nonce := uint64(0)
+	if accountJSON.Nonce != "" {
+		if nonce, err = strconv.ParseUint(accountJSON.Nonce, 10, 64); err != nil {
+			return nil, fmt.Errorf(
+				"error parsing state snapshot staking account %s nonce: %v: %w", accountJSON.Address, err, ErrMalformedStateSnapshot,
+			)
+		}
+	}
+	bonded, unbonded := bignum.Int0(), bignum.Int0()
+	if accountJSON.Bonded != "" {
+		if bonded, err = bignum.Atoi(accountJSON.Bonded); err != nil {
+			return nil, fmt.Errorf(
+				"error parsing state snapshot staking account %s bonded amount: %v: %w", accountJSON.Address, err, ErrMalformedStateSnapshot,
+			)
+		}
+	}
+	if accountJSON.Unbonded != "" {
+		if unbonded, err = bignum.Atoi(accountJSON.Unbonded); err != nil {
+			return nil, fmt.Errorf(
+				"error parsing state snapshot staking account %s unbonded amount: %v: %w", accountJSON.Address, err, ErrMalformedStateSnapshot,
+			)
+		}
+	}
+
+	var punishmentKind *chainindex.PunishmentKind
+	if accountJSON.PunishmentKind != nil {
+		switch *accountJSON.PunishmentKind {
+		case "ByzantineFault", "NonLive":
+			kind := PunishmentKindFromString(*accountJSON.PunishmentKind)
+			punishmentKind = &kind
+		default:
+			return nil, fmt.Errorf(
+				"error parsing state snapshot staking account %s: unsupported punishment kind %s: %w",
+				accountJSON.Address, *accountJSON.PunishmentKind, ErrMalformedStateSnapshot,
+			)
+		}
+	}
+
+	var councilNode *chainindex.CouncilNode
+	if accountJSON.CouncilNode != nil {
+		if accountJSON.CouncilNode.PubKeyType != "ed25519" {
+			return nil, fmt.Errorf(
+				"error parsing state snapshot council node %s: unsupported pubkey type %s: %w",
+				accountJSON.CouncilNode.Name, accountJSON.CouncilNode.PubKeyType, ErrMalformedStateSnapshot,
+			)
+		}
+		// Council node may have joined before the snapshot height, but history
+		// before it is unavailable
+		councilNode = &chainindex.CouncilNode{
+			Id:                         nil,
+			Name:                       accountJSON.CouncilNode.Name,
+			MaybeSecurityContact:       accountJSON.CouncilNode.SecurityContact,
+			PubKeyType:                 chainindex.PUBKEY_TYPE_ED25519,
+			PubKey:                     accountJSON.CouncilNode.PubKey,
+			Address:                    accountJSON.CouncilNode.Address,
+			CreatedAtBlockHeight:       height,
+			MaybeLastLeftAtBlockHeight: nil,
+		}
+	}
+
+	return &chainindex.StakingAccount{
+		Address:                 accountJSON.Address,
+		Nonce:                   nonce,
+		Bonded:                  bonded,
+		Unbonded:                unbonded,
+		MaybeUnbondedFrom:       accountJSON.UnbondedFrom,
+		MaybePunishmentKind:     punishmentKind,
+		MaybeJailedUntil:        accountJSON.JailedUntil,
+		MaybeCurrentCouncilNode: councilNode,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package adapter_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chainindex"
+	"github.com/crypto-com/chainindex/adapter"
+	tenderminttypes "github.com/crypto-com/chainindex/adapter/tendermint/types"
+	"github.com/crypto-com/chainindex/internal/bignum"
+)
+
+const STATE_SNAPSHOT_JSON = `{
+	"chain_id": "testnet-thaler-crypto-com-chain-42",
+	"block": {
+		"height": "100000",
+		"hash": "B0E3C0A1E8D8E2C3A2D5F1A1C3B8E4D2A6C4B1E3D9F0A7C5B2E1D4F3A6C8B9E0",
+		"time": "2020-06-01T00:00:00Z",
+		"app_hash": "F1A1C3B8E4D2A6C4B1E3D9F0A7C5B2E1D4F3A6C8B9E0B0E3C0A1E8D8E2C3A2D5"
+	},
+	"staking_accounts": [
+		{
+			"address": "0x6dbd5b8fe0dad494465aa7574defba711c184102",
+			"nonce": "3",
+			"bonded": "600000000000000000",
+			"unbonded": "0",
+			"unbonded_from": null,
+			"jailed_until": null,
+			"punishment_kind": null,
+			"council_node": {
+				"name": "Crypto.com Council Node",
+				"security_contact": "security@crypto.com",
+				"pubkey_type": "ed25519",
+				"pubkey": "2lBGOGSKD8ffqaUDOHTamIoqfSUcKEXULwJqrbnQtzU=",
+				"address": "1BBDF2D8E9E4E58FA4F7E0D8E8BB7E6D0B4E5A8C"
+			}
+		},
+		{
+			"address": "0x4ae85b1c9e3a2b5a5f1b5b0c8c0d0a3e9e0f0d4c",
+			"nonce": "1",
+			"bonded": "0",
+			"unbonded": "5000",
+			"unbonded_from": "2020-06-02T00:00:00Z",
+			"jailed_until": null,
+			"punishment_kind": "NonLive"
+		}
+	]
+}`
+
+var _ = Describe("ParseStateSnapshot", func() {
+	It("should parse the snapshot block and staking accounts with their council nodes", func() {
+		snapshot, err := adapter.ParseStateSnapshot([]byte(STATE_SNAPSHOT_JSON), nil)
+
+		Expect(err).To(BeNil())
+		Expect(snapshot.ChainID).To(Equal("testnet-thaler-crypto-com-chain-42"))
+		Expect(snapshot.Block.Height).To(Equal(uint64(100000)))
+		Expect(snapshot.ChainParams).To(BeNil())
+		Expect(snapshot.StakingAccounts).To(HaveLen(2))
+
+		account := snapshot.StakingAccounts[0]
+		Expect(account.Nonce).To(Equal(uint64(3)))
+		Expect(account.Bonded).To(Equal(bignum.Int0().SetUint64(600000000000000000)))
+		Expect(account.MaybeCurrentCouncilNode).NotTo(BeNil())
+		Expect(account.MaybeCurrentCouncilNode.Name).To(Equal("Crypto.com Council Node"))
+		Expect(account.MaybeCurrentCouncilNode.CreatedAtBlockHeight).To(Equal(uint64(100000)))
+
+		unbondedAccount := snapshot.StakingAccounts[1]
+		Expect(unbondedAccount.MaybeCurrentCouncilNode).To(BeNil())
+		Expect(unbondedAccount.MaybeUnbondedFrom).NotTo(BeNil())
+		Expect(*unbondedAccount.MaybePunishmentKind).To(Equal(chainindex.PUNISHMENT_KIND_NON_LIVE))
+	})
+
+	It("should return ErrMalformedStateSnapshot when the block height is missing", func() {
+		_, err := adapter.ParseStateSnapshot([]byte(`{"chain_id":"test","block":{"hash":"ABCD"}}`), nil)
+
+		Expect(errors.Is(err, adapter.ErrMalformedStateSnapshot)).To(BeTrue())
+	})
+
+	It("should return ErrMalformedStateSnapshot when the chain id mismatches the genesis", func() {
+		genesis := &tenderminttypes.Genesis{
+			ChainID: "mainnet",
+		}
+
+		_, err := adapter.ParseStateSnapshot([]byte(STATE_SNAPSHOT_JSON), genesis)
+
+		Expect(errors.Is(err, adapter.ErrMalformedStateSnapshot)).To(BeTrue())
+	})
+})
@@ -0,0 +1,93 @@
+package adapter
+
+import "sync"
+
+// SwitchableRDbConn forwards to an underlying connection which can be switched
+// at runtime, e.g. to move repositories over to another database schema
+// without restarting.
+type SwitchableRDbConn struct {
+	mux  sync.RWMutex
+	conn RDbConn
+}
+
+func NewSwitchableRDbConn(conn RDbConn) *SwitchableRDbConn {
+	return &SwitchableRDbConn{
+		conn: conn,
+	}
+}
+
+// Switch waits for all in-flight transactions to finish, then runs switchFn
+// and forwards to the returned connection. No transaction can begin while
+// switchFn is running. The underlying connection is kept when switchFn returns
+// error
+func (switchable *SwitchableRDbConn) Switch(switchFn func() (RDbConn, error)) error {
+	switchable.mux.Lock()
+	defer switchable.mux.Unlock()
+
+	conn, err := switchFn()
+	if err != nil {
+		return err
+	}
+	switchable.conn = conn
+
+	return nil
+}
+
+// Begin a transaction on the current connection. The connection cannot be
+// switched until the transaction is committed or rolled back
+func (switchable *SwitchableRDbConn) Begin() (RDbTx, error) {
+	switchable.mux.RLock()
+
+	tx, err := switchable.conn.Begin()
+	if err != nil {
+		switchable.mux.RUnlock()
+		return nil, err
+	}
+
+	return &switchableRDbTx{
+		RDbTx:    tx,
+		onFinish: new(sync.Once),
+		unlock:   switchable.mux.RUnlock,
+	}, nil
+}
+
+func (switchable *SwitchableRDbConn) Exec(sql string, args ...interface{}) (RDbExecResult, error) {
+	switchable.mux.RLock()
+	defer switchable.mux.RUnlock()
+
+	return switchable.conn.Exec(sql, args...)
+}
+
+func (switchable *SwitchableRDbConn) Query(sql string, args ...interface{}) (RDbRowsResult, error) {
+	switchable.mux.RLock()
+	defer switchable.mux.RUnlock()
+
+	return switchable.conn.Query(sql, args...)
+}
+
+func (switchable *SwitchableRDbConn) QueryRow(sql string, args ...interface{}) RDbRowResult {
+	switchable.mux.RLock()
+	defer switchable.mux.RUnlock()
+
+	return switchable.conn.QueryRow(sql, args...)
+}
+
+// switchableRDbTx releases the switch lock once the transaction is finished
+type switchableRDbTx struct {
+	RDbTx
+
+	onFinish *sync.Once
+	unlock   func()
+}
+
+func (tx *switchableRDbTx) Commit() error {
+	err := tx.RDbTx.Commit()
+	tx.onFinish.Do(tx.unlock)
+	return err
+}
+
+func (tx *switchableRDbTx) Rollback() error {
+	err := tx.RDbTx.Rollback()
+	tx.onFinish.Do(tx.unlock)
+	return err
+}
@@ -0,0 +1,80 @@
+package adapter_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chainindex/adapter"
+	. "github.com/crypto-com/chainindex/adapter/test/mock"
+)
+
+var _ = Describe("SwitchableRDbConn", func() {
+	var mockConn *MockRDbConn
+	var switchableConn *adapter.SwitchableRDbConn
+	BeforeEach(func() {
+		mockConn = new(MockRDbConn)
+		switchableConn = adapter.NewSwitchableRDbConn(mockConn)
+	})
+
+	It("should implement RDbConn", func() {
+		var _ adapter.RDbConn = switchableConn
+	})
+
+	Describe("Switch", func() {
+		It("should forward to the switched connection afterwards", func() {
+			mockConn.On("Exec", "SELECT 1").Return(new(MockRDbExecResult), nil)
+			_, err := switchableConn.Exec("SELECT 1")
+			Expect(err).To(BeNil())
+
+			anotherMockConn := new(MockRDbConn)
+			anotherMockConn.On("Exec", "SELECT 1").Return(new(MockRDbExecResult), nil)
+			err = switchableConn.Switch(func() (adapter.RDbConn, error) {
+				return anotherMockConn, nil
+			})
+			Expect(err).To(BeNil())
+
+			_, err = switchableConn.Exec("SELECT 1")
+			Expect(err).To(BeNil())
+			mockConn.AssertNumberOfCalls(GinkgoT(), "Exec", 1)
+			anotherMockConn.AssertNumberOfCalls(GinkgoT(), "Exec", 1)
+		})
+
+		It("should keep the connection when switching fails", func() {
+			mockConn.On("Exec", "SELECT 1").Return(new(MockRDbExecResult), nil)
+
+			err := switchableConn.Switch(func() (adapter.RDbConn, error) {
+				return nil, errors.New("connection refused")
+			})
+			Expect(err).NotTo(BeNil())
+
+			_, err = switchableConn.Exec("SELECT 1")
+			Expect(err).To(BeNil())
+		})
+
+		It("should wait for the in-flight transaction to finish", func() {
+			mockTx := new(MockRDbTx)
+			mockTx.On("Commit").Return(nil)
+			mockTx.On("Rollback").Return(nil)
+			mockConn.On("Begin").Return(mockTx, nil)
+
+			tx, err := switchableConn.Begin()
+			Expect(err).To(BeNil())
+
+			switchedCh := make(chan error, 1)
+			go func() {
+				switchedCh <- switchableConn.Switch(func() (adapter.RDbConn, error) {
+					return new(MockRDbConn), nil
+				})
+			}()
+			Consistently(switchedCh).ShouldNot(Receive())
+
+			Expect(tx.Commit()).To(BeNil())
+			Eventually(switchedCh).Should(Receive(BeNil()))
+
+			// Calling rollback on committed transaction has no effect
+			Expect(tx.Rollback()).To(BeNil())
+		})
+	})
+})
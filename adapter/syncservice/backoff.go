@@ -0,0 +1,91 @@
+package syncservice
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+const (
+	DEFAULT_BACKOFF_INITIAL_INTERVAL = 1 * time.Second
+	DEFAULT_BACKOFF_MAX_INTERVAL     = 1 * time.Minute
+	DEFAULT_BACKOFF_MULTIPLIER       = 2.0
+	DEFAULT_BACKOFF_JITTER           = 0.5
+)
+
+type BackoffOptions struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	// Fraction of the interval randomized on each attempt, in range (0, 1].
+	// Workers failing together are spread out instead of retrying in lockstep.
+	// Jitter is disabled when it is negative
+	Jitter float64
+}
+
+// Backoff computes jittered exponential intervals between retries. It is not
+// safe for concurrent use
+type Backoff struct {
+	options BackoffOptions
+	random  *rand.Rand
+
+	attempts int
+}
+
+func NewBackoff(options BackoffOptions) *Backoff {
+	if options.InitialInterval == 0 {
+		options.InitialInterval = DEFAULT_BACKOFF_INITIAL_INTERVAL
+	}
+	if options.MaxInterval == 0 {
+		options.MaxInterval = DEFAULT_BACKOFF_MAX_INTERVAL
+	}
+	if options.MaxInterval < options.InitialInterval {
+		options.MaxInterval = options.InitialInterval
+	}
+	if options.Multiplier < 1 {
+		options.Multiplier = DEFAULT_BACKOFF_MULTIPLIER
+	}
+	if options.Jitter == 0 || options.Jitter > 1 {
+		options.Jitter = DEFAULT_BACKOFF_JITTER
+	} else if options.Jitter < 0 {
+		options.Jitter = 0
+	}
+
+	return &Backoff{
+		options: options,
+		random:  rand.New(rand.NewSource(time.Now().UnixNano())),
+
+		attempts: 0,
+	}
+}
+
+// Next returns the interval to wait before the next retry. The interval
+// grows exponentially up to the maximum interval, and is then randomized
+// within the jitter fraction without exceeding the maximum interval
+func (backoff *Backoff) Next() time.Duration {
+	interval := float64(backoff.options.InitialInterval)
+	for i := 0; i < backoff.attempts; i += 1 {
+		interval *= backoff.options.Multiplier
+		if interval >= float64(backoff.options.MaxInterval) {
+			interval = float64(backoff.options.MaxInterval)
+			break
+		}
+	}
+	backoff.attempts += 1
+
+	delta := interval * backoff.options.Jitter
+	minInterval := interval - delta
+	// Intervals near the maximum are only randomized below it, so that they
+	// are still spread out
+	maxInterval := math.Min(interval+delta, float64(backoff.options.MaxInterval))
+	return time.Duration(minInterval + backoff.random.Float64()*(maxInterval-minInterval))
+}
+
+// Attempts returns the number of intervals returned since the last reset
+func (backoff *Backoff) Attempts() int {
+	return backoff.attempts
+}
+
+func (backoff *Backoff) Reset() {
+	backoff.attempts = 0
+}
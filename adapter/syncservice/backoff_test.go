@@ -0,0 +1,82 @@
+package syncservice_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chainindex/adapter/syncservice"
+)
+
+var _ = Describe("Backoff", func() {
+	Describe("Next", func() {
+		It("should grow the interval exponentially up to the maximum interval", func() {
+			backoff := syncservice.NewBackoff(syncservice.BackoffOptions{
+				InitialInterval: 1 * time.Second,
+				MaxInterval:     5 * time.Second,
+				Multiplier:      2,
+				Jitter:          -1,
+			})
+
+			Expect(backoff.Next()).To(Equal(1 * time.Second))
+			Expect(backoff.Next()).To(Equal(2 * time.Second))
+			Expect(backoff.Next()).To(Equal(4 * time.Second))
+			Expect(backoff.Next()).To(Equal(5 * time.Second))
+			Expect(backoff.Next()).To(Equal(5 * time.Second))
+			Expect(backoff.Attempts()).To(Equal(5))
+		})
+
+		It("should randomize the interval within the jitter fraction", func() {
+			backoff := syncservice.NewBackoff(syncservice.BackoffOptions{
+				InitialInterval: 10 * time.Second,
+				MaxInterval:     20 * time.Second,
+				Multiplier:      1,
+				Jitter:          0.5,
+			})
+
+			intervals := make(map[time.Duration]bool)
+			for i := 0; i < 20; i += 1 {
+				interval := backoff.Next()
+				Expect(interval).To(BeNumerically(">=", 5*time.Second))
+				Expect(interval).To(BeNumerically("<=", 15*time.Second))
+				intervals[interval] = true
+			}
+			Expect(len(intervals)).To(BeNumerically(">", 1))
+		})
+
+		It("should never exceed the maximum interval after many attempts", func() {
+			backoff := syncservice.NewBackoff(syncservice.BackoffOptions{
+				InitialInterval: 1 * time.Second,
+				MaxInterval:     10 * time.Second,
+				Multiplier:      2,
+				Jitter:          0.5,
+			})
+
+			intervals := make(map[time.Duration]bool)
+			for i := 0; i < 100; i += 1 {
+				interval := backoff.Next()
+				Expect(interval).To(BeNumerically("<=", 10*time.Second))
+				if i >= 10 {
+					Expect(interval).To(BeNumerically(">=", 5*time.Second))
+					intervals[interval] = true
+				}
+			}
+			// Intervals at the maximum are still spread out
+			Expect(len(intervals)).To(BeNumerically(">", 1))
+		})
+
+		It("should start from the initial interval again after reset", func() {
+			backoff := syncservice.NewBackoff(syncservice.BackoffOptions{
+				InitialInterval: 1 * time.Second,
+				Jitter:          -1,
+			})
+
+			backoff.Next()
+			backoff.Next()
+			backoff.Reset()
+
+			Expect(backoff.Next()).To(Equal(1 * time.Second))
+		})
+	})
+})
@@ -1,53 +1,81 @@
 package syncservice
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
-	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex"
 	"github.com/crypto-com/chainindex/adapter/tendermint"
-	tenderminttypes "github.com/crypto-com/chainindex/adapter/tendermint/types"
 	"github.com/crypto-com/chainindex/usecase"
 )
 
 type BatchBlocksProcessor struct {
-	logger usecase.Logger
-	client tendermint.Client
+	logger      usecase.Logger
+	metrics     usecase.Metrics
+	client      tendermint.Client
+	quarantiner *BlockQuarantiner
+	concurrency *ConcurrencyController
+	options     BatchBlocksProcessorOptions
 
 	totalWorkingWorker    int
 	maxWorker             int
 	lastDistributedHeight uint64
 }
 
-func NewBatchBlocksProcessor(logger usecase.Logger, client tendermint.Client, maxWorker int) *BatchBlocksProcessor {
+type BatchBlocksProcessorOptions struct {
+	Concurrency ConcurrencyControllerOptions
+	// Backoff between retries of a block failing to be fetched
+	RetryBackoff BackoffOptions
+}
+
+func NewBatchBlocksProcessor(
+	logger usecase.Logger,
+	metrics usecase.Metrics,
+	client tendermint.Client,
+	quarantiner *BlockQuarantiner,
+	options BatchBlocksProcessorOptions,
+) *BatchBlocksProcessor {
+	concurrency := NewConcurrencyController(logger, metrics, options.Concurrency)
+
 	return &BatchBlocksProcessor{
 		logger: logger.WithFields(usecase.LogFields{
 			"module": "BatchBlocksProcessor",
 		}),
-		client: client,
+		metrics:     metrics,
+		client:      client,
+		quarantiner: quarantiner,
+		concurrency: concurrency,
+		options:     options,
 
 		totalWorkingWorker: 0,
-		maxWorker:          maxWorker,
+		maxWorker:          concurrency.options.MaxWorker,
 	}
 }
 
-func (processor *BatchBlocksProcessor) Run(params BlocksProcessorParams) {
+func (processor *BatchBlocksProcessor) Run(ctx context.Context, params BlocksProcessorParams) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			processor.logger.Panicf("panic when running: %v", r)
+			err = fmt.Errorf("panic when running: %v", r)
+			processor.logger.Error(err.Error())
 		}
 		processor.logger.Info("shutting down")
-		params.OnExitCh <- true
 	}()
 
 	processor.logger.Infof("staring from last synchronized height: %d", params.LastSyncHeight)
 
 	processor.lastDistributedHeight = params.LastSyncHeight
+	processor.metrics.SetGauge(usecase.METRIC_SYNC_MAX_BLOCK_WORKERS, float64(processor.maxWorker), nil)
 	aggregatorBlockDataInputCh := make(chan *usecase.BlockData, processor.maxWorker)
 	onWorkerAvailableCh := make(chan int, processor.maxWorker)
+	// Errors from the aggregator and workers running in their own goroutines
+	onErrorCh := make(chan error, 1)
 
 	aggregator := NewBatchBlocksAggregator(BatchBlocksAggregatorParams{
-		Logger: processor.logger,
+		Logger:  processor.logger,
+		Metrics: processor.metrics,
 
 		BlockDataInputCh: aggregatorBlockDataInputCh,
 		MaxSize:          processor.maxWorker,
@@ -57,47 +85,86 @@ func (processor *BatchBlocksProcessor) Run(params BlocksProcessorParams) {
 
 		OnWorkerAvailableCh: onWorkerAvailableCh,
 	})
-	go aggregator.Run()
+	go func() {
+		if aggregatorErr := aggregator.Run(ctx); aggregatorErr != nil {
+			reportError(onErrorCh, aggregatorErr)
+		}
+	}()
 
 	// Start the worker
 	onWorkerAvailableCh <- 0
 
 	for {
-		availableWorkerSize := <-onWorkerAvailableCh
-		processor.totalWorkingWorker -= availableWorkerSize
-
-		processor.DistributeBlocksToWorker(&params, aggregatorBlockDataInputCh)
+		select {
+		case <-ctx.Done():
+			return nil
+		case err = <-onErrorCh:
+			return err
+		case availableWorkerSize := <-onWorkerAvailableCh:
+			processor.totalWorkingWorker -= availableWorkerSize
+
+			processor.DistributeBlocksToWorker(ctx, &params, aggregatorBlockDataInputCh, onErrorCh)
+			processor.metrics.SetGauge(
+				usecase.METRIC_SYNC_WORKING_BLOCK_WORKERS, float64(processor.totalWorkingWorker), nil,
+			)
+		}
 	}
 }
 
-func (processor *BatchBlocksProcessor) DistributeBlocksToWorker(params *BlocksProcessorParams, aggregatorBlockDataCh chan<- *usecase.BlockData) {
+func (processor *BatchBlocksProcessor) DistributeBlocksToWorker(
+	ctx context.Context,
+	params *BlocksProcessorParams,
+	aggregatorBlockDataCh chan<- *usecase.BlockData,
+	onErrorCh chan<- error,
+) {
+	if params.StopHeight != 0 && processor.lastDistributedHeight >= params.StopHeight {
+		processor.logger.Debug("processor has free worker but all blocks up to stop height are distributed")
+		return
+	}
+
 	latestTendermintBlockHeight := params.TendermintHeight.Get()
 	if processor.lastDistributedHeight == latestTendermintBlockHeight {
 		processor.logger.Debug("processor has free worker but is blocked because of no new block")
 
-		<-params.OnTendermintHeightUpdate
+		select {
+		case <-ctx.Done():
+			return
+		case <-params.OnTendermintHeightUpdate:
+		}
 		latestTendermintBlockHeight = params.TendermintHeight.Get()
 	}
+	if params.StopHeight != 0 && latestTendermintBlockHeight > params.StopHeight {
+		latestTendermintBlockHeight = params.StopHeight
+	}
 
 	for processor.lastDistributedHeight < latestTendermintBlockHeight {
 		nextHeightToHandle := processor.lastDistributedHeight + 1
 		processor.logger.Debugf("trying to distribute block height %d", nextHeightToHandle)
 
-		if processor.totalWorkingWorker == processor.maxWorker {
+		// The limit may have been narrowed below the number of working workers
+		if processor.totalWorkingWorker >= processor.concurrency.Limit() {
 			processor.logger.Debug("processor worker size already exceed maximum worker allowed")
 			return
 		}
 
 		worker := NewBatchBlocksWorker(
 			processor.logger,
+			processor.metrics,
 			processor.client,
+			processor.quarantiner,
+			processor.concurrency,
+			NewBackoff(processor.options.RetryBackoff),
 
 			nextHeightToHandle,
 
 			aggregatorBlockDataCh,
 		)
 
-		go worker.Run()
+		go func() {
+			if workerErr := worker.Run(ctx); workerErr != nil {
+				reportError(onErrorCh, workerErr)
+			}
+		}()
 
 		processor.totalWorkingWorker += 1
 		processor.lastDistributedHeight += 1
@@ -105,21 +172,38 @@ func (processor *BatchBlocksProcessor) DistributeBlocksToWorker(params *BlocksPr
 }
 
 type BatchBlocksWorker struct {
-	logger usecase.Logger
-	client tendermint.Client
+	logger      usecase.Logger
+	metrics     usecase.Metrics
+	fetcher     *BlockDataFetcher
+	quarantiner *BlockQuarantiner
+	concurrency *ConcurrencyController
+	backoff     *Backoff
 
 	height uint64
 
 	blockDataCh chan<- *usecase.BlockData
 }
 
-func NewBatchBlocksWorker(logger usecase.Logger, client tendermint.Client, height uint64, blockDataCh chan<- *usecase.BlockData) *BatchBlocksWorker {
+func NewBatchBlocksWorker(
+	logger usecase.Logger,
+	metrics usecase.Metrics,
+	client tendermint.Client,
+	quarantiner *BlockQuarantiner,
+	concurrency *ConcurrencyController,
+	backoff *Backoff,
+	height uint64,
+	blockDataCh chan<- *usecase.BlockData,
+) *BatchBlocksWorker {
 	return &BatchBlocksWorker{
 		logger: logger.WithFields(usecase.LogFields{
 			"module":      "BatchBlocksWorker",
 			"blockHeight": height,
 		}),
-		client: client,
+		metrics:     metrics,
+		fetcher:     NewBlockDataFetcher(client),
+		quarantiner: quarantiner,
+		concurrency: concurrency,
+		backoff:     backoff,
 
 		height: height,
 
@@ -127,18 +211,49 @@ func NewBatchBlocksWorker(logger usecase.Logger, client tendermint.Client, heigh
 	}
 }
 
-func (worker *BatchBlocksWorker) Run() {
+// reportError sends the error to the channel without blocking. Only the first
+// error is needed to stop the processor
+func reportError(onErrorCh chan<- error, err error) {
+	select {
+	case onErrorCh <- err:
+	default:
+	}
+}
+
+// Run retries processing the block with jittered exponential backoff until it
+// succeeds or the context is done. A block which cannot be parsed is
+// quarantined instead of retried
+func (worker *BatchBlocksWorker) Run(ctx context.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic when processing block %d: %v", worker.height, r)
+			worker.logger.Error(err.Error())
+		}
+	}()
+
 	for {
-		err := worker.processBlock()
-		if err == nil {
-			break
+		processErr := worker.processBlock(ctx)
+		if processErr == nil {
+			return nil
+		}
+		if errors.Is(processErr, ErrSyncHalted) {
+			return processErr
 		}
 
-		<-time.After(5 * time.Second)
+		interval := worker.backoff.Next()
+		worker.logger.Infof("retrying block in %s after %d attempts", interval, worker.backoff.Attempts())
+		worker.metrics.AddCounter(usecase.METRIC_SYNC_BLOCK_RETRIES_TOTAL, 1, nil)
+		worker.metrics.ObserveHistogram(usecase.METRIC_SYNC_BLOCK_RETRY_BACKOFF_SECONDS, interval.Seconds(), nil)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
 	}
 }
 
-func (worker *BatchBlocksWorker) processBlock() error {
+func (worker *BatchBlocksWorker) processBlock(ctx context.Context) error {
 	var err error
 
 	logger := worker.logger.WithFields(usecase.LogFields{
@@ -147,73 +262,49 @@ func (worker *BatchBlocksWorker) processBlock() error {
 	logger.Info("processing block")
 
 	var blockData *usecase.BlockData
-	if worker.height == uint64(1) {
-		blockData, err = worker.handleGenesisBlock()
-	} else {
-		blockData, err = worker.handleBlock(worker.height)
-	}
+	startTime := time.Now()
+	blockData, err = worker.fetcher.Fetch(worker.height)
+	fetchDuration := time.Since(startTime)
 	if err != nil {
-		logger.Errorf("error processing block: %v", err)
-		return err
+		var parseErr *BlockParseError
+		if !errors.As(err, &parseErr) {
+			worker.concurrency.Observe(fetchDuration, err)
+			logger.Errorf("error processing block: %v", err)
+			return err
+		}
+
+		if err = worker.quarantiner.Quarantine(
+			worker.height, usecase.QUARANTINE_STAGE_PARSE, parseErr, parseErr.Payload,
+		); err != nil {
+			return err
+		}
+		// Downstream still needs the height to move past the block
+		blockData = &usecase.BlockData{
+			Block: chainindex.Block{
+				Height: worker.height,
+			},
+			Quarantined: true,
+		}
 	}
+	// A block failing to be parsed has still been fetched successfully
+	worker.concurrency.Observe(fetchDuration, nil)
 
 	logger.WithFields(usecase.LogFields{
 		"blockData": blockData,
 	}).Debug("processed block data")
 
-	worker.blockDataCh <- blockData
-
-	return nil
-}
-
-// TODO: Extract to standalone struct
-func (worker *BatchBlocksWorker) handleGenesisBlock() (*usecase.BlockData, error) {
-	var err error
-
-	var genesis *tenderminttypes.Genesis
-	genesis, err = worker.client.Genesis()
-	if err != nil {
-		return nil, err
+	select {
+	case <-ctx.Done():
+		return nil
+	case worker.blockDataCh <- blockData:
 	}
 
-	var block *tenderminttypes.Block
-	block, err = worker.client.Block(uint64(1))
-	if err != nil {
-		return nil, err
-	}
-
-	blockData := adapter.ParseGenesisToBlockData(adapter.TendermintGenesisBlockData{
-		Genesis: genesis,
-		Block:   block,
-	})
-
-	return blockData, nil
-}
-
-func (worker *BatchBlocksWorker) handleBlock(height uint64) (*usecase.BlockData, error) {
-	var err error
-
-	var block *tenderminttypes.Block
-	block, err = worker.client.Block(height)
-	if err != nil {
-		return nil, err
-	}
-	var blockResults *tenderminttypes.BlockResults
-	blockResults, err = worker.client.BlockResults(height)
-	if err != nil {
-		return nil, err
-	}
-
-	blockData := adapter.ParseBlockToBlockData(adapter.TendermintBlockData{
-		Block:        block,
-		BlockResults: blockResults,
-	})
-
-	return blockData, nil
+	return nil
 }
 
 type BatchBlocksAggregator struct {
-	logger usecase.Logger
+	logger  usecase.Logger
+	metrics usecase.Metrics
 
 	blockDataInputCh <-chan *usecase.BlockData
 	slidingWindow    *BatchBlocksProcessorSlidingWindow
@@ -227,6 +318,7 @@ func NewBatchBlocksAggregator(params BatchBlocksAggregatorParams) *BatchBlocksAg
 		logger: params.Logger.WithFields(usecase.LogFields{
 			"module": "BatchBlocksAggregator",
 		}),
+		metrics: params.Metrics,
 
 		blockDataInputCh: params.BlockDataInputCh,
 		slidingWindow:    NewBatchBlocksSlidingWindow(params.MaxSize, params.LastSyncHeight+1),
@@ -237,7 +329,8 @@ func NewBatchBlocksAggregator(params BatchBlocksAggregatorParams) *BatchBlocksAg
 }
 
 type BatchBlocksAggregatorParams struct {
-	Logger usecase.Logger
+	Logger  usecase.Logger
+	Metrics usecase.Metrics
 
 	BlockDataInputCh <-chan *usecase.BlockData
 	MaxSize          int
@@ -248,30 +341,60 @@ type BatchBlocksAggregatorParams struct {
 	OnWorkerAvailableCh chan<- int
 }
 
-func (aggregator *BatchBlocksAggregator) Run() {
+func (aggregator *BatchBlocksAggregator) Run(ctx context.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic when aggregating block data: %v", r)
+			aggregator.logger.Error(err.Error())
+		}
+	}()
+
 	for {
-		aggregator.ProcessBlockDataInput()
+		if ctxErr := aggregator.ProcessBlockDataInput(ctx); ctxErr != nil {
+			return nil
+		}
 	}
 }
 
-func (aggregator *BatchBlocksAggregator) ProcessBlockDataInput() {
-	blockData := <-aggregator.blockDataInputCh
+// ProcessBlockDataInput waits for a block data from workers and sends all
+// successive block data downstream. It returns the context error when the
+// context is done in between
+func (aggregator *BatchBlocksAggregator) ProcessBlockDataInput(ctx context.Context) error {
+	var blockData *usecase.BlockData
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case blockData = <-aggregator.blockDataInputCh:
+	}
 	aggregator.logger.WithFields(usecase.LogFields{
 		"blockHeight": blockData.Block.Height,
 	}).Debug("received block data from worker")
 
 	aggregator.slidingWindow.Insert(blockData.Block.Height, blockData)
 	someBlockData := aggregator.slidingWindow.PopSuccessiveBlockData()
+	aggregator.metrics.SetGauge(
+		usecase.METRIC_SYNC_SLIDING_WINDOW_BLOCKS, float64(aggregator.slidingWindow.Size()), nil,
+	)
 	aggregator.logger.WithFields(usecase.LogFields{
 		"blockDataSize": len(someBlockData),
 	}).Debug("going to notify worker available")
 
-	aggregator.onWorkerAvailableCh <- len(someBlockData)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case aggregator.onWorkerAvailableCh <- len(someBlockData):
+	}
 
 	aggregator.logger.Debug("going to send block data to downstream")
 	for _, blockData := range someBlockData {
-		aggregator.blockDataOutputCh <- blockData
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case aggregator.blockDataOutputCh <- blockData:
+		}
 	}
+
+	return nil
 }
 
 type BatchBlocksProcessorSlidingWindow struct {
@@ -320,6 +443,14 @@ func (window *BatchBlocksProcessorSlidingWindow) Get(height uint64) (*usecase.Bl
 	return blockData, true
 }
 
+// Size returns the number of block data in the window
+func (window *BatchBlocksProcessorSlidingWindow) Size() int {
+	window.RLock()
+	defer window.RUnlock()
+
+	return len(window.data)
+}
+
 func (window *BatchBlocksProcessorSlidingWindow) PopSuccessiveBlockData() []*usecase.BlockData {
 	window.Lock()
 	defer window.Unlock()
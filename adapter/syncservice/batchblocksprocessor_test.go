@@ -22,6 +22,7 @@ var _ = Describe("Batchblocksworker", func() {
 
 			syncservice.NewBatchBlocksAggregator(syncservice.BatchBlocksAggregatorParams{
 				Logger:           anyLogger,
+				Metrics:          new(FakeMetrics),
 				BlockDataInputCh: anyInputBlockDataCh,
 				MaxSize:          anyMaxSize,
 				LastSyncHeight:   anyInitHeight,
@@ -155,6 +156,20 @@ var _ = Describe("Batchblocksworker", func() {
 				Expect(blockDataAfterFirstGet).To(HaveLen(0))
 			})
 
+			It("should leave block height data which is not returned in the window", func() {
+				anySize := 5
+				initHeight := uint64(0)
+				window := syncservice.NewBatchBlocksSlidingWindow(anySize, initHeight)
+
+				InsertRandomBlockData(window, uint64(0))
+				InsertRandomBlockData(window, uint64(2))
+				InsertRandomBlockData(window, uint64(3))
+				Expect(window.Size()).To(Equal(3))
+
+				window.PopSuccessiveBlockData()
+				Expect(window.Size()).To(Equal(2))
+			})
+
 			// It("should free the space after successive block height data is retrieved", func () {
 			// 	var err error
 			// 	sizeOf3 := uint32(3)
@@ -0,0 +1,106 @@
+package syncservice
+
+import (
+	"fmt"
+
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/adapter/tendermint"
+	tenderminttypes "github.com/crypto-com/chainindex/adapter/tendermint/types"
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+// BlockDataFetcher requests a block from Tendermint and parses it into block
+// data. Genesis is parsed together with the first block
+type BlockDataFetcher struct {
+	client tendermint.Client
+}
+
+func NewBlockDataFetcher(client tendermint.Client) *BlockDataFetcher {
+	return &BlockDataFetcher{
+		client,
+	}
+}
+
+// BlockParseError is returned when the Tendermint responses of a block cannot
+// be parsed. Payload holds the responses the parser failed on
+type BlockParseError struct {
+	Height  uint64
+	Payload interface{}
+	Err     error
+}
+
+func (err *BlockParseError) Error() string {
+	return fmt.Sprintf("error parsing block %d: %v", err.Height, err.Err)
+}
+
+func (err *BlockParseError) Unwrap() error {
+	return err.Err
+}
+
+func (fetcher *BlockDataFetcher) Fetch(height uint64) (*usecase.BlockData, error) {
+	if height == uint64(1) {
+		return fetcher.handleGenesisBlock()
+	}
+	return fetcher.handleBlock(height)
+}
+
+func (fetcher *BlockDataFetcher) handleGenesisBlock() (*usecase.BlockData, error) {
+	var err error
+
+	var genesis *tenderminttypes.Genesis
+	genesis, err = fetcher.client.Genesis()
+	if err != nil {
+		return nil, err
+	}
+
+	var block *tenderminttypes.Block
+	block, err = fetcher.client.Block(uint64(1))
+	if err != nil {
+		return nil, err
+	}
+
+	rawBlockData := adapter.TendermintGenesisBlockData{
+		Genesis: genesis,
+		Block:   block,
+	}
+	blockData, err := adapter.ParseGenesisToBlockData(rawBlockData)
+	if err != nil {
+		return nil, &BlockParseError{
+			Height:  uint64(1),
+			Payload: rawBlockData,
+			Err:     err,
+		}
+	}
+
+	return blockData, nil
+}
+
+func (fetcher *BlockDataFetcher) handleBlock(height uint64) (*usecase.BlockData, error) {
+	var err error
+
+	var block *tenderminttypes.Block
+	block, err = fetcher.client.Block(height)
+	if err != nil {
+		return nil, err
+	}
+	var blockResults *tenderminttypes.BlockResults
+	blockResults, err = fetcher.client.BlockResults(height)
+	if err != nil {
+		return nil, err
+	}
+
+	rawBlockData := adapter.TendermintBlockData{
+		Block:        block,
+		BlockResults: blockResults,
+	}
+	blockData, err := adapter.ParseBlockToBlockData(rawBlockData)
+	if err != nil {
+		return nil, &BlockParseError{
+			Height:  height,
+			Payload: rawBlockData,
+			Err:     err,
+		}
+	}
+
+	return blockData, nil
+}
@@ -1,61 +1,255 @@
 package syncservice
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/crypto-com/chainindex/adapter"
 	"github.com/crypto-com/chainindex/usecase"
 )
 
 type BlockDataRepoWorker interface {
-	Run(params BlockDataRepoWorkerParams)
+	// Run until the context is done or an error occurs. Block data being
+	// stored when the context is done is either committed or rolled back
+	// before returning
+	Run(ctx context.Context, params BlockDataRepoWorkerParams) error
 }
 
 type BlockDataRepoWorkerParams struct {
+	TendermintHeight RWSerialUint64
+
 	BlockDataCh     <-chan *usecase.BlockData
 	OnBlockStoredCh chan<- uint64
-	OnExitCh        chan<- bool
+}
+
+// Consecutive block data are stored in a single transaction when the worker
+// is far behind the Tendermint latest block height
+type BlockDataRepoWorkerOptions struct {
+	// Maximum number of block data to store in a single transaction. Batching
+	// is disabled when it is not larger than 1
+	MaxBatchSize int
+	// Maximum duration to wait for filling up a batch
+	MaxBatchLatency time.Duration
+	// Store one block data per transaction when the block is within this
+	// number of blocks from the Tendermint latest block height
+	BatchThreshold uint64
 }
 
 type DefaultBlockDataRepoWorker struct {
 	logger        usecase.Logger
+	metrics       usecase.Metrics
 	blockDataRepo usecase.BlockDataRepository
+	quarantiner   *BlockQuarantiner
+	options       BlockDataRepoWorkerOptions
 }
 
 func NewDefaultBlockDataRepoWorker(
 	logger usecase.Logger,
+	metrics usecase.Metrics,
 	blockDataRepo usecase.BlockDataRepository,
+	quarantiner *BlockQuarantiner,
+	options BlockDataRepoWorkerOptions,
 ) *DefaultBlockDataRepoWorker {
 	return &DefaultBlockDataRepoWorker{
 		logger: logger.WithFields(usecase.LogFields{
 			"module": "DefaultBlockDataRepoWorker",
 		}),
+		metrics:       metrics,
 		blockDataRepo: blockDataRepo,
+		quarantiner:   quarantiner,
+		options:       options,
 	}
 }
 
-func (worker *DefaultBlockDataRepoWorker) Run(params BlockDataRepoWorkerParams) {
-	var err error
-
+func (worker *DefaultBlockDataRepoWorker) Run(ctx context.Context, params BlockDataRepoWorkerParams) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			worker.logger.Panicf("panic when running: %v", r)
+			err = fmt.Errorf("panic when running: %v", r)
+			worker.logger.Error(err.Error())
 		}
 		worker.logger.Info("shutting down")
-		params.OnExitCh <- true
 	}()
 
 	for {
-		blockData := <-params.BlockDataCh
-		for {
-			if err = worker.processBlockData(blockData); err != nil {
-				<-time.After(5 * time.Second)
-				continue
+		var blockData *usecase.BlockData
+		select {
+		case <-ctx.Done():
+			return nil
+		case blockData = <-params.BlockDataCh:
+		}
+		batch := worker.collectBatch(ctx, &params, blockData)
+
+		if err = worker.storeBatch(ctx, &params, batch); err != nil {
+			return err
+		}
+	}
+}
+
+// storeBatch retries storing the batch until it succeeds or the context is
+// done. When the batch fails because of the block data, the blocks are stored
+// one by one to quarantine the failing block. It only returns error when
+// synchronization is halted
+func (worker *DefaultBlockDataRepoWorker) storeBatch(
+	ctx context.Context,
+	params *BlockDataRepoWorkerParams,
+	batch []*usecase.BlockData,
+) error {
+	// Recorded into the sync state together with the block data
+	if params.TendermintHeight != nil {
+		tendermintHeight := params.TendermintHeight.Get()
+		for _, blockData := range batch {
+			blockData.TendermintHeight = tendermintHeight
+		}
+	}
+
+	for {
+		// Store is not bounded by the context so that in-flight block data is
+		// either committed or rolled back as a whole
+		startedAt := time.Now()
+		processErr := worker.processBatch(batch)
+		worker.metrics.ObserveHistogram(
+			usecase.METRIC_SYNC_STORE_DURATION_SECONDS, time.Since(startedAt).Seconds(), nil,
+		)
+		if processErr == nil {
+			worker.notifyBlockStored(ctx, params, batch)
+			return nil
+		}
+		worker.metrics.AddCounter(usecase.METRIC_SYNC_STORE_ERRORS_TOTAL, 1, nil)
+
+		// Following a different chain is never skipped, whatever the policy is
+		if adapter.IsChainMismatchError(processErr) {
+			return worker.quarantiner.Halt(batch[0].Block.Height, usecase.QUARANTINE_STAGE_STORE, processErr)
+		}
+
+		if adapter.IsBlockDataError(processErr) {
+			if len(batch) > 1 {
+				for _, blockData := range batch {
+					if err := worker.storeBatch(ctx, params, []*usecase.BlockData{blockData}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+
+			quarantineErr := worker.quarantiner.Quarantine(
+				batch[0].Block.Height, usecase.QUARANTINE_STAGE_STORE, processErr, batch[0],
+			)
+			if quarantineErr == nil {
+				worker.notifyBlockStored(ctx, params, batch)
+				return nil
+			}
+			if errors.Is(quarantineErr, ErrSyncHalted) {
+				return quarantineErr
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func (worker *DefaultBlockDataRepoWorker) notifyBlockStored(
+	ctx context.Context,
+	params *BlockDataRepoWorkerParams,
+	batch []*usecase.BlockData,
+) {
+	storedCount := 0
+	for _, blockData := range batch {
+		if !blockData.Quarantined {
+			storedCount += 1
+		}
+	}
+	worker.metrics.AddCounter(usecase.METRIC_SYNC_STORED_BLOCKS_TOTAL, float64(storedCount), nil)
+
+	select {
+	case <-ctx.Done():
+	case params.OnBlockStoredCh <- batch[len(batch)-1].Block.Height:
+	}
+}
+
+// collectBatch keeps receiving consecutive block data into the batch while the
+// worker is far behind the Tendermint latest block height, until the batch is
+// full or the maximum batch latency is reached
+func (worker *DefaultBlockDataRepoWorker) collectBatch(
+	ctx context.Context,
+	params *BlockDataRepoWorkerParams,
+	firstBlockData *usecase.BlockData,
+) []*usecase.BlockData {
+	batch := []*usecase.BlockData{firstBlockData}
+	if !worker.isBatchable(params, firstBlockData) {
+		return batch
+	}
+
+	timeoutCh := time.After(worker.options.MaxBatchLatency)
+	for len(batch) < worker.options.MaxBatchSize {
+		select {
+		case <-ctx.Done():
+			return batch
+		case <-timeoutCh:
+			return batch
+		case blockData := <-params.BlockDataCh:
+			batch = append(batch, blockData)
+			if !worker.isBatchable(params, blockData) {
+				return batch
 			}
+		}
+	}
+
+	return batch
+}
+
+func (worker *DefaultBlockDataRepoWorker) isBatchable(
+	params *BlockDataRepoWorkerParams,
+	blockData *usecase.BlockData,
+) bool {
+	if worker.options.MaxBatchSize <= 1 || params.TendermintHeight == nil {
+		return false
+	}
+
+	tendermintHeight := params.TendermintHeight.Get()
+	if blockData.Block.Height >= tendermintHeight {
+		return false
+	}
 
-			params.OnBlockStoredCh <- blockData.Block.Height
-			break
+	return tendermintHeight-blockData.Block.Height > worker.options.BatchThreshold
+}
+
+func (worker *DefaultBlockDataRepoWorker) processBatch(batch []*usecase.BlockData) error {
+	// Quarantined block data are skipped. Heights of the rest are no longer
+	// consecutive but still in order
+	unquarantinedBatch := make([]*usecase.BlockData, 0, len(batch))
+	for _, blockData := range batch {
+		if !blockData.Quarantined {
+			unquarantinedBatch = append(unquarantinedBatch, blockData)
 		}
 	}
+	batch = unquarantinedBatch
+
+	if len(batch) == 0 {
+		return nil
+	}
+	if len(batch) == 1 {
+		return worker.processBlockData(batch[0])
+	}
+
+	logger := worker.logger.WithFields(usecase.LogFields{
+		"fromBlockHeight": batch[0].Block.Height,
+		"toBlockHeight":   batch[len(batch)-1].Block.Height,
+	})
+	logger.Debug("storing batch of block data")
+
+	err := worker.blockDataRepo.StoreBatch(batch)
+	if err != nil {
+		logger.Errorf("error storing batch of block data: %v", err)
+		return err
+	}
+
+	return nil
 }
 
 func (worker *DefaultBlockDataRepoWorker) processBlockData(blockData *usecase.BlockData) error {
@@ -0,0 +1,354 @@
+package syncservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex"
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/adapter/syncservice"
+	"github.com/crypto-com/chainindex/usecase"
+	. "github.com/crypto-com/chainindex/usecase/test/factory"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+	. "github.com/crypto-com/chainindex/usecase/test/mock"
+)
+
+var _ = Describe("DefaultBlockDataRepoWorker", func() {
+	var mockQuarantinedBlockRepo *MockQuarantinedBlockRepo
+	var mockSyncStateRepo *MockSyncStateRepo
+	var quarantiner *syncservice.BlockQuarantiner
+	BeforeEach(func() {
+		mockQuarantinedBlockRepo = new(MockQuarantinedBlockRepo)
+		mockSyncStateRepo = new(MockSyncStateRepo)
+		mockSyncStateRepo.On("RecordError", mock.Anything, mock.Anything).Return(nil)
+		quarantiner = syncservice.NewBlockQuarantiner(
+			new(FakeLogger), new(FakeMetrics), mockQuarantinedBlockRepo, mockSyncStateRepo, syncservice.BLOCK_ERROR_POLICY_HALT,
+		)
+	})
+
+	Describe("Run", func() {
+		It("should store block data and notify the stored block height", func() {
+			mockBlockDataRepo := new(MockBlockDataRepo)
+			mockBlockDataRepo.On("Store", mock.Anything).Return(nil)
+			worker := syncservice.NewDefaultBlockDataRepoWorker(
+				new(FakeLogger), new(FakeMetrics), mockBlockDataRepo, quarantiner, syncservice.BlockDataRepoWorkerOptions{},
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			blockDataCh := make(chan *usecase.BlockData, 1)
+			onBlockStoredCh := make(chan uint64, 1)
+			go func() {
+				_ = worker.Run(ctx, syncservice.BlockDataRepoWorkerParams{
+					BlockDataCh:     blockDataCh,
+					OnBlockStoredCh: onBlockStoredCh,
+				})
+			}()
+
+			anyBlockData := RandomBlockData()
+			blockDataCh <- &anyBlockData
+
+			Eventually(onBlockStoredCh).Should(Receive(Equal(anyBlockData.Block.Height)))
+			mockBlockDataRepo.AssertCalled(GinkgoT(), "Store", &anyBlockData)
+		})
+
+		It("should return nil when the context is done", func() {
+			worker := syncservice.NewDefaultBlockDataRepoWorker(
+				new(FakeLogger), new(FakeMetrics), new(MockBlockDataRepo), quarantiner, syncservice.BlockDataRepoWorkerOptions{},
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			doneCh := make(chan error, 1)
+			go func() {
+				doneCh <- worker.Run(ctx, syncservice.BlockDataRepoWorkerParams{
+					BlockDataCh:     make(chan *usecase.BlockData),
+					OnBlockStoredCh: make(chan uint64),
+				})
+			}()
+
+			Consistently(doneCh).ShouldNot(Receive())
+			cancel()
+			Eventually(doneCh).Should(Receive(BeNil()))
+		})
+
+		It("should stop retrying failed block data when the context is done", func() {
+			onStoreCalledCh := make(chan bool, 1)
+			mockBlockDataRepo := new(MockBlockDataRepo)
+			mockBlockDataRepo.On("Store", mock.Anything).Run(func(_ mock.Arguments) {
+				onStoreCalledCh <- true
+			}).Return(errors.New("connection lost"))
+			worker := syncservice.NewDefaultBlockDataRepoWorker(
+				new(FakeLogger), new(FakeMetrics), mockBlockDataRepo, quarantiner, syncservice.BlockDataRepoWorkerOptions{},
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			blockDataCh := make(chan *usecase.BlockData, 1)
+			doneCh := make(chan error, 1)
+			go func() {
+				doneCh <- worker.Run(ctx, syncservice.BlockDataRepoWorkerParams{
+					BlockDataCh:     blockDataCh,
+					OnBlockStoredCh: make(chan uint64),
+				})
+			}()
+
+			anyBlockData := RandomBlockData()
+			blockDataCh <- &anyBlockData
+			Eventually(onStoreCalledCh).Should(Receive())
+
+			cancel()
+			Eventually(doneCh).Should(Receive(BeNil()))
+		})
+
+		It("should return error when storing block data panics", func() {
+			mockBlockDataRepo := new(MockBlockDataRepo)
+			mockBlockDataRepo.On("Store", mock.Anything).Run(func(_ mock.Arguments) {
+				panic("staking account not found")
+			})
+			worker := syncservice.NewDefaultBlockDataRepoWorker(
+				new(FakeLogger), new(FakeMetrics), mockBlockDataRepo, quarantiner, syncservice.BlockDataRepoWorkerOptions{},
+			)
+
+			blockDataCh := make(chan *usecase.BlockData, 1)
+			anyBlockData := RandomBlockData()
+			blockDataCh <- &anyBlockData
+
+			err := worker.Run(context.Background(), syncservice.BlockDataRepoWorkerParams{
+				BlockDataCh:     blockDataCh,
+				OnBlockStoredCh: make(chan uint64, 1),
+			})
+
+			Expect(err).To(MatchError(ContainSubstring("staking account not found")))
+		})
+
+		It("should skip quarantined block data and notify its height", func() {
+			mockBlockDataRepo := new(MockBlockDataRepo)
+			worker := syncservice.NewDefaultBlockDataRepoWorker(
+				new(FakeLogger), new(FakeMetrics), mockBlockDataRepo, quarantiner, syncservice.BlockDataRepoWorkerOptions{},
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			blockDataCh := make(chan *usecase.BlockData, 1)
+			onBlockStoredCh := make(chan uint64, 1)
+			go func() {
+				_ = worker.Run(ctx, syncservice.BlockDataRepoWorkerParams{
+					BlockDataCh:     blockDataCh,
+					OnBlockStoredCh: onBlockStoredCh,
+				})
+			}()
+
+			blockDataCh <- &usecase.BlockData{
+				Block: chainindex.Block{
+					Height: uint64(10),
+				},
+				Quarantined: true,
+			}
+
+			Eventually(onBlockStoredCh).Should(Receive(Equal(uint64(10))))
+			mockBlockDataRepo.AssertNotCalled(GinkgoT(), "Store", mock.Anything)
+		})
+
+		It("should quarantine block data inconsistent with stored projections and halt", func() {
+			mockBlockDataRepo := new(MockBlockDataRepo)
+			mockBlockDataRepo.On("Store", mock.Anything).Return(
+				fmt.Errorf("error storing activity: %w", adapter.ErrInconsistentBlockData),
+			)
+			mockQuarantinedBlockRepo.On("Quarantine", mock.Anything).Return(nil)
+			worker := syncservice.NewDefaultBlockDataRepoWorker(
+				new(FakeLogger), new(FakeMetrics), mockBlockDataRepo, quarantiner, syncservice.BlockDataRepoWorkerOptions{},
+			)
+
+			blockDataCh := make(chan *usecase.BlockData, 1)
+			anyBlockData := RandomBlockData()
+			blockDataCh <- &anyBlockData
+
+			err := worker.Run(context.Background(), syncservice.BlockDataRepoWorkerParams{
+				BlockDataCh:     blockDataCh,
+				OnBlockStoredCh: make(chan uint64, 1),
+			})
+
+			Expect(errors.Is(err, syncservice.ErrSyncHalted)).To(BeTrue())
+			mockQuarantinedBlockRepo.AssertCalled(GinkgoT(), "Quarantine", mock.MatchedBy(
+				func(block *usecase.QuarantinedBlock) bool {
+					return block.Height == anyBlockData.Block.Height &&
+						block.Stage == usecase.QUARANTINE_STAGE_STORE &&
+						strings.Contains(block.Error, "error storing activity")
+				},
+			))
+		})
+
+		It("should halt without quarantining block data of a different chain even when policy is continue", func() {
+			quarantiner = syncservice.NewBlockQuarantiner(
+				new(FakeLogger), new(FakeMetrics), mockQuarantinedBlockRepo, mockSyncStateRepo, syncservice.BLOCK_ERROR_POLICY_CONTINUE,
+			)
+			mockBlockDataRepo := new(MockBlockDataRepo)
+			mockBlockDataRepo.On("Store", mock.Anything).Return(
+				fmt.Errorf("error storing block 10: %w", adapter.ErrParentBlockMismatch),
+			)
+			worker := syncservice.NewDefaultBlockDataRepoWorker(
+				new(FakeLogger), new(FakeMetrics), mockBlockDataRepo, quarantiner, syncservice.BlockDataRepoWorkerOptions{},
+			)
+
+			blockDataCh := make(chan *usecase.BlockData, 1)
+			anyBlockData := RandomBlockData()
+			blockDataCh <- &anyBlockData
+
+			err := worker.Run(context.Background(), syncservice.BlockDataRepoWorkerParams{
+				BlockDataCh:     blockDataCh,
+				OnBlockStoredCh: make(chan uint64, 1),
+			})
+
+			Expect(errors.Is(err, syncservice.ErrSyncHalted)).To(BeTrue())
+			mockQuarantinedBlockRepo.AssertNotCalled(GinkgoT(), "Quarantine", mock.Anything)
+			mockSyncStateRepo.AssertCalled(GinkgoT(), "RecordError", mock.MatchedBy(func(message string) bool {
+				return strings.Contains(message, adapter.ErrParentBlockMismatch.Error())
+			}), mock.Anything)
+		})
+
+		Context("When batching is enabled", func() {
+			var options syncservice.BlockDataRepoWorkerOptions
+			var blockDataList []*usecase.BlockData
+			BeforeEach(func() {
+				options = syncservice.BlockDataRepoWorkerOptions{
+					MaxBatchSize:    3,
+					MaxBatchLatency: 1 * time.Second,
+					BatchThreshold:  10,
+				}
+
+				blockDataList = make([]*usecase.BlockData, 0, 3)
+				for i := uint64(1); i <= 3; i += 1 {
+					blockData := RandomBlockData()
+					blockData.Block.Height = i
+					blockDataList = append(blockDataList, &blockData)
+				}
+			})
+
+			It("should store consecutive block data in one batch when far behind the latest block height", func() {
+				mockBlockDataRepo := new(MockBlockDataRepo)
+				mockBlockDataRepo.On("StoreBatch", mock.Anything).Return(nil)
+				worker := syncservice.NewDefaultBlockDataRepoWorker(new(FakeLogger), new(FakeMetrics), mockBlockDataRepo, quarantiner, options)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				blockDataCh := make(chan *usecase.BlockData, 3)
+				onBlockStoredCh := make(chan uint64, 1)
+				for _, blockData := range blockDataList {
+					blockDataCh <- blockData
+				}
+				go func() {
+					_ = worker.Run(ctx, syncservice.BlockDataRepoWorkerParams{
+						TendermintHeight: syncservice.NewDefaultRWSerialUint64(1000),
+
+						BlockDataCh:     blockDataCh,
+						OnBlockStoredCh: onBlockStoredCh,
+					})
+				}()
+
+				Eventually(onBlockStoredCh).Should(Receive(Equal(uint64(3))))
+				mockBlockDataRepo.AssertCalled(GinkgoT(), "StoreBatch", blockDataList)
+				mockBlockDataRepo.AssertNotCalled(GinkgoT(), "Store", mock.Anything)
+			})
+
+			It("should store partial batch when maximum batch latency is reached", func() {
+				options.MaxBatchLatency = 10 * time.Millisecond
+
+				mockBlockDataRepo := new(MockBlockDataRepo)
+				mockBlockDataRepo.On("StoreBatch", mock.Anything).Return(nil)
+				worker := syncservice.NewDefaultBlockDataRepoWorker(new(FakeLogger), new(FakeMetrics), mockBlockDataRepo, quarantiner, options)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				blockDataCh := make(chan *usecase.BlockData, 3)
+				onBlockStoredCh := make(chan uint64, 1)
+				blockDataCh <- blockDataList[0]
+				blockDataCh <- blockDataList[1]
+				go func() {
+					_ = worker.Run(ctx, syncservice.BlockDataRepoWorkerParams{
+						TendermintHeight: syncservice.NewDefaultRWSerialUint64(1000),
+
+						BlockDataCh:     blockDataCh,
+						OnBlockStoredCh: onBlockStoredCh,
+					})
+				}()
+
+				Eventually(onBlockStoredCh).Should(Receive(Equal(uint64(2))))
+				mockBlockDataRepo.AssertCalled(GinkgoT(), "StoreBatch", blockDataList[:2])
+			})
+
+			It("should store one block data per transaction when near the latest block height", func() {
+				mockBlockDataRepo := new(MockBlockDataRepo)
+				mockBlockDataRepo.On("Store", mock.Anything).Return(nil)
+				worker := syncservice.NewDefaultBlockDataRepoWorker(new(FakeLogger), new(FakeMetrics), mockBlockDataRepo, quarantiner, options)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				blockDataCh := make(chan *usecase.BlockData, 3)
+				onBlockStoredCh := make(chan uint64, 3)
+				for _, blockData := range blockDataList {
+					blockDataCh <- blockData
+				}
+				go func() {
+					_ = worker.Run(ctx, syncservice.BlockDataRepoWorkerParams{
+						TendermintHeight: syncservice.NewDefaultRWSerialUint64(5),
+
+						BlockDataCh:     blockDataCh,
+						OnBlockStoredCh: onBlockStoredCh,
+					})
+				}()
+
+				Eventually(onBlockStoredCh).Should(Receive(Equal(uint64(1))))
+				Eventually(onBlockStoredCh).Should(Receive(Equal(uint64(2))))
+				Eventually(onBlockStoredCh).Should(Receive(Equal(uint64(3))))
+				mockBlockDataRepo.AssertNumberOfCalls(GinkgoT(), "Store", 3)
+				mockBlockDataRepo.AssertNotCalled(GinkgoT(), "StoreBatch", mock.Anything)
+			})
+
+			It("should store block data one by one and quarantine the failing one when policy is continue", func() {
+				quarantiner = syncservice.NewBlockQuarantiner(
+					new(FakeLogger), new(FakeMetrics), mockQuarantinedBlockRepo, mockSyncStateRepo, syncservice.BLOCK_ERROR_POLICY_CONTINUE,
+				)
+				blockDataErr := fmt.Errorf("error storing activity: %w", adapter.ErrInconsistentBlockData)
+				mockBlockDataRepo := new(MockBlockDataRepo)
+				mockBlockDataRepo.On("StoreBatch", mock.Anything).Return(blockDataErr)
+				mockBlockDataRepo.On("Store", blockDataList[0]).Return(nil)
+				mockBlockDataRepo.On("Store", blockDataList[1]).Return(blockDataErr)
+				mockBlockDataRepo.On("Store", blockDataList[2]).Return(nil)
+				mockQuarantinedBlockRepo.On("Quarantine", mock.Anything).Return(nil)
+				worker := syncservice.NewDefaultBlockDataRepoWorker(new(FakeLogger), new(FakeMetrics), mockBlockDataRepo, quarantiner, options)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				blockDataCh := make(chan *usecase.BlockData, 3)
+				onBlockStoredCh := make(chan uint64, 3)
+				for _, blockData := range blockDataList {
+					blockDataCh <- blockData
+				}
+				go func() {
+					_ = worker.Run(ctx, syncservice.BlockDataRepoWorkerParams{
+						TendermintHeight: syncservice.NewDefaultRWSerialUint64(1000),
+
+						BlockDataCh:     blockDataCh,
+						OnBlockStoredCh: onBlockStoredCh,
+					})
+				}()
+
+				Eventually(onBlockStoredCh).Should(Receive(Equal(uint64(1))))
+				Eventually(onBlockStoredCh).Should(Receive(Equal(uint64(2))))
+				Eventually(onBlockStoredCh).Should(Receive(Equal(uint64(3))))
+				mockQuarantinedBlockRepo.AssertNumberOfCalls(GinkgoT(), "Quarantine", 1)
+				mockQuarantinedBlockRepo.AssertCalled(GinkgoT(), "Quarantine", mock.MatchedBy(
+					func(block *usecase.QuarantinedBlock) bool {
+						return block.Height == uint64(2)
+					},
+				))
+			})
+		})
+	})
+})
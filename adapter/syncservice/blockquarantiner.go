@@ -0,0 +1,151 @@
+package syncservice
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+// ErrSyncHalted is returned when synchronization is halted on a block failing
+// deterministically
+var ErrSyncHalted = errors.New("synchronization halted")
+
+// Policies on a block failing deterministically
+const (
+	// Stop synchronization at the block
+	BLOCK_ERROR_POLICY_HALT = "halt"
+	// Skip the block and continue synchronization with the next one
+	BLOCK_ERROR_POLICY_CONTINUE = "continue"
+)
+
+// BlockQuarantiner puts blocks failing deterministically into quarantine and
+// decides whether synchronization should continue according to the policy
+type BlockQuarantiner struct {
+	logger  usecase.Logger
+	metrics usecase.Metrics
+	repo    usecase.QuarantinedBlockRepository
+	// Last error of a quarantined or halting block is kept in the sync state
+	// for diagnosis
+	syncStateRepo usecase.SyncStateRepository
+
+	policy string
+}
+
+func NewBlockQuarantiner(
+	logger usecase.Logger,
+	metrics usecase.Metrics,
+	repo usecase.QuarantinedBlockRepository,
+	syncStateRepo usecase.SyncStateRepository,
+	policy string,
+) *BlockQuarantiner {
+	if policy == "" {
+		policy = BLOCK_ERROR_POLICY_HALT
+	}
+
+	return &BlockQuarantiner{
+		logger: logger.WithFields(usecase.LogFields{
+			"module": "BlockQuarantiner",
+		}),
+		metrics: metrics,
+		repo:    repo,
+
+		syncStateRepo: syncStateRepo,
+
+		policy: policy,
+	}
+}
+
+// Quarantine records the block together with the error and the payload it
+// failed on. It returns nil when synchronization should skip the block, or an
+// error wrapping ErrSyncHalted when synchronization should stop. Any other
+// error means the block is not quarantined and can be retried
+func (quarantiner *BlockQuarantiner) Quarantine(
+	height uint64,
+	stage string,
+	blockErr error,
+	payload interface{},
+) error {
+	logger := quarantiner.logger.WithFields(usecase.LogFields{
+		"blockHeight": height,
+		"stage":       stage,
+	})
+
+	if err := quarantiner.Record(height, stage, blockErr, payload); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	quarantiner.metrics.AddCounter(usecase.METRIC_SYNC_QUARANTINED_BLOCKS_TOTAL, 1, usecase.MetricLabels{
+		"stage": stage,
+	})
+
+	quarantineErr := fmt.Errorf("error processing block %d at %s stage: %v", height, stage, blockErr)
+	// Failing to record the error does not affect synchronization
+	if err := quarantiner.syncStateRepo.RecordError(quarantineErr.Error(), time.Now()); err != nil {
+		logger.Errorf("error recording quarantined block error into sync state: %v", err)
+	}
+
+	if quarantiner.policy == BLOCK_ERROR_POLICY_CONTINUE {
+		logger.Errorf("quarantined block and skipping it: %v", blockErr)
+		return nil
+	}
+
+	logger.Errorf("quarantined block and halting synchronization: %v", blockErr)
+	return fmt.Errorf("%v: %w", quarantineErr, ErrSyncHalted)
+}
+
+// Halt stops synchronization regardless of the policy without putting the
+// block into quarantine. It is used when the block belongs to a different
+// chain from the indexed one, which no retry can fix. The error is recorded
+// into the sync state for diagnosis
+func (quarantiner *BlockQuarantiner) Halt(height uint64, stage string, blockErr error) error {
+	logger := quarantiner.logger.WithFields(usecase.LogFields{
+		"blockHeight": height,
+		"stage":       stage,
+	})
+
+	haltErr := fmt.Errorf("error processing block %d at %s stage: %v", height, stage, blockErr)
+	if err := quarantiner.syncStateRepo.RecordError(haltErr.Error(), time.Now()); err != nil {
+		logger.Errorf("error recording halting block error into sync state: %v", err)
+	}
+
+	logger.Errorf("halting synchronization: block does not belong to the indexed chain: %v", blockErr)
+	return fmt.Errorf("%v: %w", haltErr, ErrSyncHalted)
+}
+
+// Record puts the block into quarantine regardless of the policy. Recording
+// an already quarantined block counts as another failed attempt
+func (quarantiner *BlockQuarantiner) Record(
+	height uint64,
+	stage string,
+	blockErr error,
+	payload interface{},
+) error {
+	var err error
+
+	payloadJSON, err := jsoniter.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error encoding payload of quarantined block %d: %v", height, err)
+	}
+
+	if err = quarantiner.repo.Quarantine(&usecase.QuarantinedBlock{
+		Height:  height,
+		Stage:   stage,
+		Error:   blockErr.Error(),
+		Payload: payloadJSON,
+
+		QuarantinedAt: time.Now(),
+	}); err != nil {
+		return fmt.Errorf("error quarantining block %d: %v", height, err)
+	}
+
+	return nil
+}
+
+// Release takes the block out of quarantine
+func (quarantiner *BlockQuarantiner) Release(height uint64) error {
+	return quarantiner.repo.Release(height)
+}
@@ -0,0 +1,129 @@
+package syncservice_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/adapter/syncservice"
+	"github.com/crypto-com/chainindex/usecase"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+	. "github.com/crypto-com/chainindex/usecase/test/mock"
+)
+
+var _ = Describe("BlockQuarantiner", func() {
+	Describe("Quarantine", func() {
+		var mockQuarantinedBlockRepo *MockQuarantinedBlockRepo
+		var mockSyncStateRepo *MockSyncStateRepo
+		BeforeEach(func() {
+			mockQuarantinedBlockRepo = new(MockQuarantinedBlockRepo)
+			mockSyncStateRepo = new(MockSyncStateRepo)
+		})
+
+		It("should record the block with its error and JSON encoded payload", func() {
+			mockQuarantinedBlockRepo.On("Quarantine", mock.Anything).Return(nil)
+			mockSyncStateRepo.On("RecordError", mock.Anything, mock.Anything).Return(nil)
+			quarantiner := syncservice.NewBlockQuarantiner(
+				new(FakeLogger), new(FakeMetrics), mockQuarantinedBlockRepo, mockSyncStateRepo, syncservice.BLOCK_ERROR_POLICY_CONTINUE,
+			)
+
+			err := quarantiner.Quarantine(
+				uint64(10), usecase.QUARANTINE_STAGE_PARSE, errors.New("malformed block"), struct {
+					Height string `json:"height"`
+				}{"10"},
+			)
+
+			Expect(err).To(BeNil())
+			mockQuarantinedBlockRepo.AssertCalled(GinkgoT(), "Quarantine", mock.MatchedBy(
+				func(block *usecase.QuarantinedBlock) bool {
+					return block.Height == uint64(10) &&
+						block.Stage == usecase.QUARANTINE_STAGE_PARSE &&
+						block.Error == "malformed block" &&
+						string(block.Payload) == `{"height":"10"}`
+				},
+			))
+		})
+
+		It("should return sync halted error when policy is halt", func() {
+			mockQuarantinedBlockRepo.On("Quarantine", mock.Anything).Return(nil)
+			mockSyncStateRepo.On("RecordError", mock.Anything, mock.Anything).Return(nil)
+			quarantiner := syncservice.NewBlockQuarantiner(
+				new(FakeLogger), new(FakeMetrics), mockQuarantinedBlockRepo, mockSyncStateRepo, syncservice.BLOCK_ERROR_POLICY_HALT,
+			)
+
+			err := quarantiner.Quarantine(uint64(10), usecase.QUARANTINE_STAGE_STORE, errors.New("malformed block"), nil)
+
+			Expect(errors.Is(err, syncservice.ErrSyncHalted)).To(BeTrue())
+		})
+
+		It("should halt by default", func() {
+			mockQuarantinedBlockRepo.On("Quarantine", mock.Anything).Return(nil)
+			mockSyncStateRepo.On("RecordError", mock.Anything, mock.Anything).Return(nil)
+			quarantiner := syncservice.NewBlockQuarantiner(new(FakeLogger), new(FakeMetrics), mockQuarantinedBlockRepo, mockSyncStateRepo, "")
+
+			err := quarantiner.Quarantine(uint64(10), usecase.QUARANTINE_STAGE_STORE, errors.New("malformed block"), nil)
+
+			Expect(errors.Is(err, syncservice.ErrSyncHalted)).To(BeTrue())
+		})
+
+		It("should record the error into sync state", func() {
+			mockQuarantinedBlockRepo.On("Quarantine", mock.Anything).Return(nil)
+			mockSyncStateRepo.On("RecordError", mock.Anything, mock.Anything).Return(nil)
+			quarantiner := syncservice.NewBlockQuarantiner(
+				new(FakeLogger), new(FakeMetrics), mockQuarantinedBlockRepo, mockSyncStateRepo, syncservice.BLOCK_ERROR_POLICY_CONTINUE,
+			)
+
+			err := quarantiner.Quarantine(uint64(10), usecase.QUARANTINE_STAGE_STORE, errors.New("malformed block"), nil)
+
+			Expect(err).To(BeNil())
+			mockSyncStateRepo.AssertCalled(
+				GinkgoT(), "RecordError", "error processing block 10 at store stage: malformed block", mock.Anything,
+			)
+		})
+
+		It("should continue to apply the policy when the error cannot be recorded into sync state", func() {
+			mockQuarantinedBlockRepo.On("Quarantine", mock.Anything).Return(nil)
+			mockSyncStateRepo.On("RecordError", mock.Anything, mock.Anything).Return(errors.New("connection lost"))
+			quarantiner := syncservice.NewBlockQuarantiner(
+				new(FakeLogger), new(FakeMetrics), mockQuarantinedBlockRepo, mockSyncStateRepo, syncservice.BLOCK_ERROR_POLICY_HALT,
+			)
+
+			err := quarantiner.Quarantine(uint64(10), usecase.QUARANTINE_STAGE_STORE, errors.New("malformed block"), nil)
+
+			Expect(errors.Is(err, syncservice.ErrSyncHalted)).To(BeTrue())
+		})
+
+		It("should return error other than sync halted when the block cannot be recorded", func() {
+			mockQuarantinedBlockRepo.On("Quarantine", mock.Anything).Return(errors.New("connection lost"))
+			quarantiner := syncservice.NewBlockQuarantiner(
+				new(FakeLogger), new(FakeMetrics), mockQuarantinedBlockRepo, mockSyncStateRepo, syncservice.BLOCK_ERROR_POLICY_CONTINUE,
+			)
+
+			err := quarantiner.Quarantine(uint64(10), usecase.QUARANTINE_STAGE_STORE, errors.New("malformed block"), nil)
+
+			Expect(err).To(MatchError(ContainSubstring("connection lost")))
+			Expect(errors.Is(err, syncservice.ErrSyncHalted)).To(BeFalse())
+		})
+	})
+
+	Describe("Halt", func() {
+		It("should halt and record the error into sync state without quarantining the block", func() {
+			mockQuarantinedBlockRepo := new(MockQuarantinedBlockRepo)
+			mockSyncStateRepo := new(MockSyncStateRepo)
+			mockSyncStateRepo.On("RecordError", mock.Anything, mock.Anything).Return(nil)
+			quarantiner := syncservice.NewBlockQuarantiner(
+				new(FakeLogger), new(FakeMetrics), mockQuarantinedBlockRepo, mockSyncStateRepo, syncservice.BLOCK_ERROR_POLICY_CONTINUE,
+			)
+
+			err := quarantiner.Halt(uint64(10), usecase.QUARANTINE_STAGE_STORE, errors.New("parent hash mismatch"))
+
+			Expect(errors.Is(err, syncservice.ErrSyncHalted)).To(BeTrue())
+			mockSyncStateRepo.AssertCalled(
+				GinkgoT(), "RecordError", "error processing block 10 at store stage: parent hash mismatch", mock.Anything,
+			)
+			mockQuarantinedBlockRepo.AssertNotCalled(GinkgoT(), "Quarantine", mock.Anything)
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package syncservice
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/crypto-com/chainindex/adapter/tendermint"
@@ -9,13 +11,12 @@ import (
 
 // Subscribe to a blockheight news feed
 type BlocksFeedSubscriber interface {
-	Run(BlocksFeedSubscriberParams)
+	// Run until the context is done or an error occurs
+	Run(context.Context, BlocksFeedSubscriberParams) error
 }
 
 type BlocksFeedSubscriberParams struct {
 	TendermintHeight RWSerialUint64
-
-	OnExitCh chan<- bool
 }
 
 type DefaultBlocksFeedSubscriber struct {
@@ -35,21 +36,23 @@ func NewDefaultBlocksFeedSubscriber(
 	}
 }
 
-func (subscriber *DefaultBlocksFeedSubscriber) Run(params BlocksFeedSubscriberParams) {
+func (subscriber *DefaultBlocksFeedSubscriber) Run(ctx context.Context, params BlocksFeedSubscriberParams) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			subscriber.logger.Panicf("panic when running: %v", r)
+			err = fmt.Errorf("panic when running: %v", r)
+			subscriber.logger.Error(err.Error())
 		}
 		subscriber.logger.Info("shutting down")
-		params.OnExitCh <- true
 	}()
 
 	blocksFeedCh := make(chan uint64)
-	if err := subscriber.blocksFeed.Subscribe(blocksFeedCh); err != nil {
-		subscriber.logger.Panicf("error subscribing to blocks feed: %v", err)
+	if err = subscriber.blocksFeed.Subscribe(ctx, blocksFeedCh); err != nil {
+		return fmt.Errorf("error subscribing to blocks feed: %v", err)
 	}
 	for {
 		select {
+		case <-ctx.Done():
+			return nil
 		case latestBlockHeight := <-blocksFeedCh:
 			subscriber.logger.WithFields(usecase.LogFields{
 				"latestBlockHeight": latestBlockHeight,
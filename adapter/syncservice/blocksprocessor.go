@@ -1,31 +1,34 @@
 package syncservice
 
 import (
+	"context"
+	"fmt"
 	"time"
 
-	"github.com/crypto-com/chainindex/adapter"
 	"github.com/crypto-com/chainindex/adapter/tendermint"
-	tenderminttypes "github.com/crypto-com/chainindex/adapter/tendermint/types"
 	"github.com/crypto-com/chainindex/usecase"
 )
 
 type BlocksProcessor interface {
-	Run(BlocksProcessorParams)
+	// Run until the context is done or an error occurs
+	Run(context.Context, BlocksProcessorParams) error
 }
 
 type BlocksProcessorParams struct {
 	LastSyncHeight   uint64
 	TendermintHeight RWSerialUint64
+	// Blocks after the stop height are not processed. It is unbounded when it
+	// is 0
+	StopHeight uint64
 
 	OnTendermintHeightUpdate <-chan bool
 
 	BlockDataCh chan<- *usecase.BlockData
-	OnExitCh    chan<- bool
 }
 
 type PeriodicBlocksProcessor struct {
-	logger usecase.Logger
-	client tendermint.Client
+	logger  usecase.Logger
+	fetcher *BlockDataFetcher
 
 	nextHeightAwaiting uint64
 }
@@ -40,34 +43,40 @@ func NewPeriodicBlocksWorker(
 			"module": "PeriodicBlocksWorker",
 		}),
 
-		client: client,
+		fetcher: NewBlockDataFetcher(client),
 	}
 }
 
-func (worker *PeriodicBlocksProcessor) Run(params BlocksProcessorParams) {
+func (worker *PeriodicBlocksProcessor) Run(ctx context.Context, params BlocksProcessorParams) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			worker.logger.Panicf("panic when running: %v", r)
+			err = fmt.Errorf("panic when running: %v", r)
+			worker.logger.Error(err.Error())
 		}
 		worker.logger.Info("shutting down")
-		params.OnExitCh <- true
 	}()
 
 	worker.nextHeightAwaiting = params.LastSyncHeight + 1
 
 	for {
-		_ = worker.ProcessNewBlocks(&params)
+		_ = worker.ProcessNewBlocks(ctx, &params)
 
-		<-time.After(5 * time.Second)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(5 * time.Second):
+		}
 	}
-
 }
 
-func (worker *PeriodicBlocksProcessor) ProcessNewBlocks(params *BlocksProcessorParams) error {
+func (worker *PeriodicBlocksProcessor) ProcessNewBlocks(ctx context.Context, params *BlocksProcessorParams) error {
 	var err error
 
 	for {
 		latestBlockHeight := params.TendermintHeight.Get()
+		if params.StopHeight != 0 && latestBlockHeight > params.StopHeight {
+			latestBlockHeight = params.StopHeight
+		}
 
 		worker.logger.WithFields(usecase.LogFields{
 			"nextHeightAwaiting": worker.nextHeightAwaiting,
@@ -84,11 +93,7 @@ func (worker *PeriodicBlocksProcessor) ProcessNewBlocks(params *BlocksProcessorP
 			logger.Info("processing block")
 
 			var blockData *usecase.BlockData
-			if worker.nextHeightAwaiting == uint64(1) {
-				blockData, err = worker.handleGenesisBlock()
-			} else {
-				blockData, err = worker.handleBlock(worker.nextHeightAwaiting)
-			}
+			blockData, err = worker.fetcher.Fetch(worker.nextHeightAwaiting)
 			if err != nil {
 				logger.Errorf("error processing block: %v", err)
 				return err
@@ -98,7 +103,11 @@ func (worker *PeriodicBlocksProcessor) ProcessNewBlocks(params *BlocksProcessorP
 				"blockData": blockData,
 			}).Debug("processed block data")
 
-			params.BlockDataCh <- blockData
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case params.BlockDataCh <- blockData:
+			}
 
 			worker.nextHeightAwaiting += 1
 		}
@@ -106,48 +115,3 @@ func (worker *PeriodicBlocksProcessor) ProcessNewBlocks(params *BlocksProcessorP
 
 	return nil
 }
-
-func (worker *PeriodicBlocksProcessor) handleGenesisBlock() (*usecase.BlockData, error) {
-	var err error
-
-	var genesis *tenderminttypes.Genesis
-	genesis, err = worker.client.Genesis()
-	if err != nil {
-		return nil, err
-	}
-
-	var block *tenderminttypes.Block
-	block, err = worker.client.Block(uint64(1))
-	if err != nil {
-		return nil, err
-	}
-
-	blockData := adapter.ParseGenesisToBlockData(adapter.TendermintGenesisBlockData{
-		Genesis: genesis,
-		Block:   block,
-	})
-
-	return blockData, nil
-}
-
-func (worker *PeriodicBlocksProcessor) handleBlock(height uint64) (*usecase.BlockData, error) {
-	var err error
-
-	var block *tenderminttypes.Block
-	block, err = worker.client.Block(height)
-	if err != nil {
-		return nil, err
-	}
-	var blockResults *tenderminttypes.BlockResults
-	blockResults, err = worker.client.BlockResults(height)
-	if err != nil {
-		return nil, err
-	}
-
-	blockData := adapter.ParseBlockToBlockData(adapter.TendermintBlockData{
-		Block:        block,
-		BlockResults: blockResults,
-	})
-
-	return blockData, nil
-}
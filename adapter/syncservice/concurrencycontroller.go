@@ -0,0 +1,148 @@
+package syncservice
+
+import (
+	"sync"
+	"time"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+const (
+	DEFAULT_CONCURRENCY_TARGET_LATENCY = 2 * time.Second
+	DEFAULT_CONCURRENCY_MAX_ERROR_RATE = 0.1
+	DEFAULT_CONCURRENCY_SAMPLE_SIZE    = 20
+	DEFAULT_CONCURRENCY_MIN_WORKER     = 1
+)
+
+// Limit is multiplied by the factor when a sample is unhealthy
+const CONCURRENCY_DECREASE_FACTOR = 0.5
+
+type ConcurrencyControllerOptions struct {
+	// Concurrency is fixed at MaxWorker when it is false
+	Adaptive  bool
+	MinWorker int
+	MaxWorker int
+	// Concurrency is narrowed when the average block fetch latency of a
+	// sample exceeds the target latency
+	TargetLatency time.Duration
+	// Concurrency is narrowed when the ratio of failed block fetches of a
+	// sample exceeds the maximum error rate
+	MaxErrorRate float64
+	// Number of block fetch outcomes observed before each adjustment
+	SampleSize int
+}
+
+// ConcurrencyController decides the number of workers fetching blocks at the
+// same time. In adaptive mode it widens the limit by one worker after a
+// healthy sample, and halves it after a sample with high latency or error
+// rate, so that a struggling node is given room to recover
+type ConcurrencyController struct {
+	sync.Mutex
+
+	logger  usecase.Logger
+	metrics usecase.Metrics
+	options ConcurrencyControllerOptions
+
+	limit int
+
+	sampleCount    int
+	sampleErrors   int
+	sampleDuration time.Duration
+}
+
+func NewConcurrencyController(
+	logger usecase.Logger,
+	metrics usecase.Metrics,
+	options ConcurrencyControllerOptions,
+) *ConcurrencyController {
+	if options.MaxWorker < 1 {
+		options.MaxWorker = 1
+	}
+	if options.MinWorker < 1 {
+		options.MinWorker = DEFAULT_CONCURRENCY_MIN_WORKER
+	}
+	if options.MinWorker > options.MaxWorker {
+		options.MinWorker = options.MaxWorker
+	}
+	if options.TargetLatency == 0 {
+		options.TargetLatency = DEFAULT_CONCURRENCY_TARGET_LATENCY
+	}
+	if options.MaxErrorRate == 0 {
+		options.MaxErrorRate = DEFAULT_CONCURRENCY_MAX_ERROR_RATE
+	}
+	if options.SampleSize < 1 {
+		options.SampleSize = DEFAULT_CONCURRENCY_SAMPLE_SIZE
+	}
+
+	// Adaptive mode starts from the minimum and widens as the node keeps up
+	limit := options.MaxWorker
+	if options.Adaptive {
+		limit = options.MinWorker
+	}
+
+	controller := &ConcurrencyController{
+		logger: logger.WithFields(usecase.LogFields{
+			"module": "ConcurrencyController",
+		}),
+		metrics: metrics,
+		options: options,
+
+		limit: limit,
+	}
+	controller.metrics.SetGauge(usecase.METRIC_SYNC_BLOCK_WORKERS_LIMIT, float64(limit), nil)
+
+	return controller
+}
+
+// Limit returns the current maximum number of concurrent workers
+func (controller *ConcurrencyController) Limit() int {
+	controller.Lock()
+	defer controller.Unlock()
+
+	return controller.limit
+}
+
+// Observe records the outcome of a block fetch and adjusts the limit when a
+// sample is complete
+func (controller *ConcurrencyController) Observe(duration time.Duration, err error) {
+	if !controller.options.Adaptive {
+		return
+	}
+
+	controller.Lock()
+	defer controller.Unlock()
+
+	controller.sampleCount += 1
+	controller.sampleDuration += duration
+	if err != nil {
+		controller.sampleErrors += 1
+	}
+	if controller.sampleCount < controller.options.SampleSize {
+		return
+	}
+
+	errorRate := float64(controller.sampleErrors) / float64(controller.sampleCount)
+	averageLatency := controller.sampleDuration / time.Duration(controller.sampleCount)
+	controller.sampleCount = 0
+	controller.sampleErrors = 0
+	controller.sampleDuration = 0
+
+	prevLimit := controller.limit
+	if errorRate > controller.options.MaxErrorRate || averageLatency > controller.options.TargetLatency {
+		controller.limit = int(float64(controller.limit) * CONCURRENCY_DECREASE_FACTOR)
+		if controller.limit < controller.options.MinWorker {
+			controller.limit = controller.options.MinWorker
+		}
+	} else if controller.limit < controller.options.MaxWorker {
+		controller.limit += 1
+	}
+	if controller.limit == prevLimit {
+		return
+	}
+
+	controller.logger.WithFields(usecase.LogFields{
+		"errorRate":      errorRate,
+		"averageLatency": averageLatency.String(),
+	}).Infof("adjusted block worker limit from %d to %d", prevLimit, controller.limit)
+	controller.metrics.SetGauge(usecase.METRIC_SYNC_BLOCK_WORKERS_LIMIT, float64(controller.limit), nil)
+}
@@ -0,0 +1,98 @@
+package syncservice_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chainindex/adapter/syncservice"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+)
+
+var _ = Describe("ConcurrencyController", func() {
+	observeSample := func(
+		controller *syncservice.ConcurrencyController, size int, latency time.Duration, errorCount int,
+	) {
+		for i := 0; i < size; i += 1 {
+			var err error
+			if i < errorCount {
+				err = errors.New("connection refused")
+			}
+			controller.Observe(latency, err)
+		}
+	}
+
+	It("should keep the limit at the maximum when it is not adaptive", func() {
+		controller := syncservice.NewConcurrencyController(
+			new(FakeLogger), new(FakeMetrics), syncservice.ConcurrencyControllerOptions{
+				MaxWorker:  10,
+				SampleSize: 5,
+			},
+		)
+
+		observeSample(controller, 5, 10*time.Second, 5)
+
+		Expect(controller.Limit()).To(Equal(10))
+	})
+
+	It("should start from the minimum and widen the limit by one after each healthy sample", func() {
+		controller := syncservice.NewConcurrencyController(
+			new(FakeLogger), new(FakeMetrics), syncservice.ConcurrencyControllerOptions{
+				Adaptive:      true,
+				MinWorker:     2,
+				MaxWorker:     4,
+				TargetLatency: 1 * time.Second,
+				SampleSize:    5,
+			},
+		)
+		Expect(controller.Limit()).To(Equal(2))
+
+		observeSample(controller, 4, 100*time.Millisecond, 0)
+		Expect(controller.Limit()).To(Equal(2))
+
+		observeSample(controller, 1, 100*time.Millisecond, 0)
+		Expect(controller.Limit()).To(Equal(3))
+
+		observeSample(controller, 10, 100*time.Millisecond, 0)
+		Expect(controller.Limit()).To(Equal(4))
+	})
+
+	It("should halve the limit after a sample with latency exceeding the target", func() {
+		controller := syncservice.NewConcurrencyController(
+			new(FakeLogger), new(FakeMetrics), syncservice.ConcurrencyControllerOptions{
+				Adaptive:      true,
+				MinWorker:     1,
+				MaxWorker:     8,
+				TargetLatency: 1 * time.Second,
+				SampleSize:    1,
+			},
+		)
+		observeSample(controller, 7, 100*time.Millisecond, 0)
+		Expect(controller.Limit()).To(Equal(8))
+
+		observeSample(controller, 1, 3*time.Second, 0)
+		Expect(controller.Limit()).To(Equal(4))
+	})
+
+	It("should halve the limit down to the minimum after samples with error rate exceeding the maximum", func() {
+		controller := syncservice.NewConcurrencyController(
+			new(FakeLogger), new(FakeMetrics), syncservice.ConcurrencyControllerOptions{
+				Adaptive:     true,
+				MinWorker:    3,
+				MaxWorker:    8,
+				MaxErrorRate: 0.2,
+				SampleSize:   1,
+			},
+		)
+		observeSample(controller, 5, 100*time.Millisecond, 0)
+		Expect(controller.Limit()).To(Equal(8))
+
+		observeSample(controller, 1, 100*time.Millisecond, 1)
+		Expect(controller.Limit()).To(Equal(4))
+
+		observeSample(controller, 1, 100*time.Millisecond, 1)
+		Expect(controller.Limit()).To(Equal(3))
+	})
+})
@@ -1,6 +1,9 @@
 package syncservice
 
 import (
+	"context"
+	"sync"
+
 	"github.com/crypto-com/chainindex/usecase"
 )
 
@@ -15,8 +18,6 @@ type DefaultSyncService struct {
 	syncHeight                 *DefaultRWSerialUint64
 	tendermintBlockHeight      *ChRWSerialUint64
 	onTendermintHeightUpdateCh chan bool
-
-	onExitCh chan<- bool
 }
 
 func NewDefaultSyncService(
@@ -28,8 +29,6 @@ func NewDefaultSyncService(
 	blockDataRepoWorker BlockDataRepoWorker,
 
 	lastSyncHeight uint64,
-
-	onExitCh chan<- bool,
 ) *DefaultSyncService {
 	onTendermintHeightUpdateCh := make(chan bool, 1)
 	return &DefaultSyncService{
@@ -45,50 +44,112 @@ func NewDefaultSyncService(
 		syncHeight:                 NewDefaultRWSerialUint64(lastSyncHeight),
 		tendermintBlockHeight:      NewChRWSerialUint64(onTendermintHeightUpdateCh, lastSyncHeight),
 		onTendermintHeightUpdateCh: onTendermintHeightUpdateCh,
-
-		onExitCh: onExitCh,
 	}
 }
 
 type DefaultSyncServiceConfig struct {
 	BlockDataChSize uint
+	// Synchronization completes once the block at the stop height is stored.
+	// It is unbounded when it is 0
+	StopHeight uint64
 }
 
-func (syncService *DefaultSyncService) Sync() error {
+// Sync runs the synchronization pipeline until the context is done or any of
+// the pipeline workers fails. When a worker fails the rest of the pipeline is
+// stopped and the error is returned after all workers have returned. With a
+// stop height it returns nil once the block at the stop height is stored
+func (syncService *DefaultSyncService) Sync(ctx context.Context) error {
+	if syncService.isStopHeightReached() {
+		syncService.logger.Infof(
+			"last synchronized height %d already reached stop height %d",
+			syncService.syncHeight.Get(), syncService.config.StopHeight,
+		)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	blockDataCh := make(chan *usecase.BlockData, syncService.config.BlockDataChSize)
 	onBlockStoredCh := make(chan uint64, syncService.config.BlockDataChSize)
 
-	go syncService.blocksFeedSubscriber.Run(BlocksFeedSubscriberParams{
-		TendermintHeight: syncService.tendermintBlockHeight,
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var syncErr error
+	runWorker := func(name string, run func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := run(); err != nil {
+				errOnce.Do(func() {
+					syncService.logger.Errorf("%s stopped with error: %v", name, err)
+					syncErr = err
+				})
+			}
+			// Any worker returning stops the whole pipeline
+			cancel()
+		}()
+	}
 
-		OnExitCh: syncService.onExitCh,
+	runWorker("BlocksFeedSubscriber", func() error {
+		return syncService.blocksFeedSubscriber.Run(ctx, BlocksFeedSubscriberParams{
+			TendermintHeight: syncService.tendermintBlockHeight,
+		})
 	})
-	go syncService.blocksProcessor.Run(BlocksProcessorParams{
-		LastSyncHeight:   syncService.syncHeight.Get(),
-		TendermintHeight: syncService.tendermintBlockHeight,
+	runWorker("BlocksProcessor", func() error {
+		return syncService.blocksProcessor.Run(ctx, BlocksProcessorParams{
+			LastSyncHeight:   syncService.syncHeight.Get(),
+			TendermintHeight: syncService.tendermintBlockHeight,
+			StopHeight:       syncService.config.StopHeight,
+
+			OnTendermintHeightUpdate: syncService.onTendermintHeightUpdateCh,
 
-		OnTendermintHeightUpdate: syncService.onTendermintHeightUpdateCh,
+			BlockDataCh: blockDataCh,
+		})
+	})
+	runWorker("BlockDataRepoWorker", func() error {
+		return syncService.blockDataRepoWorker.Run(ctx, BlockDataRepoWorkerParams{
+			TendermintHeight: syncService.tendermintBlockHeight,
 
-		BlockDataCh: blockDataCh,
-		OnExitCh:    syncService.onExitCh,
+			BlockDataCh:     blockDataCh,
+			OnBlockStoredCh: onBlockStoredCh,
+		})
 	})
-	go syncService.blockDataRepoWorker.Run(BlockDataRepoWorkerParams{
-		BlockDataCh:     blockDataCh,
-		OnBlockStoredCh: onBlockStoredCh,
-		OnExitCh:        syncService.onExitCh,
+	runWorker("SyncHeightUpdateWorker", func() error {
+		syncService.syncHeightUpdateWorker(ctx, onBlockStoredCh)
+		return nil
 	})
 
-	go syncService.syncHeightUpdateWorker(onBlockStoredCh)
+	wg.Wait()
+	syncService.logger.Info("all workers stopped")
 
-	return nil
+	return syncErr
 }
 
-func (syncService *DefaultSyncService) syncHeightUpdateWorker(onBlockStoredCh <-chan uint64) {
+// syncHeightUpdateWorker returns when the context is done or the block at the
+// stop height is stored, which stops the whole pipeline
+func (syncService *DefaultSyncService) syncHeightUpdateWorker(ctx context.Context, onBlockStoredCh <-chan uint64) {
 	for {
-		latestSyncedBlockHeight := <-onBlockStoredCh
+		select {
+		case <-ctx.Done():
+			return
+		case latestSyncedBlockHeight := <-onBlockStoredCh:
+			syncService.syncHeight.SetIfLarger(latestSyncedBlockHeight)
+			if syncService.isStopHeightReached() {
+				syncService.logger.Infof("reached stop height %d", syncService.config.StopHeight)
+				return
+			}
+		}
+	}
+}
 
-		syncService.syncHeight.SetIfLarger(latestSyncedBlockHeight)
+func (syncService *DefaultSyncService) isStopHeightReached() bool {
+	if syncService.config.StopHeight == 0 {
+		return false
 	}
+
+	return syncService.syncHeight.Get() >= syncService.config.StopHeight
 }
 
 func (syncService *DefaultSyncService) GetStatus() usecase.SyncStatus {
@@ -0,0 +1,127 @@
+package syncservice_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex"
+	"github.com/crypto-com/chainindex/adapter/syncservice"
+	"github.com/crypto-com/chainindex/usecase"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+	. "github.com/crypto-com/chainindex/usecase/test/mock"
+)
+
+var _ = Describe("DefaultSyncService", func() {
+	var mockBlockDataRepo *MockBlockDataRepo
+	var blocksProcessor *fakeBlocksProcessor
+	var newSyncService func(lastSyncHeight uint64, stopHeight uint64) *syncservice.DefaultSyncService
+	BeforeEach(func() {
+		mockBlockDataRepo = new(MockBlockDataRepo)
+		mockBlockDataRepo.On("Store", mock.Anything).Return(nil)
+		blocksProcessor = new(fakeBlocksProcessor)
+
+		newSyncService = func(lastSyncHeight uint64, stopHeight uint64) *syncservice.DefaultSyncService {
+			quarantiner := syncservice.NewBlockQuarantiner(
+				new(FakeLogger), new(FakeMetrics), new(MockQuarantinedBlockRepo), new(MockSyncStateRepo), syncservice.BLOCK_ERROR_POLICY_HALT,
+			)
+			return syncservice.NewDefaultSyncService(
+				new(FakeLogger),
+				syncservice.DefaultSyncServiceConfig{
+					BlockDataChSize: 1,
+					StopHeight:      stopHeight,
+				},
+
+				new(fakeBlocksFeedSubscriber),
+				blocksProcessor,
+				syncservice.NewDefaultBlockDataRepoWorker(
+					new(FakeLogger), new(FakeMetrics), mockBlockDataRepo, quarantiner, syncservice.BlockDataRepoWorkerOptions{},
+				),
+
+				lastSyncHeight,
+			)
+		}
+	})
+
+	Describe("Sync", func() {
+		It("should return nil after the block at the stop height is stored", func() {
+			syncService := newSyncService(uint64(2), uint64(5))
+
+			doneCh := make(chan error, 1)
+			go func() {
+				doneCh <- syncService.Sync(context.Background())
+			}()
+
+			Eventually(doneCh).Should(Receive(BeNil()))
+			Expect(blocksProcessor.params.StopHeight).To(Equal(uint64(5)))
+			mockBlockDataRepo.AssertNumberOfCalls(GinkgoT(), "Store", 3)
+			Expect(syncService.GetStatus().SyncBlockHeight).To(Equal(uint64(5)))
+		})
+
+		It("should return nil without running the pipeline when the stop height is already reached", func() {
+			syncService := newSyncService(uint64(5), uint64(5))
+
+			Expect(syncService.Sync(context.Background())).To(BeNil())
+			Expect(blocksProcessor.isRun).To(BeFalse())
+			mockBlockDataRepo.AssertNotCalled(GinkgoT(), "Store", mock.Anything)
+		})
+
+		It("should keep running until the context is done when there is no stop height", func() {
+			syncService := newSyncService(uint64(0), uint64(0))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			doneCh := make(chan error, 1)
+			go func() {
+				doneCh <- syncService.Sync(ctx)
+			}()
+
+			Consistently(doneCh).ShouldNot(Receive())
+			cancel()
+			Eventually(doneCh).Should(Receive(BeNil()))
+		})
+	})
+})
+
+type fakeBlocksFeedSubscriber struct{}
+
+func (subscriber *fakeBlocksFeedSubscriber) Run(
+	ctx context.Context,
+	_ syncservice.BlocksFeedSubscriberParams,
+) error {
+	<-ctx.Done()
+	return nil
+}
+
+// fakeBlocksProcessor sends block data from the height after the last
+// synchronized height up to the stop height, or a single block data when it is
+// unbounded
+type fakeBlocksProcessor struct {
+	isRun  bool
+	params syncservice.BlocksProcessorParams
+}
+
+func (processor *fakeBlocksProcessor) Run(ctx context.Context, params syncservice.BlocksProcessorParams) error {
+	processor.isRun = true
+	processor.params = params
+
+	stopHeight := params.StopHeight
+	if stopHeight == 0 {
+		stopHeight = params.LastSyncHeight + 1
+	}
+	for height := params.LastSyncHeight + 1; height <= stopHeight; height += 1 {
+		select {
+		case <-ctx.Done():
+			return nil
+		case params.BlockDataCh <- &usecase.BlockData{
+			Block: chainindex.Block{
+				Height: height,
+			},
+		}:
+		}
+	}
+
+	<-ctx.Done()
+	return nil
+}
@@ -0,0 +1,116 @@
+package syncservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crypto-com/chainindex/adapter/tendermint"
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+const DEFAULT_PENDING_TRANSACTION_POLLING_INTERVAL = 5 * time.Second
+
+// PendingTransactionParser decodes a base64 encoded transaction in the mempool
+type PendingTransactionParser func(rawTx string) (*usecase.PendingTransaction, error)
+
+type PendingTransactionWatcherOptions struct {
+	// Interval between each polling of Tendermint mempool
+	PollingInterval time.Duration
+}
+
+// PendingTransactionWatcher polls the Tendermint mempool periodically and
+// replaces the stored pending transactions with the observed ones. A
+// transaction usually stays in the mempool across several polls, so decoded
+// transactions are cached by their raw form until they leave the mempool
+type PendingTransactionWatcher struct {
+	logger  usecase.Logger
+	options PendingTransactionWatcherOptions
+
+	client tendermint.Client
+	repo   usecase.PendingTransactionRepository
+	parse  PendingTransactionParser
+
+	// nil for transactions failed to be decoded, so that they are not decoded
+	// and logged again on every poll
+	decodedTxs map[string]*usecase.PendingTransaction
+}
+
+func NewPendingTransactionWatcher(
+	logger usecase.Logger,
+	client tendermint.Client,
+	repo usecase.PendingTransactionRepository,
+	parse PendingTransactionParser,
+	options PendingTransactionWatcherOptions,
+) *PendingTransactionWatcher {
+	if options.PollingInterval == 0 {
+		options.PollingInterval = DEFAULT_PENDING_TRANSACTION_POLLING_INTERVAL
+	}
+
+	return &PendingTransactionWatcher{
+		logger: logger.WithFields(usecase.LogFields{
+			"module": "PendingTransactionWatcher",
+		}),
+		options: options,
+
+		client: client,
+		repo:   repo,
+		parse:  parse,
+
+		decodedTxs: make(map[string]*usecase.PendingTransaction),
+	}
+}
+
+// Run polls the mempool periodically until the context is done. Failed polls
+// are logged and retried on the next interval
+func (watcher *PendingTransactionWatcher) Run(ctx context.Context) {
+	for {
+		if err := watcher.Poll(); err != nil {
+			watcher.logger.Errorf("error polling pending transactions: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watcher.options.PollingInterval):
+		}
+	}
+}
+
+// Poll requests the mempool once and replaces the stored pending transactions
+// with the decodable ones
+func (watcher *PendingTransactionWatcher) Poll() error {
+	var err error
+
+	rawTxs, err := watcher.client.UnconfirmedTxs()
+	if err != nil {
+		return fmt.Errorf("error requesting unconfirmed transactions: %v", err)
+	}
+	observedAt := time.Now()
+
+	decodedTxs := make(map[string]*usecase.PendingTransaction, len(rawTxs))
+	pendingTxs := make([]usecase.PendingTransaction, 0, len(rawTxs))
+	for _, rawTx := range rawTxs {
+		pendingTx, isCached := watcher.decodedTxs[rawTx]
+		if !isCached {
+			var parseErr error
+			pendingTx, parseErr = watcher.parse(rawTx)
+			if parseErr != nil {
+				watcher.logger.Errorf("error parsing pending transaction %s: %v", rawTx, parseErr)
+				pendingTx = nil
+			}
+		}
+		decodedTxs[rawTx] = pendingTx
+
+		if pendingTx != nil {
+			pendingTxs = append(pendingTxs, *pendingTx)
+		}
+	}
+	watcher.decodedTxs = decodedTxs
+
+	if err = watcher.repo.Replace(pendingTxs, observedAt); err != nil {
+		return fmt.Errorf("error storing pending transactions: %w", err)
+	}
+
+	return nil
+}
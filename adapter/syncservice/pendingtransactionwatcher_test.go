@@ -0,0 +1,91 @@
+package syncservice_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex"
+	"github.com/crypto-com/chainindex/adapter/syncservice"
+	. "github.com/crypto-com/chainindex/adapter/tendermint/test/mock"
+	"github.com/crypto-com/chainindex/usecase"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+	. "github.com/crypto-com/chainindex/usecase/test/mock"
+)
+
+var _ = Describe("PendingTransactionWatcher", func() {
+	var mockClient *MockTendermintClient
+	var mockRepo *MockPendingTransactionRepo
+	var parsedRawTxs []string
+	var watcher *syncservice.PendingTransactionWatcher
+	BeforeEach(func() {
+		mockClient = new(MockTendermintClient)
+		mockRepo = new(MockPendingTransactionRepo)
+		parsedRawTxs = make([]string, 0)
+
+		// Raw transaction is used as the txid and "malformed" fails to be parsed
+		parse := func(rawTx string) (*usecase.PendingTransaction, error) {
+			parsedRawTxs = append(parsedRawTxs, rawTx)
+			if rawTx == "malformed" {
+				return nil, errors.New("error decoding transaction")
+			}
+			return &usecase.PendingTransaction{
+				TxID: rawTx,
+				Type: chainindex.ACTIVITY_TRANSFER,
+			}, nil
+		}
+		watcher = syncservice.NewPendingTransactionWatcher(
+			new(FakeLogger), mockClient, mockRepo, parse, syncservice.PendingTransactionWatcherOptions{},
+		)
+	})
+
+	Describe("Poll", func() {
+		It("should replace pending transactions with the transactions in the mempool", func() {
+			mockClient.On("UnconfirmedTxs").Return([]string{"tx1", "tx2"}, nil)
+			mockRepo.On("Replace", []usecase.PendingTransaction{
+				{TxID: "tx1", Type: chainindex.ACTIVITY_TRANSFER},
+				{TxID: "tx2", Type: chainindex.ACTIVITY_TRANSFER},
+			}, mock.Anything).Return(nil)
+
+			Expect(watcher.Poll()).To(Succeed())
+			mockRepo.AssertExpectations(GinkgoT())
+		})
+
+		It("should only parse transactions not seen in the previous poll", func() {
+			mockClient.On("UnconfirmedTxs").Return([]string{"tx1", "tx2"}, nil).Once()
+			mockClient.On("UnconfirmedTxs").Return([]string{"tx2", "tx3"}, nil).Once()
+			mockClient.On("UnconfirmedTxs").Return([]string{"tx1"}, nil).Once()
+			mockRepo.On("Replace", mock.Anything, mock.Anything).Return(nil)
+
+			Expect(watcher.Poll()).To(Succeed())
+			Expect(watcher.Poll()).To(Succeed())
+			Expect(watcher.Poll()).To(Succeed())
+			Expect(parsedRawTxs).To(Equal([]string{"tx1", "tx2", "tx3", "tx1"}))
+			mockRepo.AssertCalled(GinkgoT(), "Replace", []usecase.PendingTransaction{
+				{TxID: "tx2", Type: chainindex.ACTIVITY_TRANSFER},
+				{TxID: "tx3", Type: chainindex.ACTIVITY_TRANSFER},
+			}, mock.Anything)
+		})
+
+		It("should skip transactions failed to be parsed without parsing them again", func() {
+			mockClient.On("UnconfirmedTxs").Return([]string{"malformed", "tx1"}, nil)
+			mockRepo.On("Replace", []usecase.PendingTransaction{
+				{TxID: "tx1", Type: chainindex.ACTIVITY_TRANSFER},
+			}, mock.Anything).Return(nil)
+
+			Expect(watcher.Poll()).To(Succeed())
+			Expect(watcher.Poll()).To(Succeed())
+			Expect(parsedRawTxs).To(Equal([]string{"malformed", "tx1"}))
+			mockRepo.AssertNumberOfCalls(GinkgoT(), "Replace", 2)
+		})
+
+		It("should return error and keep stored pending transactions when requesting mempool fails", func() {
+			mockClient.On("UnconfirmedTxs").Return([]string(nil), errors.New("connection refused"))
+
+			Expect(watcher.Poll()).NotTo(Succeed())
+			mockRepo.AssertNotCalled(GinkgoT(), "Replace", mock.Anything, mock.Anything)
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package syncservice
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/adapter/tendermint"
+	"github.com/crypto-com/chainindex/usecase"
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+)
+
+// DefaultQuarantinedBlockRetrier fetches, parses and stores a quarantined
+// block again. The block is released when it is stored successfully, or
+// stays in quarantine with the latest error otherwise
+type DefaultQuarantinedBlockRetrier struct {
+	logger        usecase.Logger
+	fetcher       *BlockDataFetcher
+	blockDataRepo usecase.BlockDataRepository
+	blockViewRepo viewrepo.BlockViewRepo
+	quarantiner   *BlockQuarantiner
+}
+
+func NewDefaultQuarantinedBlockRetrier(
+	logger usecase.Logger,
+	client tendermint.Client,
+	blockDataRepo usecase.BlockDataRepository,
+	blockViewRepo viewrepo.BlockViewRepo,
+	quarantiner *BlockQuarantiner,
+) *DefaultQuarantinedBlockRetrier {
+	return &DefaultQuarantinedBlockRetrier{
+		logger: logger.WithFields(usecase.LogFields{
+			"module": "DefaultQuarantinedBlockRetrier",
+		}),
+		fetcher:       NewBlockDataFetcher(client),
+		blockDataRepo: blockDataRepo,
+		blockViewRepo: blockViewRepo,
+		quarantiner:   quarantiner,
+	}
+}
+
+// Retry returns an error wrapping one of the block data errors when the block
+// still fails deterministically. A block quarantined under the continue policy
+// cannot be retried once later blocks are stored, as its staking, council node
+// and transaction output changes would be applied out of order. Such index has
+// to be reindexed with all blocks instead
+func (retrier *DefaultQuarantinedBlockRetrier) Retry(height uint64) error {
+	var err error
+
+	logger := retrier.logger.WithFields(usecase.LogFields{
+		"blockHeight": height,
+	})
+	logger.Info("retrying quarantined block")
+
+	lastStoredHeight, err := retrier.blockViewRepo.LatestBlockHeight()
+	if err != nil {
+		return fmt.Errorf("error getting last stored height: %v", err)
+	}
+	if height != lastStoredHeight+1 {
+		return fmt.Errorf(
+			"error retrying quarantined block %d: last stored height is %d: %w",
+			height, lastStoredHeight, usecase.ErrQuarantinedBlockOutOfOrder,
+		)
+	}
+
+	blockData, err := retrier.fetcher.Fetch(height)
+	if err != nil {
+		var parseErr *BlockParseError
+		if errors.As(err, &parseErr) {
+			retrier.recordFailure(logger, height, usecase.QUARANTINE_STAGE_PARSE, parseErr, parseErr.Payload)
+		}
+		return fmt.Errorf("error fetching quarantined block: %w", err)
+	}
+
+	if err = retrier.blockDataRepo.Store(blockData); err != nil {
+		if adapter.IsBlockDataError(err) {
+			retrier.recordFailure(logger, height, usecase.QUARANTINE_STAGE_STORE, err, blockData)
+		}
+		return fmt.Errorf("error storing quarantined block: %w", err)
+	}
+
+	if err = retrier.quarantiner.Release(height); err != nil {
+		return fmt.Errorf("error releasing stored quarantined block: %w", err)
+	}
+	logger.Info("released quarantined block")
+
+	return nil
+}
+
+func (retrier *DefaultQuarantinedBlockRetrier) recordFailure(
+	logger usecase.Logger,
+	height uint64,
+	stage string,
+	blockErr error,
+	payload interface{},
+) {
+	logger.Errorf("quarantined block failed again at %s stage: %v", stage, blockErr)
+	if err := retrier.quarantiner.Record(height, stage, blockErr, payload); err != nil {
+		logger.Error(err.Error())
+	}
+}
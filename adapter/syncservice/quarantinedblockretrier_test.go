@@ -0,0 +1,102 @@
+package syncservice_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/adapter/syncservice"
+	. "github.com/crypto-com/chainindex/adapter/tendermint/test/mock"
+	"github.com/crypto-com/chainindex/adapter/tendermint/types"
+	"github.com/crypto-com/chainindex/usecase"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+	. "github.com/crypto-com/chainindex/usecase/test/mock"
+	. "github.com/crypto-com/chainindex/usecase/viewrepo/test/mock"
+)
+
+var _ = Describe("DefaultQuarantinedBlockRetrier", func() {
+	Describe("Retry", func() {
+		var mockClient *MockTendermintClient
+		var mockBlockDataRepo *MockBlockDataRepo
+		var mockBlockViewRepo *MockBlockViewRepo
+		var mockQuarantinedBlockRepo *MockQuarantinedBlockRepo
+		var retrier *syncservice.DefaultQuarantinedBlockRetrier
+		BeforeEach(func() {
+			mockClient = new(MockTendermintClient)
+			mockClient.On("Block", uint64(10)).Return(&types.Block{Height: uint64(10)}, nil)
+			mockClient.On("BlockResults", uint64(10)).Return(&types.BlockResults{Height: uint64(10)}, nil)
+
+			mockBlockDataRepo = new(MockBlockDataRepo)
+			mockBlockViewRepo = new(MockBlockViewRepo)
+			mockQuarantinedBlockRepo = new(MockQuarantinedBlockRepo)
+			mockSyncStateRepo := new(MockSyncStateRepo)
+			mockSyncStateRepo.On("RecordError", mock.Anything, mock.Anything).Return(nil)
+
+			retrier = syncservice.NewDefaultQuarantinedBlockRetrier(
+				new(FakeLogger),
+				mockClient,
+				mockBlockDataRepo,
+				mockBlockViewRepo,
+				syncservice.NewBlockQuarantiner(
+					new(FakeLogger), new(FakeMetrics), mockQuarantinedBlockRepo, mockSyncStateRepo,
+					syncservice.BLOCK_ERROR_POLICY_CONTINUE,
+				),
+			)
+		})
+
+		It("should store and release the block right after the last stored block", func() {
+			mockBlockViewRepo.On("LatestBlockHeight").Return(uint64(9), nil)
+			mockBlockDataRepo.On("Store", mock.Anything).Return(nil)
+			mockQuarantinedBlockRepo.On("Release", uint64(10)).Return(nil)
+
+			err := retrier.Retry(uint64(10))
+
+			Expect(err).To(BeNil())
+			mockBlockDataRepo.AssertCalled(GinkgoT(), "Store", mock.MatchedBy(func(blockData *usecase.BlockData) bool {
+				return blockData.Block.Height == uint64(10)
+			}))
+			mockQuarantinedBlockRepo.AssertCalled(GinkgoT(), "Release", uint64(10))
+		})
+
+		It("should return ErrQuarantinedBlockOutOfOrder when later blocks are already stored", func() {
+			mockBlockViewRepo.On("LatestBlockHeight").Return(uint64(12), nil)
+
+			err := retrier.Retry(uint64(10))
+
+			Expect(errors.Is(err, usecase.ErrQuarantinedBlockOutOfOrder)).To(BeTrue())
+			mockBlockDataRepo.AssertNotCalled(GinkgoT(), "Store", mock.Anything)
+			mockQuarantinedBlockRepo.AssertNotCalled(GinkgoT(), "Release", mock.Anything)
+		})
+
+		It("should return ErrQuarantinedBlockOutOfOrder when previous blocks are not yet stored", func() {
+			mockBlockViewRepo.On("LatestBlockHeight").Return(uint64(8), nil)
+
+			err := retrier.Retry(uint64(10))
+
+			Expect(errors.Is(err, usecase.ErrQuarantinedBlockOutOfOrder)).To(BeTrue())
+			mockBlockDataRepo.AssertNotCalled(GinkgoT(), "Store", mock.Anything)
+		})
+
+		It("should keep the block in quarantine with the latest error when storing still fails", func() {
+			mockBlockViewRepo.On("LatestBlockHeight").Return(uint64(9), nil)
+			mockBlockDataRepo.On("Store", mock.Anything).Return(
+				fmt.Errorf("error storing block: %w", adapter.ErrInconsistentBlockData),
+			)
+			mockQuarantinedBlockRepo.On("Quarantine", mock.Anything).Return(nil)
+
+			err := retrier.Retry(uint64(10))
+
+			Expect(adapter.IsBlockDataError(err)).To(BeTrue())
+			mockQuarantinedBlockRepo.AssertCalled(GinkgoT(), "Quarantine", mock.MatchedBy(
+				func(block *usecase.QuarantinedBlock) bool {
+					return block.Height == uint64(10) && block.Stage == usecase.QUARANTINE_STAGE_STORE
+				},
+			))
+			mockQuarantinedBlockRepo.AssertNotCalled(GinkgoT(), "Release", mock.Anything)
+		})
+	})
+})
@@ -0,0 +1,201 @@
+package syncservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/crypto-com/chainindex/adapter/tendermint"
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+const DEFAULT_REINDEX_PROGRESS_INTERVAL = 10 * time.Second
+
+// Reindexer rebuilds stored block data and their projections by replaying
+// blocks through the parser and the block data repository
+type Reindexer struct {
+	logger  usecase.Logger
+	fetcher *BlockDataFetcher
+	repo    usecase.BlockDataRepository
+
+	options ReindexerOptions
+}
+
+type ReindexerOptions struct {
+	// Maximum number of blocks to store in a single transaction
+	BatchSize int
+	// Interval between each progress report
+	ProgressInterval time.Duration
+}
+
+type ReindexParams struct {
+	// Height to start replaying from. It must directly follow the last stored
+	// height unless the stored blocks are removed
+	FromHeight uint64
+	// Last height to replay
+	ToHeight uint64
+	// Remove the stored blocks from the from height onward and roll their
+	// projections back before replaying. All block data are reset when
+	// replaying from height 1
+	RemoveStoredBlocks bool
+}
+
+func NewReindexer(
+	logger usecase.Logger,
+	client tendermint.Client,
+	repo usecase.BlockDataRepository,
+	options ReindexerOptions,
+) *Reindexer {
+	if options.BatchSize < 1 {
+		options.BatchSize = 1
+	}
+	if options.ProgressInterval == 0 {
+		options.ProgressInterval = DEFAULT_REINDEX_PROGRESS_INTERVAL
+	}
+
+	return &Reindexer{
+		logger: logger.WithFields(usecase.LogFields{
+			"module": "Reindexer",
+		}),
+		fetcher: NewBlockDataFetcher(client),
+		repo:    repo,
+
+		options: options,
+	}
+}
+
+// Run replays blocks of the height range until all of them are stored or the
+// context is done. It returns the last stored height together with any error
+func (reindexer *Reindexer) Run(ctx context.Context, params ReindexParams) (lastStoredHeight uint64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic when reindexing block %d: %v", lastStoredHeight+1, r)
+			reindexer.logger.Error(err.Error())
+		}
+	}()
+
+	if params.FromHeight == 0 {
+		return uint64(0), errors.New("error reindexing: from height must be at least 1")
+	}
+	if params.FromHeight > params.ToHeight {
+		return uint64(0), fmt.Errorf(
+			"error reindexing: from height %d is greater than to height %d", params.FromHeight, params.ToHeight,
+		)
+	}
+	lastStoredHeight = params.FromHeight - 1
+
+	var maybeFirstBlockData *usecase.BlockData
+	if params.RemoveStoredBlocks {
+		if maybeFirstBlockData, err = reindexer.removeStoredBlocks(params); err != nil {
+			return lastStoredHeight, err
+		}
+	}
+
+	reindexer.logger.Infof("reindexing blocks from height %d to %d", params.FromHeight, params.ToHeight)
+	progress := newReindexProgress(params.FromHeight, params.ToHeight)
+	lastReportedAt := time.Now()
+
+	batch := make([]*usecase.BlockData, 0, reindexer.options.BatchSize)
+	for height := params.FromHeight; height <= params.ToHeight; height += 1 {
+		if ctx.Err() != nil {
+			return lastStoredHeight, ctx.Err()
+		}
+
+		var blockData *usecase.BlockData
+		if height == params.FromHeight && maybeFirstBlockData != nil {
+			blockData = maybeFirstBlockData
+		} else {
+			var fetchErr error
+			if blockData, fetchErr = reindexer.fetcher.Fetch(height); fetchErr != nil {
+				return lastStoredHeight, fmt.Errorf("error fetching block %d: %v", height, fetchErr)
+			}
+		}
+		batch = append(batch, blockData)
+
+		if len(batch) < reindexer.options.BatchSize && height < params.ToHeight {
+			continue
+		}
+		if err = reindexer.repo.StoreBatch(batch); err != nil {
+			return lastStoredHeight, fmt.Errorf("error storing blocks up to height %d: %v", height, err)
+		}
+		lastStoredHeight = height
+		batch = batch[:0]
+
+		if time.Since(lastReportedAt) >= reindexer.options.ProgressInterval {
+			reindexer.reportProgress(progress, lastStoredHeight)
+			lastReportedAt = time.Now()
+		}
+	}
+	reindexer.reportProgress(progress, lastStoredHeight)
+
+	return lastStoredHeight, nil
+}
+
+// removeStoredBlocks removes the stored blocks of the range and returns the
+// first block to replay. Both ends of the range are fetched beforehand, so
+// that a pruned node or an incomplete archive fails the reindex before any
+// stored block is removed
+func (reindexer *Reindexer) removeStoredBlocks(params ReindexParams) (*usecase.BlockData, error) {
+	var err error
+
+	firstBlockData, err := reindexer.fetcher.Fetch(params.FromHeight)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching block %d before removing stored blocks: %v", params.FromHeight, err)
+	}
+	if params.ToHeight != params.FromHeight {
+		if _, err = reindexer.fetcher.Fetch(params.ToHeight); err != nil {
+			return nil, fmt.Errorf("error fetching block %d before removing stored blocks: %v", params.ToHeight, err)
+		}
+	}
+
+	if params.FromHeight == uint64(1) {
+		reindexer.logger.Info("resetting stored block data")
+		if err = reindexer.repo.Reset(); err != nil {
+			return nil, fmt.Errorf("error resetting stored block data: %v", err)
+		}
+	} else {
+		reindexer.logger.Infof("removing stored block data from height %d", params.FromHeight)
+		if err = reindexer.repo.Rewind(params.FromHeight); err != nil {
+			return nil, fmt.Errorf("error removing stored block data from height %d: %v", params.FromHeight, err)
+		}
+	}
+
+	return firstBlockData, nil
+}
+
+func (reindexer *Reindexer) reportProgress(progress *reindexProgress, lastStoredHeight uint64) {
+	reindexer.logger.WithFields(usecase.LogFields{
+		"height":          lastStoredHeight,
+		"toHeight":        progress.toHeight,
+		"percentage":      fmt.Sprintf("%.2f", progress.Percentage(lastStoredHeight)),
+		"blocksPerSecond": fmt.Sprintf("%.2f", progress.BlocksPerSecond(lastStoredHeight)),
+	}).Info("reindex progress")
+}
+
+type reindexProgress struct {
+	fromHeight uint64
+	toHeight   uint64
+	startedAt  time.Time
+}
+
+func newReindexProgress(fromHeight uint64, toHeight uint64) *reindexProgress {
+	return &reindexProgress{
+		fromHeight: fromHeight,
+		toHeight:   toHeight,
+		startedAt:  time.Now(),
+	}
+}
+
+func (progress *reindexProgress) Percentage(lastStoredHeight uint64) float64 {
+	total := progress.toHeight - progress.fromHeight + 1
+	return float64(lastStoredHeight+1-progress.fromHeight) * 100 / float64(total)
+}
+
+func (progress *reindexProgress) BlocksPerSecond(lastStoredHeight uint64) float64 {
+	elapsed := time.Since(progress.startedAt).Seconds()
+	if elapsed == 0 {
+		return 0
+	}
+	return float64(lastStoredHeight+1-progress.fromHeight) / elapsed
+}
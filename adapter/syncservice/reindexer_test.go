@@ -0,0 +1,220 @@
+package syncservice_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/adapter/syncservice"
+	. "github.com/crypto-com/chainindex/adapter/tendermint/test/mock"
+	"github.com/crypto-com/chainindex/adapter/tendermint/types"
+	"github.com/crypto-com/chainindex/usecase"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+	. "github.com/crypto-com/chainindex/usecase/test/mock"
+)
+
+var _ = Describe("Reindexer", func() {
+	var mockClient *MockTendermintClient
+	var mockBlockDataRepo *MockBlockDataRepo
+	BeforeEach(func() {
+		mockClient = new(MockTendermintClient)
+		mockClient.On("Genesis").Return(anyGenesis(), nil)
+		for height := uint64(1); height <= 20; height += 1 {
+			mockClient.On("Block", height).Return(&types.Block{Height: height}, nil)
+			mockClient.On("BlockResults", height).Return(&types.BlockResults{Height: height}, nil)
+		}
+
+		mockBlockDataRepo = new(MockBlockDataRepo)
+	})
+
+	Describe("Run", func() {
+		It("should reset stored block data and replay blocks in batches when removing stored blocks from height 1", func() {
+			mockBlockDataRepo.On("Reset").Return(nil)
+			var storedHeights [][]uint64
+			mockBlockDataRepo.On("StoreBatch", mock.Anything).Run(func(args mock.Arguments) {
+				heights := make([]uint64, 0)
+				for _, blockData := range args.Get(0).([]*usecase.BlockData) {
+					heights = append(heights, blockData.Block.Height)
+				}
+				storedHeights = append(storedHeights, heights)
+			}).Return(nil)
+
+			reindexer := syncservice.NewReindexer(
+				new(FakeLogger), mockClient, mockBlockDataRepo, syncservice.ReindexerOptions{
+					BatchSize: 2,
+				},
+			)
+
+			lastStoredHeight, err := reindexer.Run(context.Background(), syncservice.ReindexParams{
+				FromHeight:         1,
+				ToHeight:           5,
+				RemoveStoredBlocks: true,
+			})
+
+			Expect(err).To(BeNil())
+			Expect(lastStoredHeight).To(Equal(uint64(5)))
+			mockBlockDataRepo.AssertCalled(GinkgoT(), "Reset")
+			Expect(storedHeights).To(Equal([][]uint64{{1, 2}, {3, 4}, {5}}))
+		})
+
+		It("should keep stored block data when resuming from a later height", func() {
+			mockBlockDataRepo.On("StoreBatch", mock.Anything).Return(nil)
+
+			reindexer := syncservice.NewReindexer(
+				new(FakeLogger), mockClient, mockBlockDataRepo, syncservice.ReindexerOptions{},
+			)
+
+			lastStoredHeight, err := reindexer.Run(context.Background(), syncservice.ReindexParams{
+				FromHeight: 3,
+				ToHeight:   4,
+			})
+
+			Expect(err).To(BeNil())
+			Expect(lastStoredHeight).To(Equal(uint64(4)))
+			mockBlockDataRepo.AssertNotCalled(GinkgoT(), "Reset")
+			mockBlockDataRepo.AssertNumberOfCalls(GinkgoT(), "StoreBatch", 2)
+		})
+
+		It("should return the last stored height when storing fails", func() {
+			mockBlockDataRepo.On("StoreBatch", mock.Anything).Return(nil).Once()
+			mockBlockDataRepo.On("StoreBatch", mock.Anything).Return(errors.New("connection lost"))
+
+			reindexer := syncservice.NewReindexer(
+				new(FakeLogger), mockClient, mockBlockDataRepo, syncservice.ReindexerOptions{},
+			)
+
+			lastStoredHeight, err := reindexer.Run(context.Background(), syncservice.ReindexParams{
+				FromHeight: 10,
+				ToHeight:   20,
+			})
+
+			Expect(err).NotTo(BeNil())
+			Expect(lastStoredHeight).To(Equal(uint64(10)))
+		})
+
+		It("should stop when the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			mockBlockDataRepo.On("StoreBatch", mock.Anything).Run(func(_ mock.Arguments) {
+				cancel()
+			}).Return(nil)
+
+			reindexer := syncservice.NewReindexer(
+				new(FakeLogger), mockClient, mockBlockDataRepo, syncservice.ReindexerOptions{},
+			)
+
+			lastStoredHeight, err := reindexer.Run(ctx, syncservice.ReindexParams{
+				FromHeight: 10,
+				ToHeight:   20,
+			})
+
+			Expect(err).To(Equal(context.Canceled))
+			Expect(lastStoredHeight).To(Equal(uint64(10)))
+		})
+
+		It("should keep stored block data when replaying from height 1 without removing stored blocks", func() {
+			mockBlockDataRepo.On("StoreBatch", mock.Anything).Return(nil)
+
+			reindexer := syncservice.NewReindexer(
+				new(FakeLogger), mockClient, mockBlockDataRepo, syncservice.ReindexerOptions{},
+			)
+
+			_, err := reindexer.Run(context.Background(), syncservice.ReindexParams{
+				FromHeight: 1,
+				ToHeight:   2,
+			})
+
+			Expect(err).To(BeNil())
+			mockBlockDataRepo.AssertNotCalled(GinkgoT(), "Reset")
+			mockBlockDataRepo.AssertNotCalled(GinkgoT(), "Rewind", mock.Anything)
+		})
+
+		It("should rewind stored block data from a later height and replay blocks in place", func() {
+			mockBlockDataRepo.On("Rewind", uint64(3)).Return(nil)
+			mockBlockDataRepo.On("StoreBatch", mock.Anything).Return(nil)
+
+			reindexer := syncservice.NewReindexer(
+				new(FakeLogger), mockClient, mockBlockDataRepo, syncservice.ReindexerOptions{},
+			)
+
+			lastStoredHeight, err := reindexer.Run(context.Background(), syncservice.ReindexParams{
+				FromHeight:         3,
+				ToHeight:           10,
+				RemoveStoredBlocks: true,
+			})
+
+			Expect(err).To(BeNil())
+			Expect(lastStoredHeight).To(Equal(uint64(10)))
+			mockBlockDataRepo.AssertCalled(GinkgoT(), "Rewind", uint64(3))
+			mockBlockDataRepo.AssertNotCalled(GinkgoT(), "Reset")
+			mockBlockDataRepo.AssertNumberOfCalls(GinkgoT(), "StoreBatch", 8)
+			mockClient.AssertNumberOfCalls(GinkgoT(), "BlockResults", 9)
+		})
+
+		It("should not reset stored block data when the last block of the range cannot be fetched", func() {
+			mockClient.On("Block", uint64(30)).Return(nil, errors.New("height 30 is not available"))
+
+			reindexer := syncservice.NewReindexer(
+				new(FakeLogger), mockClient, mockBlockDataRepo, syncservice.ReindexerOptions{},
+			)
+
+			lastStoredHeight, err := reindexer.Run(context.Background(), syncservice.ReindexParams{
+				FromHeight:         1,
+				ToHeight:           30,
+				RemoveStoredBlocks: true,
+			})
+
+			Expect(err).NotTo(BeNil())
+			Expect(lastStoredHeight).To(Equal(uint64(0)))
+			mockBlockDataRepo.AssertNotCalled(GinkgoT(), "Reset")
+			mockBlockDataRepo.AssertNotCalled(GinkgoT(), "StoreBatch", mock.Anything)
+		})
+
+		It("should not rewind stored block data when the first block of the range cannot be fetched", func() {
+			mockClient.On("Block", uint64(25)).Return(nil, errors.New("height 25 is not available"))
+
+			reindexer := syncservice.NewReindexer(
+				new(FakeLogger), mockClient, mockBlockDataRepo, syncservice.ReindexerOptions{},
+			)
+
+			lastStoredHeight, err := reindexer.Run(context.Background(), syncservice.ReindexParams{
+				FromHeight:         25,
+				ToHeight:           30,
+				RemoveStoredBlocks: true,
+			})
+
+			Expect(err).NotTo(BeNil())
+			Expect(lastStoredHeight).To(Equal(uint64(24)))
+			mockBlockDataRepo.AssertNotCalled(GinkgoT(), "Rewind", mock.Anything)
+			mockBlockDataRepo.AssertNotCalled(GinkgoT(), "StoreBatch", mock.Anything)
+		})
+
+		It("should return error when from height is greater than to height", func() {
+			reindexer := syncservice.NewReindexer(
+				new(FakeLogger), mockClient, mockBlockDataRepo, syncservice.ReindexerOptions{},
+			)
+
+			_, err := reindexer.Run(context.Background(), syncservice.ReindexParams{
+				FromHeight: 20,
+				ToHeight:   10,
+			})
+
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
+
+func anyGenesis() *types.Genesis {
+	var genesis types.Genesis
+	genesis.ConsensusParams.Block.MaxBytes = "22020096"
+	genesis.ConsensusParams.Block.MaxGas = "-1"
+	genesis.ConsensusParams.Block.TimeIotaMs = "1000"
+	genesis.ConsensusParams.Evidence.MaxAgeNumBlocks = "200"
+	genesis.ConsensusParams.Evidence.MaxAgeDuration = "5400000000000"
+	genesis.AppState.NetworkParams.RequiredCouncilNodeStake = "5000000000000000"
+	genesis.AppState.NetworkParams.RewardsConfig.MonetaryExpansionCap = "2000000000000000000"
+
+	return &genesis
+}
@@ -0,0 +1,179 @@
+package syncservice
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+const DEFAULT_SHADOW_CHECK_INTERVAL = 5 * time.Second
+
+// SchemaSwapper promotes the shadow schema to the live schema. It returns the
+// name of the retired live schema
+type SchemaSwapper interface {
+	Swap() (string, error)
+}
+
+// ShadowSyncService builds a new index into a shadow schema while the live
+// index keeps being synced and served. Once the shadow index catches up with
+// the live one, live synchronization is stopped and the schemas are swapped.
+// Synchronization continues with the shadow sync service afterwards
+type ShadowSyncService struct {
+	logger usecase.Logger
+
+	liveSyncService   usecase.SyncService
+	shadowSyncService usecase.SyncService
+	schemaSwapper     SchemaSwapper
+
+	options ShadowSyncServiceOptions
+
+	swappedMux sync.RWMutex
+	swapped    bool
+}
+
+type ShadowSyncServiceOptions struct {
+	// Interval between each comparison of live and shadow sync heights
+	CheckInterval time.Duration
+}
+
+func NewShadowSyncService(
+	logger usecase.Logger,
+
+	liveSyncService usecase.SyncService,
+	shadowSyncService usecase.SyncService,
+	schemaSwapper SchemaSwapper,
+
+	options ShadowSyncServiceOptions,
+) *ShadowSyncService {
+	if options.CheckInterval == 0 {
+		options.CheckInterval = DEFAULT_SHADOW_CHECK_INTERVAL
+	}
+
+	return &ShadowSyncService{
+		logger: logger.WithFields(usecase.LogFields{
+			"module": "ShadowSyncService",
+		}),
+
+		liveSyncService:   liveSyncService,
+		shadowSyncService: shadowSyncService,
+		schemaSwapper:     schemaSwapper,
+
+		options: options,
+	}
+}
+
+// Sync runs both live and shadow synchronization until the shadow index
+// catches up and is swapped in, then keeps running shadow synchronization
+// until the context is done or it fails
+func (syncService *ShadowSyncService) Sync(ctx context.Context) error {
+	liveCtx, cancelLive := context.WithCancel(ctx)
+	defer cancelLive()
+	shadowCtx, cancelShadow := context.WithCancel(ctx)
+	defer cancelShadow()
+
+	liveStoppedCh := make(chan error, 1)
+	go func() {
+		liveStoppedCh <- syncService.liveSyncService.Sync(liveCtx)
+	}()
+	shadowStoppedCh := make(chan error, 1)
+	go func() {
+		shadowStoppedCh <- syncService.shadowSyncService.Sync(shadowCtx)
+	}()
+
+	// Any of the sync services stopping before the swap stops the other one
+	stopOther := func(err error, otherStoppedCh <-chan error) error {
+		cancelLive()
+		cancelShadow()
+		if otherErr := <-otherStoppedCh; err == nil {
+			err = otherErr
+		}
+		return err
+	}
+
+	ticker := time.NewTicker(syncService.options.CheckInterval)
+	defer ticker.Stop()
+
+	for !syncService.isShadowCaughtUp() {
+		select {
+		case <-ctx.Done():
+			return stopOther(<-liveStoppedCh, shadowStoppedCh)
+		case err := <-liveStoppedCh:
+			if err != nil {
+				err = fmt.Errorf("error running live sync service: %w", err)
+			}
+			return stopOther(err, shadowStoppedCh)
+		case err := <-shadowStoppedCh:
+			if err != nil {
+				err = fmt.Errorf("error running shadow sync service: %w", err)
+			}
+			return stopOther(err, liveStoppedCh)
+		case <-ticker.C:
+		}
+	}
+
+	syncService.logger.Info("shadow index caught up with live index, stopping live synchronization")
+	cancelLive()
+	if err := <-liveStoppedCh; err != nil {
+		syncService.logger.Errorf("live sync service stopped with error: %v", err)
+	}
+	liveSyncHeight := syncService.liveSyncService.GetStatus().SyncBlockHeight
+
+	for syncService.shadowSyncService.GetStatus().SyncBlockHeight < liveSyncHeight {
+		select {
+		case <-ctx.Done():
+			return <-shadowStoppedCh
+		case err := <-shadowStoppedCh:
+			if err != nil {
+				return fmt.Errorf("error running shadow sync service: %w", err)
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+
+	retiredSchema, err := syncService.schemaSwapper.Swap()
+	if err != nil {
+		cancelShadow()
+		<-shadowStoppedCh
+		return fmt.Errorf("error swapping shadow schema in at height %d: %v", liveSyncHeight, err)
+	}
+	syncService.swappedMux.Lock()
+	syncService.swapped = true
+	syncService.swappedMux.Unlock()
+	syncService.logger.Infof(
+		"swapped shadow schema in at height %d, previous live schema is retired as %s", liveSyncHeight, retiredSchema,
+	)
+
+	if err := <-shadowStoppedCh; err != nil {
+		return fmt.Errorf("error running shadow sync service: %w", err)
+	}
+	return nil
+}
+
+func (syncService *ShadowSyncService) isShadowCaughtUp() bool {
+	liveStatus := syncService.liveSyncService.GetStatus()
+	shadowStatus := syncService.shadowSyncService.GetStatus()
+
+	syncService.logger.WithFields(usecase.LogFields{
+		"liveSyncHeight":   liveStatus.SyncBlockHeight,
+		"shadowSyncHeight": shadowStatus.SyncBlockHeight,
+	}).Debug("comparing live and shadow sync heights")
+
+	return shadowStatus.SyncBlockHeight > uint64(0) &&
+		shadowStatus.SyncBlockHeight >= liveStatus.SyncBlockHeight
+}
+
+// GetStatus returns the status of the live sync service until the shadow
+// schema is swapped in
+func (syncService *ShadowSyncService) GetStatus() usecase.SyncStatus {
+	syncService.swappedMux.RLock()
+	defer syncService.swappedMux.RUnlock()
+
+	if syncService.swapped {
+		return syncService.shadowSyncService.GetStatus()
+	}
+	return syncService.liveSyncService.GetStatus()
+}
@@ -0,0 +1,166 @@
+package syncservice_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chainindex/adapter/syncservice"
+	"github.com/crypto-com/chainindex/usecase"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+)
+
+var _ = Describe("ShadowSyncService", func() {
+	var liveSyncService *fakeSyncService
+	var shadowSyncService *fakeSyncService
+	var schemaSwapper *fakeSchemaSwapper
+	var shadowSyncServiceUnderTest *syncservice.ShadowSyncService
+	BeforeEach(func() {
+		liveSyncService = newFakeSyncService(uint64(100))
+		shadowSyncService = newFakeSyncService(uint64(0))
+		schemaSwapper = new(fakeSchemaSwapper)
+		shadowSyncServiceUnderTest = syncservice.NewShadowSyncService(
+			new(FakeLogger),
+			liveSyncService,
+			shadowSyncService,
+			schemaSwapper,
+			syncservice.ShadowSyncServiceOptions{
+				CheckInterval: 10 * time.Millisecond,
+			},
+		)
+	})
+
+	Describe("Sync", func() {
+		It("should stop live synchronization and swap the schemas when the shadow index catches up", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			doneCh := make(chan error, 1)
+			go func() {
+				doneCh <- shadowSyncServiceUnderTest.Sync(ctx)
+			}()
+
+			shadowSyncService.SetSyncHeight(uint64(50))
+			Consistently(schemaSwapper.SwapCount).Should(Equal(0))
+			Expect(shadowSyncServiceUnderTest.GetStatus().SyncBlockHeight).To(Equal(uint64(100)))
+
+			shadowSyncService.SetSyncHeight(uint64(100))
+			Eventually(schemaSwapper.SwapCount).Should(Equal(1))
+			Expect(liveSyncService.IsRunning()).To(BeFalse())
+			Expect(shadowSyncService.IsRunning()).To(BeTrue())
+
+			shadowSyncService.SetSyncHeight(uint64(101))
+			Expect(shadowSyncServiceUnderTest.GetStatus().SyncBlockHeight).To(Equal(uint64(101)))
+
+			cancel()
+			Eventually(doneCh).Should(Receive(BeNil()))
+		})
+
+		It("should stop the shadow synchronization and return error when the live synchronization fails", func() {
+			liveSyncService.syncErr = errors.New("live sync error")
+
+			ctx, cancel := context.WithCancel(context.Background())
+			doneCh := make(chan error, 1)
+			go func() {
+				doneCh <- shadowSyncServiceUnderTest.Sync(ctx)
+			}()
+			cancel()
+
+			var err error
+			Eventually(doneCh).Should(Receive(&err))
+			Expect(err.Error()).To(ContainSubstring("live sync error"))
+			Expect(shadowSyncService.IsRunning()).To(BeFalse())
+			Expect(schemaSwapper.SwapCount()).To(Equal(0))
+		})
+
+		It("should return error when swapping fails", func() {
+			schemaSwapper.swapErr = errors.New("swap error")
+			shadowSyncService.SetSyncHeight(uint64(100))
+
+			err := shadowSyncServiceUnderTest.Sync(context.Background())
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("swap error"))
+			Expect(shadowSyncService.IsRunning()).To(BeFalse())
+		})
+	})
+})
+
+type fakeSyncService struct {
+	mux        sync.RWMutex
+	syncHeight uint64
+	isRunning  bool
+
+	syncErr error
+}
+
+func newFakeSyncService(syncHeight uint64) *fakeSyncService {
+	return &fakeSyncService{
+		syncHeight: syncHeight,
+	}
+}
+
+func (syncService *fakeSyncService) Sync(ctx context.Context) error {
+	syncService.mux.Lock()
+	syncService.isRunning = true
+	syncService.mux.Unlock()
+
+	<-ctx.Done()
+
+	syncService.mux.Lock()
+	syncService.isRunning = false
+	syncService.mux.Unlock()
+
+	return syncService.syncErr
+}
+
+func (syncService *fakeSyncService) GetStatus() usecase.SyncStatus {
+	syncService.mux.RLock()
+	defer syncService.mux.RUnlock()
+
+	return usecase.SyncStatus{
+		TendermintBlockHeight: syncService.syncHeight,
+		SyncBlockHeight:       syncService.syncHeight,
+	}
+}
+
+func (syncService *fakeSyncService) SetSyncHeight(syncHeight uint64) {
+	syncService.mux.Lock()
+	defer syncService.mux.Unlock()
+
+	syncService.syncHeight = syncHeight
+}
+
+func (syncService *fakeSyncService) IsRunning() bool {
+	syncService.mux.RLock()
+	defer syncService.mux.RUnlock()
+
+	return syncService.isRunning
+}
+
+type fakeSchemaSwapper struct {
+	mux       sync.Mutex
+	swapCount int
+
+	swapErr error
+}
+
+func (swapper *fakeSchemaSwapper) Swap() (string, error) {
+	swapper.mux.Lock()
+	defer swapper.mux.Unlock()
+
+	if swapper.swapErr != nil {
+		return "", swapper.swapErr
+	}
+	swapper.swapCount += 1
+	return "public_retired", nil
+}
+
+func (swapper *fakeSchemaSwapper) SwapCount() int {
+	swapper.mux.Lock()
+	defer swapper.mux.Unlock()
+
+	return swapper.swapCount
+}
@@ -0,0 +1,210 @@
+package adapter
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+// syncStateCheckpoint is the synchronization progress recorded into the sync
+// state together with the stored block data
+type syncStateCheckpoint struct {
+	lastStoredHeight             uint64
+	lastObservedTendermintHeight uint64
+	// The recorded chain id is kept when it is nil. The checkpoint fails with
+	// ErrChainIDMismatch when it differs from the recorded one
+	maybeChainID *string
+}
+
+// newSyncStateCheckpoint returns the progress after storing the consecutive
+// block data
+func newSyncStateCheckpoint(blockDataList []*usecase.BlockData) *syncStateCheckpoint {
+	var checkpoint syncStateCheckpoint
+	for _, blockData := range blockDataList {
+		checkpoint.lastStoredHeight = blockData.Block.Height
+		if blockData.TendermintHeight > checkpoint.lastObservedTendermintHeight {
+			checkpoint.lastObservedTendermintHeight = blockData.TendermintHeight
+		}
+		if blockData.Block.ChainID != "" {
+			checkpoint.maybeChainID = &blockData.Block.ChainID
+		}
+	}
+	// Tendermint is at least at the stored height
+	if checkpoint.lastStoredHeight > checkpoint.lastObservedTendermintHeight {
+		checkpoint.lastObservedTendermintHeight = checkpoint.lastStoredHeight
+	}
+
+	return &checkpoint
+}
+
+// checkpointSyncState records the progress into the sync state in the same
+// transaction as the block data. Heights never move backward so that blocks
+// retried out of quarantine do not rewind the progress. The oldest parser
+// version having stored blocks is kept until the index is reset. Nothing is
+// updated when the chain id differs from the recorded one
+func (repo *RDbBlockDataRepo) checkpointSyncState(
+	tx RDbTx,
+	checkpoint *syncStateCheckpoint,
+	checkpointedAt time.Time,
+) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Insert(
+		"sync_state",
+	).Columns(
+		"last_stored_height",
+		"last_observed_tendermint_height",
+		"chain_id",
+		"parser_version",
+		"created_at",
+		"updated_at",
+	).Values(
+		checkpoint.lastStoredHeight,
+		checkpoint.lastObservedTendermintHeight,
+		checkpoint.maybeChainID,
+		BLOCK_DATA_PARSER_VERSION,
+		repo.typeConv.Tton(&checkpointedAt),
+		repo.typeConv.Tton(&checkpointedAt),
+	).Suffix(
+		"ON CONFLICT (id) DO UPDATE SET " +
+			"last_stored_height = GREATEST(sync_state.last_stored_height, EXCLUDED.last_stored_height), " +
+			"last_observed_tendermint_height = GREATEST(sync_state.last_observed_tendermint_height, EXCLUDED.last_observed_tendermint_height), " +
+			"chain_id = COALESCE(EXCLUDED.chain_id, sync_state.chain_id), " +
+			"parser_version = LEAST(sync_state.parser_version, EXCLUDED.parser_version), " +
+			"updated_at = EXCLUDED.updated_at " +
+			"WHERE sync_state.chain_id IS NULL OR EXCLUDED.chain_id IS NULL OR sync_state.chain_id = EXCLUDED.chain_id",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building sync state checkpoint SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	result, err := tx.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error checkpointing sync state: %v: %w", err, ErrRepoWrite)
+	}
+	if result.RowsAffected() != 1 {
+		if checkpoint.maybeChainID == nil {
+			return fmt.Errorf("error checkpointing sync state: no row updated: %w", ErrRepoWrite)
+		}
+		return fmt.Errorf(
+			"error checkpointing sync state at height %d: chain id %s differs from the chain id of the index: %w",
+			checkpoint.lastStoredHeight, *checkpoint.maybeChainID, ErrChainIDMismatch,
+		)
+	}
+
+	return nil
+}
+
+// resetSyncState rewinds the progress of the sync state after the block data
+// are reset, so that the parser version is the one rebuilding the index. The
+// chain id and the last error are kept
+func (repo *RDbBlockDataRepo) resetSyncState(tx RDbTx, resetAt time.Time) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Update(
+		"sync_state",
+	).SetMap(sq.Eq{
+		"last_stored_height": 0,
+		"parser_version":     BLOCK_DATA_PARSER_VERSION,
+		"updated_at":         repo.typeConv.Tton(&resetAt),
+	}).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building sync state reset SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	if _, err = tx.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error resetting sync state: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+// rewindSyncState moves the progress of the sync state back to the last
+// height kept after the block data are rewound. The parser version is kept as
+// the blocks before are not parsed again
+func (repo *RDbBlockDataRepo) rewindSyncState(tx RDbTx, lastStoredHeight uint64, rewoundAt time.Time) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Update(
+		"sync_state",
+	).SetMap(sq.Eq{
+		"last_stored_height": lastStoredHeight,
+		"updated_at":         repo.typeConv.Tton(&rewoundAt),
+	}).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building sync state rewind SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	if _, err = tx.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error rewinding sync state: %v: %w", err, ErrRepoWrite)
+	}
+
+	return nil
+}
+
+// RDbSyncStateRepo records synchronization diagnostics into the sync_state
+// table
+type RDbSyncStateRepo struct {
+	conn        RDbConn
+	stmtBuilder sq.StatementBuilderType
+	typeConv    RDbTypeConv
+}
+
+func NewRDbSyncStateRepo(
+	conn RDbConn,
+	stmtBuilder sq.StatementBuilderType,
+	typeConv RDbTypeConv,
+) *RDbSyncStateRepo {
+	return &RDbSyncStateRepo{
+		conn,
+		stmtBuilder,
+		typeConv,
+	}
+}
+
+// RecordError keeps the error in the sync state. An error occurring before any
+// block is stored creates the sync state at height 0
+func (repo *RDbSyncStateRepo) RecordError(message string, occurredAt time.Time) error {
+	var err error
+
+	sql, sqlArgs, err := repo.stmtBuilder.Insert(
+		"sync_state",
+	).Columns(
+		"last_stored_height",
+		"last_observed_tendermint_height",
+		"parser_version",
+		"last_error",
+		"last_error_at",
+		"created_at",
+		"updated_at",
+	).Values(
+		0,
+		0,
+		BLOCK_DATA_PARSER_VERSION,
+		message,
+		repo.typeConv.Tton(&occurredAt),
+		repo.typeConv.Tton(&occurredAt),
+		repo.typeConv.Tton(&occurredAt),
+	).Suffix(
+		"ON CONFLICT (id) DO UPDATE SET " +
+			"last_error = EXCLUDED.last_error, " +
+			"last_error_at = EXCLUDED.last_error_at, " +
+			"updated_at = EXCLUDED.updated_at",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building sync state error recording SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	result, err := repo.conn.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error recording error into sync state: %v: %w", err, ErrRepoWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error recording error into sync state: no row updated: %w", ErrRepoWrite)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package adapter_test
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/adapter"
+	. "github.com/crypto-com/chainindex/adapter/test/fake"
+	. "github.com/crypto-com/chainindex/adapter/test/mock"
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+const (
+	SQL_SYNC_STATE_CHECKPOINT_UPSERT = "INSERT INTO sync_state (last_stored_height,last_observed_tendermint_height,chain_id,parser_version,created_at,updated_at) VALUES (?,?,?,?,?,?) " +
+		"ON CONFLICT (id) DO UPDATE SET last_stored_height = GREATEST(sync_state.last_stored_height, EXCLUDED.last_stored_height), " +
+		"last_observed_tendermint_height = GREATEST(sync_state.last_observed_tendermint_height, EXCLUDED.last_observed_tendermint_height), " +
+		"chain_id = COALESCE(EXCLUDED.chain_id, sync_state.chain_id), " +
+		"parser_version = LEAST(sync_state.parser_version, EXCLUDED.parser_version), " +
+		"updated_at = EXCLUDED.updated_at " +
+		"WHERE sync_state.chain_id IS NULL OR EXCLUDED.chain_id IS NULL OR sync_state.chain_id = EXCLUDED.chain_id"
+	SQL_SYNC_STATE_ERROR_UPSERT = "INSERT INTO sync_state (last_stored_height,last_observed_tendermint_height,parser_version,last_error,last_error_at,created_at,updated_at) VALUES (?,?,?,?,?,?,?) " +
+		"ON CONFLICT (id) DO UPDATE SET last_error = EXCLUDED.last_error, last_error_at = EXCLUDED.last_error_at, updated_at = EXCLUDED.updated_at"
+)
+
+var _ = Describe("RDbSyncStateRepo", func() {
+	var mockConn *MockRDbConn
+	var repo *adapter.RDbSyncStateRepo
+	BeforeEach(func() {
+		mockConn = new(MockRDbConn)
+		repo = adapter.NewRDbSyncStateRepo(mockConn, sq.StatementBuilder, new(PrimRDbTypeConv))
+	})
+
+	It("should implement SyncStateRepository", func() {
+		var _ usecase.SyncStateRepository = repo
+	})
+
+	Describe("RecordError", func() {
+		It("should upsert the error into sync state", func() {
+			occurredAt := time.Unix(1592000000, 0)
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(1))
+			mockConn.On("Exec",
+				SQL_SYNC_STATE_ERROR_UPSERT,
+				0,
+				0,
+				adapter.BLOCK_DATA_PARSER_VERSION,
+				"error processing block 100 at store stage: malformed block",
+				&occurredAt,
+				&occurredAt,
+				&occurredAt,
+			).Return(mockExecResult, nil)
+
+			err := repo.RecordError("error processing block 100 at store stage: malformed block", occurredAt)
+
+			Expect(err).To(BeNil())
+			mockConn.AssertExpectations(GinkgoT())
+		})
+
+		It("should return error when the sync state is not written", func() {
+			mockExecResult := new(MockRDbExecResult)
+			mockExecResult.On("RowsAffected").Return(int64(0))
+			mockConn.On("Exec", MockSQLWithAnyArgs(SQL_SYNC_STATE_ERROR_UPSERT, 7)...).Return(mockExecResult, nil)
+
+			err := repo.RecordError("malformed block", time.Unix(1592000000, 0))
+
+			Expect(err).To(MatchError(adapter.ErrRepoWrite))
+		})
+	})
+})
+
+func OnTxUpsertSyncStateWithoutChainID(mockTx *MockRDbTx) *mock.Call {
+	return mockTx.On("Exec",
+		SQL_SYNC_STATE_CHECKPOINT_UPSERT,
+		mock.Anything,
+		mock.Anything,
+		(*string)(nil),
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	)
+}
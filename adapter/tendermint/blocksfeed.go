@@ -1,9 +1,11 @@
 package tendermint
 
+import "context"
+
 // Tendermint latest block height news feed
 type BlocksFeed interface {
-	// Subscribe to block height updates via channel. It does not guarantee
-	// every block height wll be sent to the channel. It is the downstream
-	// responsibility to fill all those gaps
-	Subscribe(chan<- uint64) error
+	// Subscribe to block height updates via channel until the context is
+	// done. It does not guarantee every block height wll be sent to the
+	// channel. It is the downstream responsibility to fill all those gaps
+	Subscribe(ctx context.Context, subscriberCh chan<- uint64) error
 }
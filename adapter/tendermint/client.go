@@ -1,10 +1,33 @@
 package tendermint
 
-import "github.com/crypto-com/chainindex/adapter/tendermint/types"
+import (
+	"time"
+
+	"github.com/crypto-com/chainindex/adapter/tendermint/types"
+)
 
 type Client interface {
 	Genesis() (*types.Genesis, error)
 	LatestBlockHeight() (uint64, error)
 	BlockResults(height uint64) (*types.BlockResults, error)
 	Block(height uint64) (*types.Block, error)
+	// UnconfirmedTxs returns the base64 encoded transactions in the mempool
+	// which are not yet included in a block
+	UnconfirmedTxs() ([]string, error)
+}
+
+// EndpointStatus is the health of a Tendermint RPC endpoint as observed by
+// the client
+type EndpointStatus struct {
+	URL               string
+	Healthy           bool
+	LatestBlockHeight uint64
+	LastError         *string
+	LastCheckedAt     *time.Time
+}
+
+// EndpointStatusProvider reports the status of each Tendermint RPC endpoint a
+// client requests from
+type EndpointStatusProvider interface {
+	EndpointStatuses() []EndpointStatus
 }
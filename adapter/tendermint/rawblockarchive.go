@@ -0,0 +1,38 @@
+package tendermint
+
+import "errors"
+
+var ErrNotArchived = errors.New("raw Tendermint response not archived")
+
+// RawClient requests verbatim Tendermint RPC responses without parsing them
+type RawClient interface {
+	RawGenesis() ([]byte, error)
+	RawBlock(height uint64) ([]byte, error)
+	RawBlockResults(height uint64) ([]byte, error)
+	LatestBlockHeight() (uint64, error)
+	// Unconfirmed transactions are never archived nor recorded, they are
+	// passed through
+	UnconfirmedTxs() ([]string, error)
+}
+
+// RawBlockArchive is an append-only store of verbatim Tendermint genesis,
+// block and block_results responses. It allows re-parsing blocks without
+// requesting them from a Tendermint node again.
+type RawBlockArchive interface {
+	// Store genesis response. Archived genesis is never overwritten
+	StoreGenesis(rawGenesis []byte) error
+	// Store block response at height. Archived block is never overwritten
+	StoreBlock(height uint64, rawBlock []byte) error
+	// Store block_results response at height. Archived block results is never
+	// overwritten
+	StoreBlockResults(height uint64, rawBlockResults []byte) error
+
+	// Find the archived responses. Returns ErrNotArchived when not found
+	FindGenesis() ([]byte, error)
+	FindBlock(height uint64) ([]byte, error)
+	FindBlockResults(height uint64) ([]byte, error)
+
+	// Returns the highest height of which both block and block_results are
+	// archived
+	LatestHeight() (uint64, error)
+}
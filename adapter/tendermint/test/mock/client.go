@@ -3,6 +3,7 @@ package adaptertendermintmock
 import (
 	"github.com/stretchr/testify/mock"
 
+	"github.com/crypto-com/chainindex/adapter/tendermint"
 	"github.com/crypto-com/chainindex/adapter/tendermint/types"
 )
 
@@ -29,3 +30,18 @@ func (client *MockTendermintClient) Block(height uint64) (*types.Block, error) {
 	args := client.Called(height)
 	return args.Get(0).(*types.Block), args.Error(1)
 }
+
+func (client *MockTendermintClient) UnconfirmedTxs() ([]string, error) {
+	args := client.Called()
+	return args.Get(0).([]string), args.Error(1)
+}
+
+type MockEndpointStatusProvider struct {
+	mock.Mock
+}
+
+func (provider *MockEndpointStatusProvider) EndpointStatuses() []tendermint.EndpointStatus {
+	args := provider.Called()
+
+	return args.Get(0).([]tendermint.EndpointStatus)
+}
@@ -0,0 +1,47 @@
+package adaptertendermintmock
+
+import (
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRawBlockArchive struct {
+	mock.Mock
+}
+
+func (archive *MockRawBlockArchive) StoreGenesis(rawGenesis []byte) error {
+	args := archive.Called(rawGenesis)
+	return args.Error(0)
+}
+
+func (archive *MockRawBlockArchive) StoreBlock(height uint64, rawBlock []byte) error {
+	args := archive.Called(height, rawBlock)
+	return args.Error(0)
+}
+
+func (archive *MockRawBlockArchive) StoreBlockResults(height uint64, rawBlockResults []byte) error {
+	args := archive.Called(height, rawBlockResults)
+	return args.Error(0)
+}
+
+func (archive *MockRawBlockArchive) FindGenesis() ([]byte, error) {
+	args := archive.Called()
+	result, _ := args.Get(0).([]byte)
+	return result, args.Error(1)
+}
+
+func (archive *MockRawBlockArchive) FindBlock(height uint64) ([]byte, error) {
+	args := archive.Called(height)
+	result, _ := args.Get(0).([]byte)
+	return result, args.Error(1)
+}
+
+func (archive *MockRawBlockArchive) FindBlockResults(height uint64) ([]byte, error) {
+	args := archive.Called(height)
+	result, _ := args.Get(0).([]byte)
+	return result, args.Error(1)
+}
+
+func (archive *MockRawBlockArchive) LatestHeight() (uint64, error) {
+	args := archive.Called()
+	return args.Get(0).(uint64), args.Error(1)
+}
@@ -50,38 +50,8 @@ type BlockResp struct {
 							Type  string `json:"type"`
 							Value string `json:"value"`
 						} `json:"PubKey"`
-						VoteA struct {
-							Type    int    `json:"type"`
-							Height  string `json:"height"`
-							Round   string `json:"round"`
-							BlockID struct {
-								Hash  string `json:"hash"`
-								Parts struct {
-									Total string `json:"total"`
-									Hash  string `json:"hash"`
-								} `json:"parts"`
-							} `json:"block_id"`
-							Timestamp        time.Time `json:"timestamp"`
-							ValidatorAddress string    `json:"validator_address"`
-							ValidatorIndex   string    `json:"validator_index"`
-							Signature        string    `json:"signature"`
-						} `json:"VoteA"`
-						VoteB struct {
-							Type    int    `json:"type"`
-							Height  string `json:"height"`
-							Round   string `json:"round"`
-							BlockID struct {
-								Hash  string `json:"hash"`
-								Parts struct {
-									Total string `json:"total"`
-									Hash  string `json:"hash"`
-								} `json:"parts"`
-							} `json:"block_id"`
-							Timestamp        time.Time `json:"timestamp"`
-							ValidatorAddress string    `json:"validator_address"`
-							ValidatorIndex   string    `json:"validator_index"`
-							Signature        string    `json:"signature"`
-						} `json:"VoteB"`
+						VoteA RawBlockEvidenceVote `json:"VoteA"`
+						VoteB RawBlockEvidenceVote `json:"VoteB"`
 					} `json:"value"`
 				} `json:"evidence"`
 			} `json:"evidence"`
@@ -101,6 +71,23 @@ type BlockResp struct {
 	} `json:"result"`
 }
 
+type RawBlockEvidenceVote struct {
+	Type    int    `json:"type"`
+	Height  string `json:"height"`
+	Round   string `json:"round"`
+	BlockID struct {
+		Hash  string `json:"hash"`
+		Parts struct {
+			Total string `json:"total"`
+			Hash  string `json:"hash"`
+		} `json:"parts"`
+	} `json:"block_id"`
+	Timestamp        time.Time `json:"timestamp"`
+	ValidatorAddress string    `json:"validator_address"`
+	ValidatorIndex   string    `json:"validator_index"`
+	Signature        string    `json:"signature"`
+}
+
 type RawBlockSignature struct {
 	BlockIDFlag      int       `json:"block_id_flag"`
 	ValidatorAddress string    `json:"validator_address"`
@@ -109,16 +96,41 @@ type RawBlockSignature struct {
 }
 
 type Block struct {
-	Height         uint64
-	Hash           string
+	Height  uint64
+	Hash    string
+	ChainID string
+	// Hash of the previous block. It is empty in the first block
+	LastBlockHash  string
 	Time           time.Time
 	AppHash        string
 	PropserAddress string
 	Txs            []string
 	Signatures     []BlockSignature
+	Evidences      []BlockEvidence
 }
 
 type BlockSignature struct {
 	ValidatorAddress string
 	Signature        string
 }
+
+// BlockEvidence is a duplicate vote evidence of a validator signing two
+// conflicting votes
+type BlockEvidence struct {
+	Type       string
+	PubKeyType string
+	PubKey     string
+	VoteA      BlockEvidenceVote
+	VoteB      BlockEvidenceVote
+}
+
+type BlockEvidenceVote struct {
+	Type             int
+	Height           uint64
+	Round            uint64
+	BlockHash        string
+	Timestamp        time.Time
+	ValidatorAddress string
+	ValidatorIndex   uint64
+	Signature        string
+}
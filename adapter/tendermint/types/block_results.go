@@ -24,6 +24,12 @@ type RawBlockResultsTxResult struct {
 	Codespace string                 `json:"codespace"`
 }
 
+type BlockResultsTxResult struct {
+	Code      uint32
+	Log       string
+	Codespace string
+}
+
 type RawBlockResultsEvent struct {
 	Type       string `json:"type"`
 	Attributes []struct {
@@ -41,9 +47,13 @@ type RawBlockResultsValidator struct {
 }
 
 type BlockResults struct {
-	Height           uint64
-	TxsEvents        [][]BlockResultsEvent
+	Height    uint64
+	TxsEvents [][]BlockResultsEvent
+	// TxsResults[i] is the execution result of the transaction emitting
+	// TxsEvents[i]
+	TxsResults       []BlockResultsTxResult
 	BeginBlockEvents []BlockResultsEvent
+	EndBlockEvents   []BlockResultsEvent
 	ValidatorUpdates []BlockResultsValidator
 }
 
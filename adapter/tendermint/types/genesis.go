@@ -9,23 +9,10 @@ type GenesisResp struct {
 	ID      int    `json:"id"`
 	Result  struct {
 		Genesis struct {
-			GenesisTime     time.Time `json:"genesis_time"`
-			ChainID         string    `json:"chain_id"`
-			ConsensusParams struct {
-				Block struct {
-					MaxBytes   string `json:"max_bytes"`
-					MaxGas     string `json:"max_gas"`
-					TimeIotaMs string `json:"time_iota_ms"`
-				} `json:"block"`
-				Evidence struct {
-					MaxAgeNumBlocks string `json:"max_age_num_blocks"`
-					MaxAgeDuration  string `json:"max_age_duration"`
-				} `json:"evidence"`
-				Validator struct {
-					PubKeyTypes []string `json:"pub_key_types"`
-				} `json:"validator"`
-			} `json:"consensus_params"`
-			Validators []struct {
+			GenesisTime     time.Time              `json:"genesis_time"`
+			ChainID         string                 `json:"chain_id"`
+			ConsensusParams GenesisConsensusParams `json:"consensus_params"`
+			Validators      []struct {
 				Address string `json:"address"`
 				PubKey  struct {
 					Type  string `json:"type"`
@@ -38,35 +25,52 @@ type GenesisResp struct {
 			AppState struct {
 				CouncilNodes  map[string][]interface{} `json:"council_nodes"`
 				Distribution  map[string][]string      `json:"distribution"`
-				NetworkParams struct {
-					InitialFeePolicy struct {
-						Coefficient int `json:"coefficient"`
-						Constant    int `json:"constant"`
-					} `json:"initial_fee_policy"`
-					JailingConfig struct {
-						BlockSigningWindow   int `json:"block_signing_window"`
-						MissedBlockThreshold int `json:"missed_block_threshold"`
-					} `json:"jailing_config"`
-					MaxValidators            int    `json:"max_validators"`
-					RequiredCouncilNodeStake string `json:"required_council_node_stake"`
-					RewardsConfig            struct {
-						MonetaryExpansionCap   string `json:"monetary_expansion_cap"`
-						MonetaryExpansionDecay int    `json:"monetary_expansion_decay"`
-						MonetaryExpansionR0    int    `json:"monetary_expansion_r0"`
-						MonetaryExpansionTau   int64  `json:"monetary_expansion_tau"`
-						RewardPeriodSeconds    int    `json:"reward_period_seconds"`
-					} `json:"rewards_config"`
-					SlashingConfig struct {
-						ByzantineSlashPercent string `json:"byzantine_slash_percent"`
-						LivenessSlashPercent  string `json:"liveness_slash_percent"`
-					} `json:"slashing_config"`
-					UnbondingPeriod int `json:"unbonding_period"`
-				} `json:"network_params"`
+				NetworkParams GenesisNetworkParams     `json:"network_params"`
 			} `json:"app_state"`
 		} `json:"genesis"`
 	} `json:"result"`
 }
 
+type GenesisConsensusParams struct {
+	Block struct {
+		MaxBytes   string `json:"max_bytes"`
+		MaxGas     string `json:"max_gas"`
+		TimeIotaMs string `json:"time_iota_ms"`
+	} `json:"block"`
+	Evidence struct {
+		MaxAgeNumBlocks string `json:"max_age_num_blocks"`
+		MaxAgeDuration  string `json:"max_age_duration"`
+	} `json:"evidence"`
+	Validator struct {
+		PubKeyTypes []string `json:"pub_key_types"`
+	} `json:"validator"`
+}
+
+type GenesisNetworkParams struct {
+	InitialFeePolicy struct {
+		Coefficient int `json:"coefficient"`
+		Constant    int `json:"constant"`
+	} `json:"initial_fee_policy"`
+	JailingConfig struct {
+		BlockSigningWindow   int `json:"block_signing_window"`
+		MissedBlockThreshold int `json:"missed_block_threshold"`
+	} `json:"jailing_config"`
+	MaxValidators            int    `json:"max_validators"`
+	RequiredCouncilNodeStake string `json:"required_council_node_stake"`
+	RewardsConfig            struct {
+		MonetaryExpansionCap   string `json:"monetary_expansion_cap"`
+		MonetaryExpansionDecay int    `json:"monetary_expansion_decay"`
+		MonetaryExpansionR0    int    `json:"monetary_expansion_r0"`
+		MonetaryExpansionTau   int64  `json:"monetary_expansion_tau"`
+		RewardPeriodSeconds    int    `json:"reward_period_seconds"`
+	} `json:"rewards_config"`
+	SlashingConfig struct {
+		ByzantineSlashPercent string `json:"byzantine_slash_percent"`
+		LivenessSlashPercent  string `json:"liveness_slash_percent"`
+	} `json:"slashing_config"`
+	UnbondingPeriod int `json:"unbonding_period"`
+}
+
 type RawGenesisDistributionType string
 
 var (
@@ -75,14 +79,22 @@ var (
 )
 
 type Genesis struct {
-	GenesisTime time.Time
-	ChainID     string
-	AppHash     string
-	AppState    GenesisAppState
+	GenesisTime     time.Time
+	ChainID         string
+	ConsensusParams GenesisConsensusParams
+	Validators      []GenesisValidator
+	AppHash         string
+	AppState        GenesisAppState
+}
+type GenesisValidator struct {
+	// Tendermint address derived from the public key
+	Address string
+	Power   string
 }
 type GenesisAppState struct {
-	CouncilNodes []GenesisCouncilNode
-	Distribution []GenesisDistribution
+	CouncilNodes  []GenesisCouncilNode
+	Distribution  []GenesisDistribution
+	NetworkParams GenesisNetworkParams
 }
 type GenesisCouncilNode struct {
 	StakingAccountAddress string
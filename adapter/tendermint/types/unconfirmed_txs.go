@@ -0,0 +1,12 @@
+package types
+
+type UnconfirmedTxsResp struct {
+	Jsonrpc string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Result  struct {
+		NTxs       string   `json:"n_txs"`
+		Total      string   `json:"total"`
+		TotalBytes string   `json:"total_bytes"`
+		Txs        []string `json:"txs"`
+	} `json:"result"`
+}
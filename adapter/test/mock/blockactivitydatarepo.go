@@ -39,6 +39,10 @@ func (repo *MockRDbBlockActivityDataRepo) InsertUnjailTransaction(tx adapter.RDb
 	args := repo.Called(tx, activity)
 	return args.Error(0)
 }
+func (repo *MockRDbBlockActivityDataRepo) InsertFailedTransaction(tx adapter.RDbTx, activity *chainindex.Activity) error {
+	args := repo.Called(tx, activity)
+	return args.Error(0)
+}
 func (repo *MockRDbBlockActivityDataRepo) InsertRewardEvent(tx adapter.RDbTx, activity *chainindex.Activity) error {
 	args := repo.Called(tx, activity)
 	return args.Error(0)
@@ -6,21 +6,15 @@ package txauxdecoder
 */
 import "C"
 import (
+	"errors"
 	"fmt"
 	"unsafe"
 
 	jsoniter "github.com/json-iterator/go"
 )
 
-func MustDecodeBase64(rawTx string) *DecodedTx {
-	decodedTx, err := DecodeBase64(rawTx)
-	if err != nil {
-		panic(err)
-	}
-
-	return decodedTx
-}
-
+// DecodeBase64 decodes the base64 encoded TxAux. A malformed transaction
+// returns an error instead of aborting the process
 func DecodeBase64(rawTx string) (*DecodedTx, error) {
 	var err error
 
@@ -28,16 +22,36 @@ func DecodeBase64(rawTx string) (*DecodedTx, error) {
 	defer C.free(unsafe.Pointer(arg))
 
 	decodedTxPtr := C.decode_base64(arg)
+	if decodedTxPtr == nil {
+		return nil, errors.New("error decoding tx: decoder returned no result")
+	}
 	defer C.decode_free(decodedTxPtr)
+	decodedTxJSON := C.GoString(decodedTxPtr)
+
+	var decodeErr decodeError
+	if err = jsoniter.UnmarshalFromString(decodedTxJSON, &decodeErr); err != nil {
+		return nil, fmt.Errorf("error deserializing decoded tx: %v", err)
+	}
+	if decodeErr.MaybeError != nil {
+		return nil, fmt.Errorf("error decoding tx: %s", *decodeErr.MaybeError)
+	}
 
 	var decodedTx DecodedTx
-	if err = jsoniter.UnmarshalFromString(C.GoString(decodedTxPtr), &decodedTx); err != nil {
+	if err = jsoniter.UnmarshalFromString(decodedTxJSON, &decodedTx); err != nil {
 		return nil, fmt.Errorf("error deserializing decoded tx: %v", err)
 	}
 	return &decodedTx, nil
 }
 
+// decodeError is returned by the decoder in place of the decoded tx when the
+// transaction is malformed
+type decodeError struct {
+	MaybeError *string `json:"error"`
+}
+
 type DecodedTx struct {
+	// Lowercase hex encoded transaction ID
+	TxID string `json:"txid"`
 	// TODO: Parse TxType to pre-defined constants
 	TxType                string                `json:"tx_type"`
 	Inputs                []DecodedTxInput      `json:"inputs"`
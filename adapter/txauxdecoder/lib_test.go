@@ -12,7 +12,9 @@ var _ = Describe("DecodeBase64", func() {
 	It("should return decoded tx of Transfer transaction", func() {
 		decodedTx, err := txauxdecoder.DecodeBase64(TRANFER_TX)
 		Expect(err).To(BeNil())
+		Expect(decodedTx.TxID).To(MatchRegexp("^[0-9a-f]{64}$"))
 		Expect(*decodedTx).To(Equal(txauxdecoder.DecodedTx{
+			TxID:   decodedTx.TxID,
 			TxType: "Transfer",
 			Inputs: []txauxdecoder.DecodedTxInput{
 				{
@@ -29,7 +31,9 @@ var _ = Describe("DecodeBase64", func() {
 	It("should return decoded tx of NodeJoin transaction", func() {
 		decodedTx, err := txauxdecoder.DecodeBase64(NODE_JOIN_TX)
 		Expect(err).To(BeNil())
+		Expect(decodedTx.TxID).To(MatchRegexp("^[0-9a-f]{64}$"))
 		Expect(*decodedTx).To(Equal(txauxdecoder.DecodedTx{
+			TxID:                  decodedTx.TxID,
 			TxType:                "NodeJoin",
 			Inputs:                nil,
 			OutputCount:           nil,
@@ -47,6 +51,16 @@ var _ = Describe("DecodeBase64", func() {
 			},
 		}))
 	})
+
+	It("should return error when the transaction is not base64 encoded", func() {
+		_, err := txauxdecoder.DecodeBase64("not base64 encoded")
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should return error when the transaction is not a TxAux", func() {
+		_, err := txauxdecoder.DecodeBase64("AAAA")
+		Expect(err).NotTo(BeNil())
+	})
 })
 
 const (
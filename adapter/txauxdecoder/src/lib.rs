@@ -1,15 +1,20 @@
 use base64;
 use chain_core::state::account::{CouncilNode, StakedStateAddress};
 use chain_core::tx::data::input::{TxoPointer, TxoSize};
+use chain_core::tx::data::TxId;
 use chain_core::tx::{TxAux, TxEnclaveAux, TxPublicAux};
 use chain_tx_validation::witness::verify_tx_recover_address;
 use parity_scale_codec::Decode;
 use serde::Serialize;
+use std::any::Any;
 use std::ffi::{CStr, CString};
 use std::os::raw::c_char;
+use std::panic::{self, AssertUnwindSafe};
+use std::ptr;
 
 #[derive(Serialize)]
 struct DecodedTx<'a> {
+    txid: String,
     tx_type: DecodedTxType,
     inputs: Option<&'a Vec<TxoPointer>>,
     output_count: Option<&'a TxoSize>,
@@ -27,39 +32,81 @@ enum DecodedTxType {
     Unjail,
 }
 
+#[derive(Serialize)]
+struct DecodeError {
+    error: String,
+}
+
+/// Decodes the base64 encoded transaction into JSON. A malformed transaction is
+/// returned as a JSON of the error instead of panicking across the FFI
+/// boundary, which would abort the process. A null pointer is returned only
+/// when the error cannot be serialized
 #[no_mangle]
 pub extern "C" fn decode_base64(encoded_tx_ptr: *const c_char) -> *mut c_char {
-    let encoded_tx_cstr = unsafe {
-        assert!(
-            !encoded_tx_ptr.is_null(),
-            "called passed a null pointer argument"
-        );
+    let result = panic::catch_unwind(AssertUnwindSafe(|| decode(encoded_tx_ptr)))
+        .unwrap_or_else(|panic_payload| Err(panic_message(panic_payload)));
 
-        CStr::from_ptr(encoded_tx_ptr)
+    let json = match result {
+        Ok(decoded_tx) => decoded_tx,
+        Err(error) => match serde_json::to_string(&DecodeError { error }) {
+            Ok(json) => json,
+            Err(_) => return ptr::null_mut(),
+        },
     };
 
-    let encoded_tx = encoded_tx_cstr.to_str().unwrap();
+    match CString::new(json) {
+        Ok(json_cstr) => json_cstr.into_raw(),
+        Err(_) => ptr::null_mut(),
+    }
+}
+
+fn decode(encoded_tx_ptr: *const c_char) -> Result<String, String> {
+    if encoded_tx_ptr.is_null() {
+        return Err("called passed a null pointer argument".to_owned());
+    }
+    let encoded_tx_cstr = unsafe { CStr::from_ptr(encoded_tx_ptr) };
+
+    let encoded_tx = encoded_tx_cstr
+        .to_str()
+        .map_err(|err| format!("error reading transaction: {}", err))?;
+
+    let encoded_tx = base64::decode(encoded_tx)
+        .map_err(|err| format!("error base64 decoding transaction: {}", err))?;
+    let tx_aux = TxAux::decode(&mut encoded_tx.as_slice())
+        .map_err(|err| format!("error decoding TxAux: {:?}", err))?;
 
-    let encoded_tx = base64::decode(encoded_tx).expect("error base64 decoding transaction");
-    let tx_aux = TxAux::decode(&mut encoded_tx.as_slice()).expect("error decoding TxAux");
+    let txid = encode_txid(&tx_aux.tx_id());
+    let decoded_tx = decoded_tx_from_tx_aux(txid, &tx_aux)?;
 
-    let decoded_tx = decoded_tx_from_tx_aux(&tx_aux);
+    serde_json::to_string(&decoded_tx)
+        .map_err(|err| format!("error serializing decoded transaction into json: {}", err))
+}
 
-    let decoded_tx = serde_json::to_string(&decoded_tx)
-        .expect("error serializing decoded transaction into json");
-    let decoded_tx_cstr = CString::new(decoded_tx).unwrap();
+fn panic_message(panic_payload: Box<dyn Any + Send>) -> String {
+    if let Some(message) = panic_payload.downcast_ref::<&str>() {
+        format!("panic when decoding transaction: {}", message)
+    } else if let Some(message) = panic_payload.downcast_ref::<String>() {
+        format!("panic when decoding transaction: {}", message)
+    } else {
+        "panic when decoding transaction".to_owned()
+    }
+}
 
-    decoded_tx_cstr.into_raw()
+// Transaction ID is lowercase hex encoded, the same as reported by the valid_txs
+// event
+fn encode_txid(txid: &TxId) -> String {
+    txid.iter().map(|byte| format!("{:02x}", byte)).collect()
 }
 
-fn decoded_tx_from_tx_aux(tx_aux: &TxAux) -> DecodedTx {
-    match tx_aux {
+fn decoded_tx_from_tx_aux(txid: String, tx_aux: &TxAux) -> Result<DecodedTx, String> {
+    let decoded_tx = match tx_aux {
         TxAux::EnclaveTx(enclave_tx) => match enclave_tx {
             TxEnclaveAux::TransferTx {
                 inputs,
                 no_of_outputs,
                 ..
             } => DecodedTx {
+                txid,
                 tx_type: DecodedTxType::Transfer,
                 inputs: Some(inputs),
                 output_count: Some(no_of_outputs),
@@ -67,6 +114,7 @@ fn decoded_tx_from_tx_aux(tx_aux: &TxAux) -> DecodedTx {
                 council_node_meta: None,
             },
             TxEnclaveAux::DepositStakeTx { tx, .. } => DecodedTx {
+                txid,
                 tx_type: DecodedTxType::Deposit,
                 inputs: Some(&tx.inputs),
                 output_count: None,
@@ -79,8 +127,9 @@ fn decoded_tx_from_tx_aux(tx_aux: &TxAux) -> DecodedTx {
                 payload,
             } => {
                 let staked_state_address = verify_tx_recover_address(&witness, &payload.txid)
-                    .expect("error recovering staked state address");
+                    .map_err(|err| format!("error recovering staked state address: {:?}", err))?;
                 DecodedTx {
+                    txid,
                     tx_type: DecodedTxType::Withdraw,
                     inputs: None,
                     output_count: Some(no_of_outputs),
@@ -91,6 +140,7 @@ fn decoded_tx_from_tx_aux(tx_aux: &TxAux) -> DecodedTx {
         },
         TxAux::PublicTx(public_tx) => match public_tx {
             TxPublicAux::UnbondStakeTx(unbond_tx, ..) => DecodedTx {
+                txid,
                 tx_type: DecodedTxType::Unbond,
                 inputs: None,
                 output_count: None,
@@ -98,6 +148,7 @@ fn decoded_tx_from_tx_aux(tx_aux: &TxAux) -> DecodedTx {
                 council_node_meta: None,
             },
             TxPublicAux::NodeJoinTx(node_join_tx, ..) => DecodedTx {
+                txid,
                 tx_type: DecodedTxType::NodeJoin,
                 inputs: None,
                 output_count: None,
@@ -105,6 +156,7 @@ fn decoded_tx_from_tx_aux(tx_aux: &TxAux) -> DecodedTx {
                 council_node_meta: Some(&node_join_tx.node_meta),
             },
             TxPublicAux::UnjailTx(unjail_tx, ..) => DecodedTx {
+                txid,
                 tx_type: DecodedTxType::Unjail,
                 inputs: None,
                 output_count: None,
@@ -112,7 +164,9 @@ fn decoded_tx_from_tx_aux(tx_aux: &TxAux) -> DecodedTx {
                 council_node_meta: None,
             },
         },
-    }
+    };
+
+    Ok(decoded_tx)
 }
 
 #[no_mangle]
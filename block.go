@@ -10,8 +10,13 @@ import (
 type Block struct {
 	Height  uint64
 	Hash    string
-	Time    time.Time
-	AppHash string
+	ChainID string
+	// Hash of the previous block. It is empty in the first block and the
+	// block of a state snapshot
+	LastBlockHash   string
+	Time            time.Time
+	AppHash         string
+	ProposerAddress string
 }
 
 func (block *Block) String() string {
@@ -27,13 +32,42 @@ func (reward *BlockReward) String() string {
 	return render.Render(reward)
 }
 
+// BlockSignature is a signature of the last commit carried by a block. It
+// belongs to the block before the one carrying it
 type BlockSignature struct {
+	// Height of the block being signed
 	BlockHeight        uint64
 	CouncilNodeAddress string
 	Signature          string
-	IsProposer         bool
 }
 
 func (signature *BlockSignature) String() string {
 	return render.Render(signature)
 }
+
+// BlockEvidence is a proof carried by a block of a council node signing two
+// conflicting votes at the same height and round
+type BlockEvidence struct {
+	// Height of the block carrying the evidence
+	BlockHeight uint64
+	// Position of the evidence in the block
+	Position           uint32
+	Type               string
+	CouncilNodeAddress string
+	VoteA              BlockEvidenceVote
+	VoteB              BlockEvidenceVote
+}
+
+func (evidence *BlockEvidence) String() string {
+	return render.Render(evidence)
+}
+
+type BlockEvidenceVote struct {
+	Type           uint8
+	Height         uint64
+	Round          uint64
+	BlockHash      string
+	Timestamp      time.Time
+	ValidatorIndex uint64
+	Signature      string
+}
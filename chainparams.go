@@ -0,0 +1,62 @@
+package chainindex
+
+import (
+	"math/big"
+
+	"github.com/luci/go-render/render"
+)
+
+// ChainParams are the chain-wide parameters declared in the genesis
+type ChainParams struct {
+	ChainID         string
+	NetworkParams   NetworkParams
+	ConsensusParams ConsensusParams
+}
+
+func (params *ChainParams) String() string {
+	return render.Render(params)
+}
+
+type NetworkParams struct {
+	InitialFeePolicy         InitialFeePolicy
+	JailingConfig            JailingConfig
+	MaxValidators            uint64
+	RequiredCouncilNodeStake *big.Int
+	RewardsConfig            RewardsConfig
+	SlashingConfig           SlashingConfig
+	// Unbonding period in seconds
+	UnbondingPeriod uint64
+}
+
+type InitialFeePolicy struct {
+	Coefficient uint64
+	Constant    uint64
+}
+
+type JailingConfig struct {
+	BlockSigningWindow   uint64
+	MissedBlockThreshold uint64
+}
+
+type RewardsConfig struct {
+	MonetaryExpansionCap   *big.Int
+	MonetaryExpansionDecay uint64
+	MonetaryExpansionR0    uint64
+	MonetaryExpansionTau   uint64
+	RewardPeriodSeconds    uint64
+}
+
+type SlashingConfig struct {
+	// Percentages are kept in their decimal string representation (e.g. "0.200")
+	ByzantineSlashPercent string
+	LivenessSlashPercent  string
+}
+
+type ConsensusParams struct {
+	BlockMaxBytes           int64
+	BlockMaxGas             int64
+	BlockTimeIotaMs         int64
+	EvidenceMaxAgeNumBlocks int64
+	EvidenceMaxAgeDuration  int64
+	ValidatorPubKeyTypes    []string
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 
@@ -70,12 +71,41 @@ func (app *App) Run(args []string) error {
 				Usage:   "Postgres schema name",
 				EnvVars: []string{"DB_SCHEMA"},
 			},
+			&cli.StringFlag{
+				Name:    "dbShadowSchema",
+				Usage:   "Postgres schema to build a new index into and swap in once it catches up",
+				EnvVars: []string{"DB_SHADOW_SCHEMA"},
+			},
 
 			&cli.StringFlag{
 				Name:    "tendermintURL",
 				Usage:   "Tendermint HTTP RPC URL",
 				EnvVars: []string{"TENDERMINT_URL"},
 			},
+			&cli.StringFlag{
+				Name:    "tendermintDir",
+				Usage:   "`DIR` of recorded Tendermint genesis, block and block_results responses to read instead of requesting Tendermint",
+				EnvVars: []string{"TENDERMINT_DIR"},
+			},
+			&cli.StringFlag{
+				Name:    "tendermintRecordDir",
+				Usage:   "`DIR` to record Tendermint genesis, block and block_results responses into as fixtures",
+				EnvVars: []string{"TENDERMINT_RECORD_DIR"},
+			},
+			&cli.Uint64Flag{
+				Name:  "recordFromHeight",
+				Usage: "First block `HEIGHT` to record",
+			},
+			&cli.Uint64Flag{
+				Name:  "recordToHeight",
+				Usage: "Last block `HEIGHT` to record",
+			},
+
+			&cli.Uint64Flag{
+				Name:    "untilHeight",
+				Aliases: []string{"until-height"},
+				Usage:   "Exit once the block at `HEIGHT` is stored without serving the HTTP API",
+			},
 		},
 		Action: func(ctx *cli.Context) error {
 			var err error
@@ -85,26 +115,7 @@ func (app *App) Run(args []string) error {
 			}
 
 			configPath := ctx.String("config")
-
-			cliConfig := CLIConfig{
-				LogLevel: parseLogLevel(ctx.String("logLevel")),
-
-				DatabaseHost:     ctx.String("dbHost"),
-				DatabaseUsername: ctx.String("dbUsername"),
-				DatabaseName:     ctx.String("dbName"),
-				DatabaseSchema:   ctx.String("dbSchema"),
-
-				TendermintHTTPRPCURL: ctx.String("tendermintURL"),
-			}
-			if ctx.IsSet("color") {
-				cliConfig.LoggerColor = primptr.Bool(ctx.Bool("color"))
-			}
-			if ctx.IsSet("dbSSL") {
-				cliConfig.DatabaseSSL = primptr.Bool(ctx.Bool("dbSSL"))
-			}
-			if ctx.IsSet("dgPort") {
-				cliConfig.DatabasePort = primptr.Uint32(uint32(ctx.Uint("dbPort")))
-			}
+			cliConfig := parseCLIConfig(ctx)
 
 			serverApp, err := NewServer(configPath, &cliConfig)
 			if err != nil {
@@ -117,6 +128,116 @@ func (app *App) Run(args []string) error {
 
 			return nil
 		},
+		Commands: []*cli.Command{
+			{
+				Name:  "bootstrap",
+				Usage: "Seed an empty index with a trusted state snapshot to start indexing from its height",
+				Description: "Staking accounts and council nodes are seeded from the JSON export of the state at the " +
+					"snapshot height, and synchronization starts from the next height. History before the snapshot " +
+					"height is unavailable. It allows indexing against pruned nodes which no longer serve the blocks " +
+					"from genesis.",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "snapshot",
+						Usage:    "JSON `FILE` of the state snapshot",
+						Required: true,
+					},
+					&cli.BoolFlag{
+						Name:  "skipGenesis",
+						Usage: "Do not request the genesis from Tendermint. Chain params are not stored",
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					var err error
+
+					if args := ctx.Args(); args.Len() > 0 {
+						return fmt.Errorf("Unexpected arguments: %q", args.Get(0))
+					}
+
+					configPath := ctx.String("config")
+					cliConfig := parseCLIConfig(ctx)
+
+					bootstrapCommand, err := NewBootstrapCommand(configPath, &cliConfig)
+					if err != nil {
+						return fmt.Errorf("error creating bootstrap command: %v", err)
+					}
+
+					if err = bootstrapCommand.Run(BootstrapCommandParams{
+						SnapshotPath: ctx.String("snapshot"),
+						SkipGenesis:  ctx.Bool("skipGenesis"),
+					}); err != nil {
+						return fmt.Errorf("error bootstrapping: %v", err)
+					}
+
+					return nil
+				},
+			},
+			{
+				Name:  "reindex",
+				Usage: "Rebuild stored blocks and projections by replaying blocks from Tendermint or the raw block archive",
+				Description: "Stored blocks from --from onward are removed, with activities, transaction outputs, rewards, " +
+					"commits and power changes, staking accounts and council nodes are rolled back to the height before, " +
+					"and the blocks are replayed up to --to. As staking accounts and council nodes are running totals, " +
+					"--to cannot be before the last stored height. A --from height after the last stored height " +
+					"resumes an interrupted reindex.",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "all",
+						Usage: "Reindex all stored blocks, same as --from 1 --to the last stored height",
+					},
+					&cli.Uint64Flag{
+						Name:        "from",
+						Usage:       "First block `HEIGHT` to replay. Stored blocks from it onward are removed beforehand",
+						DefaultText: "height after the last stored height",
+					},
+					&cli.Uint64Flag{
+						Name:        "to",
+						Usage:       "Last block `HEIGHT` to replay. Cannot be before the last stored height",
+						DefaultText: "last stored height, or latest Tendermint height when resuming",
+					},
+					&cli.BoolFlag{
+						Name:  "fromArchive",
+						Usage: "Read blocks from the raw block archive instead of Tendermint",
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					var err error
+
+					if args := ctx.Args(); args.Len() > 0 {
+						return fmt.Errorf("Unexpected arguments: %q", args.Get(0))
+					}
+					if ctx.Bool("all") && (ctx.IsSet("from") || ctx.IsSet("to")) {
+						return errors.New("--all cannot be used together with --from or --to")
+					}
+
+					configPath := ctx.String("config")
+					cliConfig := parseCLIConfig(ctx)
+
+					reindexCommand, err := NewReindexCommand(configPath, &cliConfig)
+					if err != nil {
+						return fmt.Errorf("error creating reindex command: %v", err)
+					}
+
+					params := ReindexCommandParams{
+						All:             ctx.Bool("all"),
+						MaybeFromHeight: nil,
+						MaybeToHeight:   nil,
+						FromArchive:     ctx.Bool("fromArchive"),
+					}
+					if ctx.IsSet("from") {
+						params.MaybeFromHeight = primptr.Uint64(ctx.Uint64("from"))
+					}
+					if ctx.IsSet("to") {
+						params.MaybeToHeight = primptr.Uint64(ctx.Uint64("to"))
+					}
+					if err = reindexCommand.Run(params); err != nil {
+						return fmt.Errorf("error reindexing: %v", err)
+					}
+
+					return nil
+				},
+			},
+		},
 	}
 
 	err := cliApp.Run(args)
@@ -127,6 +248,44 @@ func (app *App) Run(args []string) error {
 	return nil
 }
 
+func parseCLIConfig(ctx *cli.Context) CLIConfig {
+	cliConfig := CLIConfig{
+		LogLevel: parseLogLevel(ctx.String("logLevel")),
+
+		DatabaseHost:     ctx.String("dbHost"),
+		DatabaseUsername: ctx.String("dbUsername"),
+		DatabaseName:     ctx.String("dbName"),
+		DatabaseSchema:   ctx.String("dbSchema"),
+
+		DatabaseShadowSchema: ctx.String("dbShadowSchema"),
+
+		TendermintHTTPRPCURL: ctx.String("tendermintURL"),
+		TendermintDir:        ctx.String("tendermintDir"),
+
+		TendermintRecordDir: ctx.String("tendermintRecordDir"),
+	}
+	if ctx.IsSet("color") {
+		cliConfig.LoggerColor = primptr.Bool(ctx.Bool("color"))
+	}
+	if ctx.IsSet("dbSSL") {
+		cliConfig.DatabaseSSL = primptr.Bool(ctx.Bool("dbSSL"))
+	}
+	if ctx.IsSet("dgPort") {
+		cliConfig.DatabasePort = primptr.Uint32(uint32(ctx.Uint("dbPort")))
+	}
+	if ctx.IsSet("recordFromHeight") {
+		cliConfig.TendermintRecordFromHeight = primptr.Uint64(ctx.Uint64("recordFromHeight"))
+	}
+	if ctx.IsSet("recordToHeight") {
+		cliConfig.TendermintRecordToHeight = primptr.Uint64(ctx.Uint64("recordToHeight"))
+	}
+	if ctx.IsSet("untilHeight") {
+		cliConfig.SyncStopHeight = primptr.Uint64(ctx.Uint64("untilHeight"))
+	}
+
+	return cliConfig
+}
+
 func parseLogLevel(level string) usecase.LogLevel {
 	switch level {
 	case "panic":
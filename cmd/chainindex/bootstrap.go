@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/crypto-com/chainindex/adapter"
+	tenderminttypes "github.com/crypto-com/chainindex/adapter/tendermint/types"
+	"github.com/crypto-com/chainindex/infrastructure"
+)
+
+type BootstrapCommand struct {
+	*ServerContext
+}
+
+type BootstrapCommandParams struct {
+	SnapshotPath string
+	// Chain params are parsed from the genesis served by Tendermint unless it
+	// is skipped
+	SkipGenesis bool
+}
+
+func NewBootstrapCommand(configPath string, cliConfig *CLIConfig) (*BootstrapCommand, error) {
+	serverContext, err := NewContextFromConfigFile(configPath, cliConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BootstrapCommand{
+		ServerContext: serverContext,
+	}, nil
+}
+
+// Run seeds an empty index with the state snapshot. The server started
+// afterwards synchronizes from the height after the snapshot height
+func (command *BootstrapCommand) Run(params BootstrapCommandParams) error {
+	snapshotJSON, err := ioutil.ReadFile(params.SnapshotPath)
+	if err != nil {
+		return fmt.Errorf("error reading state snapshot: %v", err)
+	}
+
+	pgxConnPool, err := command.newPgxConnPool(command.config.Database.Schema)
+	if err != nil {
+		return err
+	}
+	defer pgxConnPool.Close()
+	rDbConn := infrastructure.NewPgxRDbConn(pgxConnPool)
+	rDBTypeConv := new(infrastructure.PgxRDbTypeConv)
+
+	var genesis *tenderminttypes.Genesis
+	if !params.SkipGenesis {
+		if genesis, err = command.getTendermintClient(rDbConn).Genesis(); err != nil {
+			return fmt.Errorf("error getting genesis for chain params, skip it with --skipGenesis: %v", err)
+		}
+	}
+
+	snapshot, err := adapter.ParseStateSnapshot(snapshotJSON, genesis)
+	if err != nil {
+		return err
+	}
+	snapshot.Source = params.SnapshotPath
+
+	blockActivityDataRepo := adapter.NewDefaultRDbBlockActivityDataRepo(infrastructure.PostgresStmtBuilder, rDBTypeConv)
+	blockDataRepo := adapter.NewRDbBlockDataRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv, blockActivityDataRepo)
+	indexBootstrapRepo := adapter.NewRDbIndexBootstrapRepo(
+		rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv, blockDataRepo,
+	)
+	if err = indexBootstrapRepo.Bootstrap(snapshot, time.Now()); err != nil {
+		return err
+	}
+	command.logger.Infof(
+		"bootstrapped index from state snapshot at height %d with %d staking accounts, synchronization starts from height %d",
+		snapshot.Block.Height, len(snapshot.StakingAccounts), snapshot.Block.Height+1,
+	)
+
+	return nil
+}
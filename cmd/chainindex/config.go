@@ -37,10 +37,31 @@ func (config *Config) OverrideByCLIConfig(cliConfig *CLIConfig) {
 	if cliConfig.DatabaseSchema != "" {
 		config.Database.Schema = cliConfig.DatabaseSchema
 	}
+	if cliConfig.DatabaseShadowSchema != "" {
+		config.Database.ShadowSchema = cliConfig.DatabaseShadowSchema
+	}
 	config.Database.Password = os.Getenv("DB_PASSWORD")
 
 	if cliConfig.TendermintHTTPRPCURL != "" {
+		// Requests go to the CLI provided endpoint only
 		config.Tendermint.URL = cliConfig.TendermintHTTPRPCURL
+		config.Tendermint.URLs = nil
+	}
+	if cliConfig.TendermintDir != "" {
+		config.Tendermint.Dir = cliConfig.TendermintDir
+	}
+	if cliConfig.TendermintRecordDir != "" {
+		config.Tendermint.RecordDir = cliConfig.TendermintRecordDir
+	}
+	if cliConfig.TendermintRecordFromHeight != nil {
+		config.Tendermint.RecordFromHeight = *cliConfig.TendermintRecordFromHeight
+	}
+	if cliConfig.TendermintRecordToHeight != nil {
+		config.Tendermint.RecordToHeight = *cliConfig.TendermintRecordToHeight
+	}
+
+	if cliConfig.SyncStopHeight != nil {
+		config.Synchronization.StopHeight = *cliConfig.SyncStopHeight
 	}
 }
 
@@ -51,6 +72,7 @@ type FileConfig struct {
 	Database        DatabaseConfig
 	Synchronization SyncConfig
 	Postgres        PostgresConfig
+	Archive         ArchiveConfig
 }
 
 type HTTPAPIConfig struct {
@@ -58,6 +80,9 @@ type HTTPAPIConfig struct {
 	WriteTimeout     duration `toml:"write_timeout"`
 	ReadTimeout      duration `toml:"read_timeout"`
 	IdleTimeout      duration `toml:"idle_timeout"`
+	ShutdownTimeout  duration `toml:"shutdown_timeout"`
+	// Serve admin endpoints under /admin
+	AdminEnabled bool `toml:"admin_enabled"`
 }
 
 type LoggerConfig struct {
@@ -66,6 +91,35 @@ type LoggerConfig struct {
 
 type TendermintConfig struct {
 	URL string `toml:"http_rpc_url"`
+	// Additional endpoints to spread requests across together with URL
+	URLs                []string `toml:"http_rpc_urls"`
+	HTTPTimeout         duration `toml:"http_timeout"`
+	HealthCheckInterval duration `toml:"health_check_interval"`
+	MaxLagBlocks        uint64   `toml:"max_lag_blocks"`
+	// Read recorded responses from this directory instead of requesting
+	// Tendermint endpoints
+	Dir string `toml:"dir"`
+	// Record responses into this directory in the same layout as Dir. Blocks
+	// are only recorded within the height range, which is unbounded on the
+	// side which is 0
+	RecordDir        string `toml:"record_dir"`
+	RecordFromHeight uint64 `toml:"record_from_height"`
+	RecordToHeight   uint64 `toml:"record_to_height"`
+}
+
+// ServerURLs returns all configured endpoints without duplicates
+func (config *TendermintConfig) ServerURLs() []string {
+	serverURLs := make([]string, 0, len(config.URLs)+1)
+	seen := make(map[string]bool)
+	for _, serverURL := range append([]string{config.URL}, config.URLs...) {
+		if serverURL == "" || seen[serverURL] {
+			continue
+		}
+		seen[serverURL] = true
+		serverURLs = append(serverURLs, serverURL)
+	}
+
+	return serverURLs
 }
 
 type DatabaseConfig struct {
@@ -76,15 +130,46 @@ type DatabaseConfig struct {
 	Password string
 	Name     string `toml:"name"`
 	Schema   string `toml:"schema"`
+	// Build a new index into this schema and swap it in once it catches up
+	ShadowSchema string `toml:"shadow_schema"`
 }
 
 type SyncConfig struct {
-	BlockDataChSize            uint     `toml:"block_data_channel_size"`
-	BlockHeightPollingInterval duration `toml:"block_height_polling_interval"`
-	BlockHeightChSize          uint     `toml:"block_height_channel_size"`
-	MaxConcurrentBlockWorker   uint     `toml:"max_concurrent_block_worker"`
+	BlockDataChSize              uint     `toml:"block_data_channel_size"`
+	BlocksFeed                   string   `toml:"blocks_feed"`
+	BlockHeightPollingInterval   duration `toml:"block_height_polling_interval"`
+	WebSocketReconnectMinBackoff duration `toml:"websocket_reconnect_min_backoff"`
+	WebSocketReconnectMaxBackoff duration `toml:"websocket_reconnect_max_backoff"`
+	WebSocketReadTimeout         duration `toml:"websocket_read_timeout"`
+	BlockHeightChSize            uint     `toml:"block_height_channel_size"`
+	MaxConcurrentBlockWorker     uint     `toml:"max_concurrent_block_worker"`
+	MinConcurrentBlockWorker     uint     `toml:"min_concurrent_block_worker"`
+	AdaptiveConcurrency          bool     `toml:"adaptive_concurrency"`
+	TargetBlockFetchLatency      duration `toml:"target_block_fetch_latency"`
+	MaxBlockFetchErrorRate       float64  `toml:"max_block_fetch_error_rate"`
+	BlockRetryInitialBackoff     duration `toml:"block_retry_initial_backoff"`
+	BlockRetryMaxBackoff         duration `toml:"block_retry_max_backoff"`
+	BlockRetryBackoffJitter      float64  `toml:"block_retry_backoff_jitter"`
+	StoreBatchSize               uint     `toml:"store_batch_size"`
+	StoreBatchMaxLatency         duration `toml:"store_batch_max_latency"`
+	StoreBatchThreshold          uint64   `toml:"store_batch_threshold"`
+	// Interval between each polling of Tendermint mempool for pending
+	// transactions
+	PendingTransactionPollingInterval duration `toml:"pending_transaction_polling_interval"`
+	// What to do on a block failing deterministically: "halt" or "continue"
+	OnBlockError string `toml:"on_block_error"`
+	// Exit once the block at this height is stored without serving the HTTP
+	// API. Synchronization is unbounded when it is 0
+	StopHeight uint64 `toml:"stop_height"`
 }
 
+const DEFAULT_DATABASE_SCHEMA = "public"
+
+const (
+	BLOCKS_FEED_POLLING   = "polling"
+	BLOCKS_FEED_WEBSOCKET = "websocket"
+)
+
 type PostgresConfig struct {
 	MaxConns            int32    `toml:"pool_max_conns"`
 	MinConns            int32    `toml:"pool_min_conns"`
@@ -93,6 +178,11 @@ type PostgresConfig struct {
 	HealthCheckInterval duration `toml:"pool_health_check_interval"`
 }
 
+type ArchiveConfig struct {
+	Enabled         bool `toml:"enabled"`
+	SyncFromArchive bool `toml:"sync_from_archive"`
+}
+
 type duration struct {
 	time.Duration
 }
@@ -114,5 +204,14 @@ type CLIConfig struct {
 	DatabaseName     string
 	DatabaseSchema   string
 
+	DatabaseShadowSchema string
+
 	TendermintHTTPRPCURL string
+	TendermintDir        string
+
+	TendermintRecordDir        string
+	TendermintRecordFromHeight *uint64
+	TendermintRecordToHeight   *uint64
+
+	SyncStopHeight *uint64
 }
@@ -1,15 +1,29 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/crypto-com/chainindex/adapter"
+	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
 	"github.com/crypto-com/chainindex/infrastructure"
+	"github.com/crypto-com/chainindex/infrastructure/tendermint"
+	"github.com/crypto-com/chainindex/internal/filereader/toml"
 	"github.com/crypto-com/chainindex/usecase"
 )
 
 type ServerContext struct {
-	logger usecase.Logger
-	config *Config
+	logger  usecase.Logger
+	metrics *infrastructure.PrometheusMetrics
+	config  *Config
+
+	// Created on first use and shared by all Tendermint clients
+	tendermintClientPool *tendermint.ClientPool
 }
 
 func NewContext(config *Config) *ServerContext {
@@ -22,6 +36,147 @@ func NewContext(config *Config) *ServerContext {
 
 	return &ServerContext{
 		logger,
+		infrastructure.NewPrometheusMetrics(),
 		config,
+
+		nil,
+	}
+}
+
+// NewContextFromConfigFile reads the TOML config file, overrides it by CLI
+// config and creates the context
+func NewContextFromConfigFile(configPath string, cliConfig *CLIConfig) (*ServerContext, error) {
+	configReader, configFileErr := toml.FromFile(configPath)
+	if configFileErr != nil {
+		return nil, configFileErr
+	}
+
+	var config Config
+	readConfigErr := configReader.Read(&config)
+	if readConfigErr != nil {
+		return nil, readConfigErr
+	}
+	config.OverrideByCLIConfig(cliConfig)
+
+	serverContext := NewContext(&config)
+	serverContext.logger.SetLogLevel(cliConfig.LogLevel)
+
+	return serverContext, nil
+}
+
+// newPgxConnPool creates a connection pool to the database with search_path
+// set to the provided schema
+func (serverContext *ServerContext) newPgxConnPool(schema string) (*pgxpool.Pool, error) {
+	pgxConnPool, err := infrastructure.NewPgxConnPool(infrastructure.PgxConnPoolConfig{
+		PgxConnConfig: infrastructure.PgxConnConfig{
+			Host:     serverContext.config.Database.Host,
+			Port:     serverContext.config.Database.Port,
+			Username: serverContext.config.Database.Username,
+			Password: serverContext.config.Database.Password,
+			Database: serverContext.config.Database.Name,
+			Schema:   schema,
+			SSL:      serverContext.config.Database.SSL,
+		},
+		MaxConns:          serverContext.config.Postgres.MaxConns,
+		MinConns:          serverContext.config.Postgres.MinConns,
+		MaxConnLifeTime:   serverContext.config.Postgres.MaxConnLifeTime.Duration,
+		MaxConnIdleTime:   serverContext.config.Postgres.MaxConnIdleTime.Duration,
+		HealthCheckPeriod: serverContext.config.Postgres.HealthCheckInterval.Duration,
+	}, serverContext.logger)
+	if err != nil {
+		return nil, fmt.Errorf("error creating connection pool to Postgres: %v", err)
+	}
+
+	return pgxConnPool, nil
+}
+
+func (serverContext *ServerContext) cancelOnSignal(ctx context.Context, cancel context.CancelFunc) {
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signalCh)
+
+	select {
+	case <-ctx.Done():
+	case sig := <-signalCh:
+		serverContext.logger.Infof("received %s signal, gracefully shutting down", sig)
+		cancel()
+	}
+}
+
+// getTendermintClient returns the client to request Tendermint responses from.
+// Depending on the archive config, responses are read from the raw block
+// archive or archived before being parsed
+func (serverContext *ServerContext) getTendermintClient(rDbConn adapter.RDbConn) tendermintadapter.Client {
+	archiveConfig := serverContext.config.Archive
+	if archiveConfig.SyncFromArchive {
+		serverContext.logger.Info("reading blocks from raw block archive")
+		return tendermint.NewArchiveClient(adapter.NewRDbRawBlockArchive(rDbConn, infrastructure.PostgresStmtBuilder))
 	}
+
+	tendermintClient := serverContext.getTendermintRawClient()
+	if !archiveConfig.Enabled {
+		return tendermintClient
+	}
+
+	return tendermint.NewArchivingClient(
+		tendermintClient,
+		adapter.NewRDbRawBlockArchive(rDbConn, infrastructure.PostgresStmtBuilder),
+	)
+}
+
+type tendermintRawClient interface {
+	tendermintadapter.Client
+	tendermintadapter.RawClient
+}
+
+// getTendermintRawClient returns the client requesting Tendermint endpoints,
+// or reading recorded responses when the Tendermint directory is configured.
+// Responses are recorded into the record directory when it is configured
+func (serverContext *ServerContext) getTendermintRawClient() tendermintRawClient {
+	tendermintConfig := serverContext.config.Tendermint
+
+	var tendermintClient tendermintRawClient
+	if tendermintConfig.Dir != "" {
+		serverContext.logger.Infof("reading blocks from directory %s", tendermintConfig.Dir)
+		tendermintClient = tendermint.NewFileClient(tendermintConfig.Dir)
+	} else {
+		tendermintClient = serverContext.getTendermintClientPool()
+	}
+
+	if tendermintConfig.RecordDir != "" {
+		serverContext.logger.Infof("recording Tendermint responses into directory %s", tendermintConfig.RecordDir)
+		tendermintClient = tendermint.NewRecordingClient(
+			tendermintClient,
+			tendermintConfig.RecordDir,
+			tendermint.RecordingClientOptions{
+				FromHeight: tendermintConfig.RecordFromHeight,
+				ToHeight:   tendermintConfig.RecordToHeight,
+			},
+		)
+	}
+
+	return tendermintClient
+}
+
+// getTendermintClientPool returns the pool of all configured Tendermint RPC
+// endpoints. Endpoints are only health-checked when the pool is run
+func (serverContext *ServerContext) getTendermintClientPool() *tendermint.ClientPool {
+	if serverContext.tendermintClientPool != nil {
+		return serverContext.tendermintClientPool
+	}
+
+	tendermintConfig := serverContext.config.Tendermint
+	serverContext.tendermintClientPool = tendermint.NewClientPool(
+		serverContext.logger,
+		serverContext.metrics,
+		tendermintConfig.ServerURLs(),
+		tendermint.HTTPClientOptions{
+			Timeout: tendermintConfig.HTTPTimeout.Duration,
+		},
+		tendermint.ClientPoolOptions{
+			HealthCheckInterval: tendermintConfig.HealthCheckInterval.Duration,
+			MaxLagBlocks:        tendermintConfig.MaxLagBlocks,
+		},
+	)
+	return serverContext.tendermintClientPool
 }
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/crypto-com/chainindex/adapter"
+	"github.com/crypto-com/chainindex/adapter/rdbviewrepo"
+	"github.com/crypto-com/chainindex/adapter/syncservice"
+	"github.com/crypto-com/chainindex/infrastructure"
+)
+
+type ReindexCommand struct {
+	*ServerContext
+}
+
+type ReindexCommandParams struct {
+	// Rebuild all stored blocks from height 1, same as from height 1 to the
+	// last stored height
+	All             bool
+	MaybeFromHeight *uint64
+	MaybeToHeight   *uint64
+	FromArchive     bool
+}
+
+func NewReindexCommand(configPath string, cliConfig *CLIConfig) (*ReindexCommand, error) {
+	serverContext, err := NewContextFromConfigFile(configPath, cliConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReindexCommand{
+		ServerContext: serverContext,
+	}, nil
+}
+
+// Run replays blocks through the parser and block data repository until all
+// of them are stored or SIGINT or SIGTERM is received
+func (command *ReindexCommand) Run(params ReindexCommandParams) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go command.cancelOnSignal(ctx, cancel)
+
+	if params.FromArchive {
+		command.config.Archive.SyncFromArchive = true
+	}
+
+	pgxConnPool, err := command.newPgxConnPool(command.config.Database.Schema)
+	if err != nil {
+		return err
+	}
+	defer pgxConnPool.Close()
+	rDbConn := infrastructure.NewPgxRDbConn(pgxConnPool)
+	rDBTypeConv := new(infrastructure.PgxRDbTypeConv)
+
+	tendermintClient := command.getTendermintClient(rDbConn)
+
+	blockActivityDataRepo := adapter.NewDefaultRDbBlockActivityDataRepo(infrastructure.PostgresStmtBuilder, rDBTypeConv)
+	blockDataRepo := adapter.NewRDbBlockDataRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv, blockActivityDataRepo)
+	blockViewRepo := rdbviewrepo.NewRDbBlockViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
+	indexBootstrapViewRepo := rdbviewrepo.NewRDbIndexBootstrapViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
+
+	lastStoredHeight, err := blockViewRepo.LatestBlockHeight()
+	if err != nil {
+		return fmt.Errorf("error getting last stored height: %v", err)
+	}
+
+	// Stored blocks from the from height onward are removed and replayed in
+	// place. Reindex after the last stored height resumes the synchronization
+	var fromHeight, toHeight uint64
+	if params.All {
+		if lastStoredHeight == uint64(0) {
+			return errors.New("reindex of all blocks is not supported: no block is stored yet")
+		}
+		fromHeight = uint64(1)
+	} else if params.MaybeFromHeight != nil {
+		fromHeight = *params.MaybeFromHeight
+	} else {
+		fromHeight = lastStoredHeight + 1
+	}
+	if fromHeight == uint64(0) {
+		return errors.New("reindex from height 0 is not supported: blocks start from height 1")
+	}
+	if fromHeight > lastStoredHeight+1 {
+		return fmt.Errorf(
+			"reindex from height %d is not supported: blocks up to height %d are not stored, reindex from height %d instead",
+			fromHeight, fromHeight-1, lastStoredHeight+1,
+		)
+	}
+
+	removeStoredBlocks := fromHeight <= lastStoredHeight
+	if removeStoredBlocks {
+		indexBootstrap, findErr := indexBootstrapViewRepo.Find()
+		if findErr == nil {
+			return fmt.Errorf(
+				"reindex of stored blocks is not supported: index is bootstrapped from a state snapshot at height %d, "+
+					"which cannot be rebuilt from the blocks, resume it from height %d instead",
+				indexBootstrap.Height, lastStoredHeight+1,
+			)
+		}
+		if findErr != adapter.ErrNotFound {
+			return fmt.Errorf("error finding index bootstrap: %v", findErr)
+		}
+
+		// Staking accounts and council nodes are running totals, so the
+		// stored blocks after the range are removed as well
+		toHeight = lastStoredHeight
+		if params.MaybeToHeight != nil {
+			if *params.MaybeToHeight < lastStoredHeight {
+				return fmt.Errorf(
+					"reindex to height %d is not supported: stored blocks up to height %d would be removed, "+
+						"reindex to height %d or later instead",
+					*params.MaybeToHeight, lastStoredHeight, lastStoredHeight,
+				)
+			}
+			toHeight = *params.MaybeToHeight
+		}
+	} else if params.MaybeToHeight != nil {
+		toHeight = *params.MaybeToHeight
+	} else if toHeight, err = tendermintClient.LatestBlockHeight(); err != nil {
+		return fmt.Errorf("error getting latest block height: %v", err)
+	}
+
+	reindexer := syncservice.NewReindexer(
+		command.logger,
+		tendermintClient,
+		blockDataRepo,
+		syncservice.ReindexerOptions{
+			BatchSize: int(command.config.Synchronization.StoreBatchSize),
+		},
+	)
+	reindexedHeight, err := reindexer.Run(ctx, syncservice.ReindexParams{
+		FromHeight:         fromHeight,
+		ToHeight:           toHeight,
+		RemoveStoredBlocks: removeStoredBlocks,
+	})
+	if err != nil {
+		if reindexedHeight >= fromHeight {
+			command.logger.Errorf(
+				"reindex stopped after height %d, resume it with --from %d --to %d",
+				reindexedHeight, reindexedHeight+1, toHeight,
+			)
+		}
+		return err
+	}
+	command.logger.Infof("reindexed blocks from height %d to %d", fromHeight, reindexedHeight)
+
+	return nil
+}
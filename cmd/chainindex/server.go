@@ -1,8 +1,12 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
+	"github.com/jackc/pgx/v4/pgxpool"
+
 	"github.com/crypto-com/chainindex/adapter"
 	httpapiadapter "github.com/crypto-com/chainindex/adapter/httpapi"
 	"github.com/crypto-com/chainindex/adapter/rdbviewrepo"
@@ -11,7 +15,6 @@ import (
 	"github.com/crypto-com/chainindex/infrastructure"
 	"github.com/crypto-com/chainindex/infrastructure/httpapi"
 	"github.com/crypto-com/chainindex/infrastructure/tendermint"
-	"github.com/crypto-com/chainindex/internal/filereader/toml"
 	"github.com/crypto-com/chainindex/usecase"
 	"github.com/crypto-com/chainindex/usecase/viewrepo"
 )
@@ -21,48 +24,45 @@ type Server struct {
 }
 
 func NewServer(configPath string, cliConfig *CLIConfig) (*Server, error) {
-	configReader, configFileErr := toml.FromFile(configPath)
-	if configFileErr != nil {
-		return nil, configFileErr
-	}
-
-	var config Config
-	readConfigErr := configReader.Read(&config)
-	if readConfigErr != nil {
-		return nil, readConfigErr
+	serverContext, err := NewContextFromConfigFile(configPath, cliConfig)
+	if err != nil {
+		return nil, err
 	}
-	config.OverrideByCLIConfig(cliConfig)
-
-	context := NewContext(&config)
-	context.logger.SetLogLevel(cliConfig.LogLevel)
 
 	return &Server{
-		ServerContext: context,
+		ServerContext: serverContext,
 	}, nil
 }
 
+// Run the sync service and HTTP API server until SIGINT or SIGTERM is received
+// or any of them stops. It returns the error causing the shutdown
 func (server *Server) Run() error {
-	tendermintClient := tendermint.NewHTTPClient(server.config.Tendermint.URL)
-
-	pgxConnPool, err := infrastructure.NewPgxConnPool(infrastructure.PgxConnPoolConfig{
-		PgxConnConfig: infrastructure.PgxConnConfig{
-			Host:     server.config.Database.Host,
-			Port:     server.config.Database.Port,
-			Username: server.config.Database.Username,
-			Password: server.config.Database.Password,
-			Database: server.config.Database.Name,
-			SSL:      server.config.Database.SSL,
-		},
-		MaxConns:          server.config.Postgres.MaxConns,
-		MinConns:          server.config.Postgres.MinConns,
-		MaxConnLifeTime:   server.config.Postgres.MaxConnLifeTime.Duration,
-		MaxConnIdleTime:   server.config.Postgres.MaxConnIdleTime.Duration,
-		HealthCheckPeriod: server.config.Postgres.HealthCheckInterval.Duration,
-	}, server.logger)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go server.cancelOnSignal(ctx, cancel)
+
+	// Connection pools are created on demand when swapping in a shadow schema,
+	// all of them are closed on exit
+	pgxConnPools := make([]*pgxpool.Pool, 0)
+	defer func() {
+		for _, pgxConnPool := range pgxConnPools {
+			pgxConnPool.Close()
+		}
+	}()
+	newRDbConn := func(schema string) (adapter.RDbConn, error) {
+		pgxConnPool, err := server.newPgxConnPool(schema)
+		if err != nil {
+			return nil, err
+		}
+		pgxConnPools = append(pgxConnPools, pgxConnPool)
+		server.metrics.RegisterPgxConnPool(schema, pgxConnPool)
+		return infrastructure.NewPgxRDbConn(pgxConnPool), nil
+	}
+
+	rDbConn, err := newRDbConn(server.config.Database.Schema)
 	if err != nil {
-		return fmt.Errorf("error creating connection pool to Postgres: %v", err)
+		return err
 	}
-	rDbConn := infrastructure.NewPgxRDbConn(pgxConnPool)
 	rDBTypeConv := new(infrastructure.PgxRDbTypeConv)
 
 	_, err = rDbConn.Exec("SELECT 1")
@@ -70,62 +70,259 @@ func (server *Server) Run() error {
 		server.logger.Panicf("error connecting to Database: %v", err)
 	}
 
-	blockActivityDataRepo := adapter.NewDefaultRDbBlockActivityDataRepo(infrastructure.PostgresStmtBuilder, rDBTypeConv)
-	blockDataRepo := adapter.NewRDbBlockDataRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv, blockActivityDataRepo)
+	stopHeight := server.config.Synchronization.StopHeight
+	if stopHeight != 0 && server.config.Database.ShadowSchema != "" {
+		return errors.New("stop height cannot be used together with shadow schema")
+	}
+
+	var syncService usecase.SyncService
+	if server.config.Database.ShadowSchema == "" {
+		tendermintClient := server.getTendermintClient(rDbConn)
+		syncService = server.getDefaultSyncService(
+			server.metrics.WithLabels(usecase.MetricLabels{"index": "live"}),
+			tendermintClient,
+			server.newBlockDataRepo(rDbConn),
+			server.newQuarantinedBlockRepo(rDbConn),
+			server.newSyncStateRepo(rDbConn),
+			rdbviewrepo.NewRDbSyncStateViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv),
+		)
+	} else {
+		// View repositories follow the live schema when the shadow schema is
+		// swapped in
+		switchableRDbConn := adapter.NewSwitchableRDbConn(rDbConn)
+		rDbConn = switchableRDbConn
+		syncService, err = server.getShadowSyncService(switchableRDbConn, newRDbConn)
+		if err != nil {
+			return err
+		}
+	}
+
+	if stopHeight != 0 {
+		return server.syncUntilStopHeight(ctx, syncService, stopHeight)
+	}
+
 	blockViewRepo := rdbviewrepo.NewRDbBlockViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
 	activityViewRepo := rdbviewrepo.NewRDbActivityViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
 	councilNodeViewRepo := rdbviewrepo.NewRDbCouncilNodeViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
 	rewardViewRepo := rdbviewrepo.NewRDbRewardViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
 	stakingAccountViewRepo := rdbviewrepo.NewRDbStkaingAccountViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
+	chainParamsViewRepo := rdbviewrepo.NewRDbChainParamsViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
+	quarantinedBlockViewRepo := rdbviewrepo.NewRDbQuarantinedBlockViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
+	indexBootstrapViewRepo := rdbviewrepo.NewRDbIndexBootstrapViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
+	syncStateViewRepo := rdbviewrepo.NewRDbSyncStateViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
+	pendingTransactionViewRepo := rdbviewrepo.NewRDbPendingTransactionViewRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
+
+	// Retried blocks are stored into the live schema
+	quarantinedBlockRetrier := syncservice.NewDefaultQuarantinedBlockRetrier(
+		server.logger,
+		server.getTendermintClient(rDbConn),
+		server.newBlockDataRepo(rDbConn),
+		blockViewRepo,
+		syncservice.NewBlockQuarantiner(
+			server.logger,
+			server.metrics.WithLabels(usecase.MetricLabels{"index": "live"}),
+			server.newQuarantinedBlockRepo(rDbConn),
+			server.newSyncStateRepo(rDbConn),
+			server.config.Synchronization.OnBlockError,
+		),
+	)
 
-	// TODO: channel to receive error instead of exit
-	onExitCh := make(chan bool)
+	server.metrics.RegisterSyncStatus(syncService)
+
+	// Health checks are only needed when requests go to Tendermint endpoints
+	var tendermintEndpoints tendermintadapter.EndpointStatusProvider
+	if !server.config.Archive.SyncFromArchive && server.config.Tendermint.Dir == "" {
+		tendermintClientPool := server.getTendermintClientPool()
+		go tendermintClientPool.Run(ctx)
+		tendermintEndpoints = tendermintClientPool
+
+		// Archive and recorded responses have no mempool to watch
+		pendingTransactionWatcher := syncservice.NewPendingTransactionWatcher(
+			server.logger,
+			server.getTendermintClient(rDbConn),
+			adapter.NewRDbPendingTransactionRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv),
+			adapter.ParsePendingTransaction,
+			syncservice.PendingTransactionWatcherOptions{
+				PollingInterval: server.config.Synchronization.PendingTransactionPollingInterval.Duration,
+			},
+		)
+		go pendingTransactionWatcher.Run(ctx)
+	}
 
-	syncService := server.getDefaultSyncService(
-		tendermintClient,
-		blockDataRepo,
-		blockViewRepo,
+	// Whichever of the sync service and HTTP API server stops first shuts down
+	// the other one
+	onStoppedCh := make(chan error, 2)
+	go func() {
+		if syncErr := syncService.Sync(ctx); syncErr != nil {
+			// HTTP API keeps serving so that the halting block can be inspected
+			// and retried
+			if errors.Is(syncErr, syncservice.ErrSyncHalted) {
+				server.logger.Errorf("sync service halted: %v", syncErr)
+				<-ctx.Done()
+				onStoppedCh <- nil
+				return
+			}
+			onStoppedCh <- fmt.Errorf("error running sync service: %v", syncErr)
+			return
+		}
+		onStoppedCh <- nil
+	}()
+	go func() {
+		onStoppedCh <- server.runHTTPAPIServer(
+			ctx,
+
+			syncService,
+			tendermintEndpoints,
+			activityViewRepo,
+			pendingTransactionViewRepo,
+			rewardViewRepo,
+			blockViewRepo,
+			councilNodeViewRepo,
+			stakingAccountViewRepo,
+			chainParamsViewRepo,
+			indexBootstrapViewRepo,
+			syncStateViewRepo,
+			quarantinedBlockViewRepo,
+			quarantinedBlockRetrier,
+		)
+	}()
+
+	err = <-onStoppedCh
+	cancel()
+	if otherErr := <-onStoppedCh; err == nil {
+		err = otherErr
+	}
 
-		onExitCh,
-	)
-	if err := syncService.Sync(); err != nil {
-		server.logger.Panicf("error starting sync service: %v", err)
+	if err != nil {
+		server.logger.Errorf("shutting down: %v", err)
+	} else {
+		server.logger.Info("shutting down")
 	}
+	return err
+}
 
-	server.startHTTPAPIServer(
-		syncService,
-		activityViewRepo,
-		rewardViewRepo,
-		blockViewRepo,
-		councilNodeViewRepo,
-		stakingAccountViewRepo,
+// syncUntilStopHeight runs the sync service without the HTTP API server until
+// the block at the stop height is stored. Halting synchronization is an error
+// because the stop height is never reached
+func (server *Server) syncUntilStopHeight(ctx context.Context, syncService usecase.SyncService, stopHeight uint64) error {
+	server.logger.Infof("synchronizing until height %d without HTTP API server", stopHeight)
+	if err := syncService.Sync(ctx); err != nil {
+		server.logger.Errorf("shutting down: %v", err)
+		return fmt.Errorf("error running sync service: %v", err)
+	}
 
-		onExitCh,
+	// A partially synchronized dataset must not be mistaken for a complete one
+	if syncHeight := syncService.GetStatus().SyncBlockHeight; syncHeight < stopHeight {
+		server.logger.Errorf("shutting down before reaching stop height at height %d", syncHeight)
+		return fmt.Errorf("synchronization interrupted at height %d before reaching stop height %d", syncHeight, stopHeight)
+	}
+	server.logger.Infof("synchronized until height %d, shutting down", stopHeight)
+	return nil
+}
+
+func (server *Server) newBlockDataRepo(rDbConn adapter.RDbConn) usecase.BlockDataRepository {
+	rDBTypeConv := new(infrastructure.PgxRDbTypeConv)
+	blockActivityDataRepo := adapter.NewDefaultRDbBlockActivityDataRepo(infrastructure.PostgresStmtBuilder, rDBTypeConv)
+	return adapter.NewRDbBlockDataRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv, blockActivityDataRepo)
+}
+
+func (server *Server) newQuarantinedBlockRepo(rDbConn adapter.RDbConn) usecase.QuarantinedBlockRepository {
+	rDBTypeConv := new(infrastructure.PgxRDbTypeConv)
+	return adapter.NewRDbQuarantinedBlockRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
+}
+
+func (server *Server) newSyncStateRepo(rDbConn adapter.RDbConn) usecase.SyncStateRepository {
+	rDBTypeConv := new(infrastructure.PgxRDbTypeConv)
+	return adapter.NewRDbSyncStateRepo(rDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv)
+}
+
+// getShadowSyncService returns a sync service which keeps syncing the live
+// schema while building a new index into the shadow schema, and swaps the
+// shadow schema in once it catches up
+func (server *Server) getShadowSyncService(
+	liveRDbConn *adapter.SwitchableRDbConn,
+	newRDbConn func(schema string) (adapter.RDbConn, error),
+) (usecase.SyncService, error) {
+	liveSchema := server.config.Database.Schema
+	if liveSchema == "" {
+		liveSchema = DEFAULT_DATABASE_SCHEMA
+	}
+	shadowSchema := server.config.Database.ShadowSchema
+	if shadowSchema == liveSchema {
+		return nil, fmt.Errorf("shadow schema must be different from live schema %s", liveSchema)
+	}
+
+	shadowPoolRDbConn, err := newRDbConn(shadowSchema)
+	if err != nil {
+		return nil, err
+	}
+	shadowRDbConn := adapter.NewSwitchableRDbConn(shadowPoolRDbConn)
+	rDBTypeConv := new(infrastructure.PgxRDbTypeConv)
+
+	// Both indexes share the raw block archive of the live schema, which is
+	// moved to the shadow schema when swapping
+	tendermintClient := server.getTendermintClient(liveRDbConn)
+	liveSyncService := server.getDefaultSyncService(
+		server.metrics.WithLabels(usecase.MetricLabels{"index": "live"}),
+		tendermintClient,
+		server.newBlockDataRepo(liveRDbConn),
+		server.newQuarantinedBlockRepo(liveRDbConn),
+		server.newSyncStateRepo(liveRDbConn),
+		rdbviewrepo.NewRDbSyncStateViewRepo(liveRDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv),
+	)
+	shadowSyncService := server.getDefaultSyncService(
+		server.metrics.WithLabels(usecase.MetricLabels{"index": "shadow"}),
+		tendermintClient,
+		server.newBlockDataRepo(shadowRDbConn),
+		server.newQuarantinedBlockRepo(shadowRDbConn),
+		server.newSyncStateRepo(shadowRDbConn),
+		rdbviewrepo.NewRDbSyncStateViewRepo(shadowRDbConn, infrastructure.PostgresStmtBuilder, rDBTypeConv),
+	)
+	schemaSwapper := adapter.NewRDbSchemaSwapper(
+		shadowPoolRDbConn,
+		liveSchema,
+		shadowSchema,
+
+		[]*adapter.SwitchableRDbConn{liveRDbConn, shadowRDbConn},
+		func() (adapter.RDbConn, error) {
+			return newRDbConn(liveSchema)
+		},
 	)
 
-	<-onExitCh
+	server.logger.Infof("building new index into shadow schema %s", shadowSchema)
+	return syncservice.NewShadowSyncService(
+		server.logger,
 
-	return nil
+		liveSyncService,
+		shadowSyncService,
+		schemaSwapper,
+
+		syncservice.ShadowSyncServiceOptions{},
+	), nil
 }
 
 func (server *Server) getDefaultSyncService(
+	metrics usecase.Metrics,
 	tendermintClient tendermintadapter.Client,
 	blockDataRepo usecase.BlockDataRepository,
-	blockViewRepo viewrepo.BlockViewRepo,
-
-	onExitCh chan<- bool,
+	quarantinedBlockRepo usecase.QuarantinedBlockRepository,
+	syncStateRepo usecase.SyncStateRepository,
+	syncStateViewRepo viewrepo.SyncStateViewRepo,
 ) usecase.SyncService {
 	config := syncservice.DefaultSyncServiceConfig{
 		BlockDataChSize: server.config.Synchronization.BlockDataChSize,
+		StopHeight:      server.config.Synchronization.StopHeight,
 	}
 
-	tendermintBlocksFeed := tendermint.NewPollingBlocksFeed(
+	quarantiner := syncservice.NewBlockQuarantiner(
 		server.logger,
-		tendermintClient,
-		tendermint.PollingBlocksFeedOptions{
-			PollingInterval: server.config.Synchronization.BlockHeightPollingInterval.Duration,
-		},
+		metrics,
+		quarantinedBlockRepo,
+		syncStateRepo,
+		server.config.Synchronization.OnBlockError,
 	)
+
+	tendermintBlocksFeed := server.getBlocksFeed(tendermintClient)
 	blocksFeedSubscriber := syncservice.NewDefaultBlocksFeedSubscriber(
 		server.logger,
 		tendermintBlocksFeed,
@@ -133,16 +330,43 @@ func (server *Server) getDefaultSyncService(
 	// blocksWorker := syncservice.NewPeriodicBlocksWorker(server.logger, tendermintClient)
 	blocksWorker := syncservice.NewBatchBlocksProcessor(
 		server.logger,
+		metrics,
 		tendermintClient,
-		int(server.config.Synchronization.MaxConcurrentBlockWorker),
+		quarantiner,
+		syncservice.BatchBlocksProcessorOptions{
+			Concurrency: syncservice.ConcurrencyControllerOptions{
+				Adaptive:      server.config.Synchronization.AdaptiveConcurrency,
+				MinWorker:     int(server.config.Synchronization.MinConcurrentBlockWorker),
+				MaxWorker:     int(server.config.Synchronization.MaxConcurrentBlockWorker),
+				TargetLatency: server.config.Synchronization.TargetBlockFetchLatency.Duration,
+				MaxErrorRate:  server.config.Synchronization.MaxBlockFetchErrorRate,
+			},
+			RetryBackoff: syncservice.BackoffOptions{
+				InitialInterval: server.config.Synchronization.BlockRetryInitialBackoff.Duration,
+				MaxInterval:     server.config.Synchronization.BlockRetryMaxBackoff.Duration,
+				Jitter:          server.config.Synchronization.BlockRetryBackoffJitter,
+			},
+		},
 	)
 	repoWorker := syncservice.NewDefaultBlockDataRepoWorker(
 		server.logger,
+		metrics,
 		blockDataRepo,
+		quarantiner,
+		syncservice.BlockDataRepoWorkerOptions{
+			MaxBatchSize:    int(server.config.Synchronization.StoreBatchSize),
+			MaxBatchLatency: server.config.Synchronization.StoreBatchMaxLatency.Duration,
+			BatchThreshold:  server.config.Synchronization.StoreBatchThreshold,
+		},
 	)
 
-	lastSyncHeight, err := blockViewRepo.LatestBlockHeight()
-	if err != nil {
+	// Synchronization resumes from the checkpointed sync state. Nothing is
+	// stored yet when it is not found
+	var lastSyncHeight uint64
+	syncState, err := syncStateViewRepo.FindSyncState()
+	if err == nil {
+		lastSyncHeight = syncState.LastStoredHeight
+	} else if err != adapter.ErrNotFound {
 		server.logger.Panicf("error getting last sync height: %v", err)
 	}
 
@@ -155,41 +379,90 @@ func (server *Server) getDefaultSyncService(
 		repoWorker,
 
 		lastSyncHeight,
-
-		onExitCh,
 	)
 }
 
-func (server *Server) startHTTPAPIServer(
+func (server *Server) getBlocksFeed(tendermintClient tendermintadapter.Client) tendermintadapter.BlocksFeed {
+	syncConfig := server.config.Synchronization
+	switch syncConfig.BlocksFeed {
+	case "", BLOCKS_FEED_POLLING:
+		return tendermint.NewPollingBlocksFeed(
+			server.logger,
+			tendermintClient,
+			tendermint.PollingBlocksFeedOptions{
+				PollingInterval: syncConfig.BlockHeightPollingInterval.Duration,
+			},
+		)
+	case BLOCKS_FEED_WEBSOCKET:
+		if server.config.Archive.SyncFromArchive {
+			server.logger.Panic("websocket blocks feed is not supported when syncing from archive")
+		}
+		if server.config.Tendermint.Dir != "" {
+			server.logger.Panic("websocket blocks feed is not supported when reading blocks from directory")
+		}
+		websocketURL, err := tendermint.WebSocketURLFromHTTPRPCURL(server.config.Tendermint.URL)
+		if err != nil {
+			server.logger.Panicf("error getting Tendermint websocket URL: %v", err)
+		}
+		return tendermint.NewWebSocketBlocksFeed(
+			server.logger,
+			tendermintClient,
+			websocketURL,
+			tendermint.WebSocketBlocksFeedOptions{
+				FallbackPollingInterval: syncConfig.BlockHeightPollingInterval.Duration,
+				MinReconnectBackoff:     syncConfig.WebSocketReconnectMinBackoff.Duration,
+				MaxReconnectBackoff:     syncConfig.WebSocketReconnectMaxBackoff.Duration,
+				ReadTimeout:             syncConfig.WebSocketReadTimeout.Duration,
+			},
+		)
+	default:
+		server.logger.Panicf("unsupported blocks feed: %s", syncConfig.BlocksFeed)
+	}
+
+	return nil
+}
+
+func (server *Server) runHTTPAPIServer(
+	ctx context.Context,
+
 	syncService usecase.SyncService,
+	tendermintEndpoints tendermintadapter.EndpointStatusProvider,
 	activityViewRepo viewrepo.ActivityViewRepo,
+	pendingTransactionViewRepo viewrepo.PendingTransactionViewRepo,
 	rewardViewRepo viewrepo.RewardViewRepo,
 	blockViewRepo viewrepo.BlockViewRepo,
 	councilNodeViewRepo viewrepo.CouncilNodeViewRepo,
 	stakingAccountViewRepo viewrepo.StakingAccountViewRepo,
-
-	onExitCh chan<- bool,
-) {
+	chainParamsViewRepo viewrepo.ChainParamsViewRepo,
+	indexBootstrapViewRepo viewrepo.IndexBootstrapViewRepo,
+	syncStateViewRepo viewrepo.SyncStateViewRepo,
+	quarantinedBlockViewRepo viewrepo.QuarantinedBlockViewRepo,
+	quarantinedBlockRetrier usecase.QuarantinedBlockRetrier,
+) error {
 	router := httpapi.NewMuxRouter()
 
 	routePath := httpapi.NewMuxRoutePath()
 
-	statusHandler := httpapiadapter.NewStatusHandler()
+	statusHandler := httpapiadapter.NewStatusHandler(tendermintEndpoints)
 
 	activitiesHandler := httpapiadapter.NewActivitiesHandler(
 		server.logger,
 		routePath,
 		activityViewRepo,
+		pendingTransactionViewRepo,
+		indexBootstrapViewRepo,
 	)
 	blocksHandler := httpapiadapter.NewBlocksHandler(
 		server.logger,
 		routePath,
 		blockViewRepo,
+		indexBootstrapViewRepo,
 	)
 	councilNodeHandler := httpapiadapter.NewCouncilNodesHandler(
 		server.logger,
 		routePath,
 		councilNodeViewRepo,
+		indexBootstrapViewRepo,
 	)
 	chainStatusHandler := httpapiadapter.NewChainStatusHandler(
 		server.logger,
@@ -197,6 +470,12 @@ func (server *Server) startHTTPAPIServer(
 		activityViewRepo,
 		rewardViewRepo,
 		councilNodeViewRepo,
+		indexBootstrapViewRepo,
+		syncStateViewRepo,
+	)
+	chainParamsHandler := httpapiadapter.NewChainParamsHandler(
+		server.logger,
+		chainParamsViewRepo,
 	)
 	searchHandler := httpapiadapter.NewSearchHandler(
 		server.logger,
@@ -205,29 +484,47 @@ func (server *Server) startHTTPAPIServer(
 		stakingAccountViewRepo,
 		councilNodeViewRepo,
 	)
+	var quarantinedBlocksHandler *httpapiadapter.QuarantinedBlocksHandler
+	if server.config.HTTPAPI.AdminEnabled {
+		quarantinedBlocksHandler = httpapiadapter.NewQuarantinedBlocksHandler(
+			server.logger,
+			routePath,
+			quarantinedBlockViewRepo,
+			quarantinedBlockRetrier,
+		)
+	}
 
 	httpapiadapter.NewRoutesRegistry(
 		router,
 
 		statusHandler,
 		chainStatusHandler,
+		chainParamsHandler,
 		activitiesHandler,
 		blocksHandler,
 		councilNodeHandler,
 		searchHandler,
+
+		quarantinedBlocksHandler,
 	).RegisterHandlers()
 
+	router.Get("/metrics", server.metrics.Handler().ServeHTTP)
+
 	router.Use(httpapiadapter.LoggerMiddleware(server.logger))
+	router.Use(httpapiadapter.MetricsMiddleware(server.metrics, routePath))
 	httpAPIServer := httpapi.NewServer(router.Handler(), httpapi.ServerConfig{
 		WriteTimeout: server.config.HTTPAPI.WriteTimeout.Duration,
 		ReadTimeout:  server.config.HTTPAPI.ReadTimeout.Duration,
 		IdleTimeout:  server.config.HTTPAPI.IdleTimeout.Duration,
+
+		ShutdownTimeout: server.config.HTTPAPI.ShutdownTimeout.Duration,
 	})
 
 	server.logger.Info("HTTP API server start listening on " + server.config.HTTPAPI.ListeningAddress)
-	if err := httpAPIServer.ListenAndServe(server.config.HTTPAPI.ListeningAddress); err != nil {
-		server.logger.Errorf("error listening and serving HTTP API server: %v", err)
+	if err := httpAPIServer.ListenAndServe(ctx, server.config.HTTPAPI.ListeningAddress); err != nil {
+		return fmt.Errorf("error listening and serving HTTP API server: %v", err)
 	}
+	server.logger.Info("HTTP API server stopped")
 
-	onExitCh <- true
+	return nil
 }
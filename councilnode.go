@@ -26,12 +26,16 @@ func (node *CouncilNode) String() string {
 type CouncilNodeUpdate struct {
 	Address string
 	Type    uint8
+	// Voting power of the council node from the block. It is only present in
+	// power changed update
+	MaybePower *uint64
 }
 
 type CouncilNodeUpdateType = uint8
 
 const (
 	COUNCIL_NODE_UPDATE_TYPE_LEFT = iota
+	COUNCIL_NODE_UPDATE_TYPE_POWER_CHANGED
 )
 
 func (update *CouncilNodeUpdate) String() string {
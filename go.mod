@@ -11,6 +11,7 @@ require (
 	github.com/google/go-cmp v0.4.1 // indirect
 	github.com/google/go-querystring v1.0.0
 	github.com/gorilla/mux v1.7.4
+	github.com/gorilla/websocket v1.4.2
 	github.com/jackc/pgconn v1.5.0
 	github.com/jackc/pgtype v1.3.0
 	github.com/jackc/pgx/v4 v4.6.0
@@ -19,6 +20,7 @@ require (
 	github.com/luci/go-render v0.0.0-20160219211803-9a04cc21af0f
 	github.com/onsi/ginkgo v1.12.1
 	github.com/onsi/gomega v1.10.0
+	github.com/prometheus/client_golang v1.5.1
 	github.com/rs/zerolog v1.18.0
 	github.com/shopspring/decimal v1.2.0 // indirect
 	github.com/stretchr/testify v1.5.1
@@ -24,6 +24,10 @@ func (router *MuxRouter) Get(path string, handler func(http.ResponseWriter, *htt
 	router.instance.HandleFunc(path, handler).Methods("GET")
 }
 
+func (router *MuxRouter) Post(path string, handler func(http.ResponseWriter, *http.Request)) {
+	router.instance.HandleFunc(path, handler).Methods("POST")
+}
+
 func (router *MuxRouter) Handler() http.Handler {
 	return router.instance
 }
@@ -37,3 +41,16 @@ func NewMuxRoutePath() *MuxRoutePath {
 func (path *MuxRoutePath) Vars(req *http.Request) map[string]string {
 	return mux.Vars(req)
 }
+
+func (path *MuxRoutePath) Template(req *http.Request) string {
+	route := mux.CurrentRoute(req)
+	if route == nil {
+		return ""
+	}
+
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return ""
+	}
+	return template
+}
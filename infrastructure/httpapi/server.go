@@ -1,6 +1,8 @@
 package httpapi
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"time"
 )
@@ -15,6 +17,8 @@ type ServerConfig struct {
 	WriteTimeout time.Duration
 	ReadTimeout  time.Duration
 	IdleTimeout  time.Duration
+	// Maximum duration to wait for in-flight requests to complete on shutdown
+	ShutdownTimeout time.Duration
 }
 
 func NewServer(router http.Handler, config ServerConfig) *Server {
@@ -25,7 +29,9 @@ func NewServer(router http.Handler, config ServerConfig) *Server {
 	}
 }
 
-func (server *Server) ListenAndServe(address string) error {
+// ListenAndServe serves until the context is done, then stops accepting new
+// connections and drains in-flight requests before returning
+func (server *Server) ListenAndServe(ctx context.Context, address string) error {
 	httpServer := &http.Server{
 		Addr:         address,
 		WriteTimeout: server.config.WriteTimeout,
@@ -34,7 +40,28 @@ func (server *Server) ListenAndServe(address string) error {
 		Handler:      server.handler,
 	}
 
-	if err := httpServer.ListenAndServe(); err != nil {
+	serveErrCh := make(chan error, 1)
+	go func() {
+		serveErrCh <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErrCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx := context.Background()
+	if server.config.ShutdownTimeout > 0 {
+		var cancel context.CancelFunc
+		shutdownCtx, cancel = context.WithTimeout(shutdownCtx, server.config.ShutdownTimeout)
+		defer cancel()
+	}
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+
+	if err := <-serveErrCh; !errors.Is(err, http.ErrServerClosed) {
 		return err
 	}
 
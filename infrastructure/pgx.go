@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -62,7 +63,9 @@ type PgxConnConfig struct {
 	Username string
 	Password string
 	Database string
-	SSL      bool
+	// Schema is set as the search_path of the connection when provided
+	Schema string
+	SSL    bool
 }
 
 type PgxConnPoolConfig struct {
@@ -81,11 +84,18 @@ func (config *PgxConnConfig) ToURL() string {
 	}
 
 	connStr := fmt.Sprintf("postgres://%s%s:%d/%s", authStr, config.Host, config.Port, config.Database)
-	if config.SSL {
+
+	queryValues := url.Values{}
+	if config.Schema != "" {
+		queryValues.Set("search_path", config.Schema)
+	}
+	if !config.SSL {
+		queryValues.Set("sslmode", "disable")
+	}
+	if len(queryValues) == 0 {
 		return connStr
-	} else {
-		return connStr + "?sslmode=disable"
 	}
+	return connStr + "?" + queryValues.Encode()
 }
 
 func (config *PgxConnPoolConfig) ToURL() string {
@@ -99,6 +109,9 @@ func (config *PgxConnPoolConfig) ToURL() string {
 	if err != nil {
 		panic(fmt.Sprintf("error parsing Pgx connection config: %v", err))
 	}
+	if config.Schema != "" {
+		queryValues.Set("search_path", config.Schema)
+	}
 	if !config.SSL {
 		queryValues.Set("sslmode", "disable")
 	}
@@ -118,3 +118,36 @@ var _ = Describe("PgxRDbTypeConv", func() {
 		})
 	})
 })
+
+var _ = Describe("PgxConnPoolConfig", func() {
+	Describe("ToURL", func() {
+		It("should set search_path to the schema when provided", func() {
+			config := infrastructure.PgxConnPoolConfig{
+				PgxConnConfig: infrastructure.PgxConnConfig{
+					Host:     "localhost",
+					Port:     5432,
+					Username: "postgres",
+					Password: "postgres",
+					Database: "postgres",
+					Schema:   "shadow",
+					SSL:      true,
+				},
+				MaxConns: 4,
+			}
+
+			Expect(config.ToURL()).To(ContainSubstring("search_path=shadow"))
+		})
+
+		It("should not set search_path when schema is not provided", func() {
+			config := infrastructure.PgxConnPoolConfig{
+				PgxConnConfig: infrastructure.PgxConnConfig{
+					Host:     "localhost",
+					Port:     5432,
+					Database: "postgres",
+				},
+			}
+
+			Expect(config.ToURL()).NotTo(ContainSubstring("search_path"))
+		})
+	})
+})
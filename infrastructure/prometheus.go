@@ -0,0 +1,341 @@
+package infrastructure
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+const PROMETHEUS_NAMESPACE = "chainindex"
+
+type prometheusMetricDef struct {
+	help       string
+	labelNames []string
+}
+
+var prometheusGaugeDefs = map[string]prometheusMetricDef{
+	usecase.METRIC_SYNC_WORKING_BLOCK_WORKERS: {
+		"Number of workers fetching and parsing blocks", []string{"index"},
+	},
+	usecase.METRIC_SYNC_MAX_BLOCK_WORKERS: {
+		"Maximum number of concurrent workers fetching and parsing blocks", []string{"index"},
+	},
+	usecase.METRIC_SYNC_BLOCK_WORKERS_LIMIT: {
+		"Current limit of concurrent workers fetching and parsing blocks set by the concurrency controller", []string{"index"},
+	},
+	usecase.METRIC_SYNC_SLIDING_WINDOW_BLOCKS: {
+		"Number of parsed blocks waiting in the sliding window for their preceding blocks", []string{"index"},
+	},
+}
+
+var prometheusCounterDefs = map[string]prometheusMetricDef{
+	usecase.METRIC_SYNC_STORED_BLOCKS_TOTAL: {
+		"Number of blocks stored", []string{"index"},
+	},
+	usecase.METRIC_SYNC_STORE_ERRORS_TOTAL: {
+		"Number of failed attempts to store blocks", []string{"index"},
+	},
+	usecase.METRIC_SYNC_QUARANTINED_BLOCKS_TOTAL: {
+		"Number of blocks put into quarantine", []string{"index", "stage"},
+	},
+	usecase.METRIC_SYNC_BLOCK_RETRIES_TOTAL: {
+		"Number of retries of fetching and parsing blocks", []string{"index"},
+	},
+	usecase.METRIC_TENDERMINT_RPC_ERRORS_TOTAL: {
+		"Number of failed Tendermint RPC requests", []string{"method"},
+	},
+}
+
+var prometheusHistogramDefs = map[string]prometheusMetricDef{
+	usecase.METRIC_SYNC_STORE_DURATION_SECONDS: {
+		"Time taken to store blocks in a single transaction", []string{"index"},
+	},
+	usecase.METRIC_SYNC_BLOCK_RETRY_BACKOFF_SECONDS: {
+		"Time waited before retrying fetching and parsing blocks", []string{"index"},
+	},
+	usecase.METRIC_TENDERMINT_RPC_DURATION_SECONDS: {
+		"Time taken to complete Tendermint RPC requests", []string{"method"},
+	},
+	usecase.METRIC_HTTPAPI_REQUEST_DURATION_SECONDS: {
+		"Time taken to serve HTTP API requests", []string{"route", "method", "status"},
+	},
+}
+
+// PrometheusMetrics records metrics declared in the definitions above.
+// Observations of undeclared metrics are ignored, and declared labels missing
+// from an observation are recorded as empty string
+type PrometheusMetrics struct {
+	registry *prometheus.Registry
+
+	gauges     map[string]*prometheus.GaugeVec
+	counters   map[string]*prometheus.CounterVec
+	histograms map[string]*prometheus.HistogramVec
+
+	pgxConnPoolCollector *pgxConnPoolCollector
+
+	labels usecase.MetricLabels
+}
+
+func NewPrometheusMetrics() *PrometheusMetrics {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(prometheus.NewGoCollector())
+	registry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
+
+	gauges := make(map[string]*prometheus.GaugeVec, len(prometheusGaugeDefs))
+	for name, def := range prometheusGaugeDefs {
+		gauges[name] = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: PROMETHEUS_NAMESPACE,
+			Name:      name,
+			Help:      def.help,
+		}, def.labelNames)
+		registry.MustRegister(gauges[name])
+	}
+	counters := make(map[string]*prometheus.CounterVec, len(prometheusCounterDefs))
+	for name, def := range prometheusCounterDefs {
+		counters[name] = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: PROMETHEUS_NAMESPACE,
+			Name:      name,
+			Help:      def.help,
+		}, def.labelNames)
+		registry.MustRegister(counters[name])
+	}
+	histograms := make(map[string]*prometheus.HistogramVec, len(prometheusHistogramDefs))
+	for name, def := range prometheusHistogramDefs {
+		histograms[name] = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: PROMETHEUS_NAMESPACE,
+			Name:      name,
+			Help:      def.help,
+			Buckets:   prometheus.DefBuckets,
+		}, def.labelNames)
+		registry.MustRegister(histograms[name])
+	}
+
+	pgxConnPoolCollector := newPgxConnPoolCollector()
+	registry.MustRegister(pgxConnPoolCollector)
+
+	return &PrometheusMetrics{
+		registry: registry,
+
+		gauges:     gauges,
+		counters:   counters,
+		histograms: histograms,
+
+		pgxConnPoolCollector: pgxConnPoolCollector,
+
+		labels: usecase.MetricLabels{},
+	}
+}
+
+func (metrics *PrometheusMetrics) SetGauge(name string, value float64, labels usecase.MetricLabels) {
+	if gauge, ok := metrics.gauges[name]; ok {
+		gauge.With(metrics.promLabels(prometheusGaugeDefs[name], labels)).Set(value)
+	}
+}
+
+func (metrics *PrometheusMetrics) AddCounter(name string, delta float64, labels usecase.MetricLabels) {
+	if counter, ok := metrics.counters[name]; ok {
+		counter.With(metrics.promLabels(prometheusCounterDefs[name], labels)).Add(delta)
+	}
+}
+
+func (metrics *PrometheusMetrics) ObserveHistogram(name string, value float64, labels usecase.MetricLabels) {
+	if histogram, ok := metrics.histograms[name]; ok {
+		histogram.With(metrics.promLabels(prometheusHistogramDefs[name], labels)).Observe(value)
+	}
+}
+
+// WithLabels returns metrics which record the labels on every observation
+// in addition to the labels provided
+func (metrics *PrometheusMetrics) WithLabels(labels usecase.MetricLabels) usecase.Metrics {
+	mergedLabels := make(usecase.MetricLabels, len(metrics.labels)+len(labels))
+	for name, value := range metrics.labels {
+		mergedLabels[name] = value
+	}
+	for name, value := range labels {
+		mergedLabels[name] = value
+	}
+
+	return &PrometheusMetrics{
+		registry: metrics.registry,
+
+		gauges:     metrics.gauges,
+		counters:   metrics.counters,
+		histograms: metrics.histograms,
+
+		pgxConnPoolCollector: metrics.pgxConnPoolCollector,
+
+		labels: mergedLabels,
+	}
+}
+
+func (metrics *PrometheusMetrics) promLabels(def prometheusMetricDef, labels usecase.MetricLabels) prometheus.Labels {
+	promLabels := make(prometheus.Labels, len(def.labelNames))
+	for _, name := range def.labelNames {
+		if value, ok := labels[name]; ok {
+			promLabels[name] = value
+		} else {
+			promLabels[name] = metrics.labels[name]
+		}
+	}
+	return promLabels
+}
+
+// RegisterSyncStatus exposes Tendermint block height, sync block height and
+// the lag between them from the sync service status on every scrape
+func (metrics *PrometheusMetrics) RegisterSyncStatus(syncService usecase.SyncService) {
+	metrics.registry.MustRegister(newSyncStatusCollector(syncService))
+}
+
+// RegisterPgxConnPool exposes the stats of the connection pool under the
+// provided name. A pool registered under an existing name replaces the
+// previous one
+func (metrics *PrometheusMetrics) RegisterPgxConnPool(name string, pool *pgxpool.Pool) {
+	metrics.pgxConnPoolCollector.Add(name, pool)
+}
+
+// Handler serves the metrics in Prometheus exposition format
+func (metrics *PrometheusMetrics) Handler() http.Handler {
+	return promhttp.HandlerFor(metrics.registry, promhttp.HandlerOpts{})
+}
+
+type syncStatusCollector struct {
+	syncService usecase.SyncService
+
+	tendermintBlockHeightDesc *prometheus.Desc
+	syncBlockHeightDesc       *prometheus.Desc
+	lagDesc                   *prometheus.Desc
+}
+
+func newSyncStatusCollector(syncService usecase.SyncService) *syncStatusCollector {
+	return &syncStatusCollector{
+		syncService: syncService,
+
+		tendermintBlockHeightDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(PROMETHEUS_NAMESPACE, "sync", "tendermint_block_height"),
+			"Latest block height known from Tendermint", nil, nil,
+		),
+		syncBlockHeightDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(PROMETHEUS_NAMESPACE, "sync", "block_height"),
+			"Latest stored block height", nil, nil,
+		),
+		lagDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(PROMETHEUS_NAMESPACE, "sync", "lag_blocks"),
+			"Number of blocks the stored block height is behind Tendermint", nil, nil,
+		),
+	}
+}
+
+func (collector *syncStatusCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.tendermintBlockHeightDesc
+	ch <- collector.syncBlockHeightDesc
+	ch <- collector.lagDesc
+}
+
+func (collector *syncStatusCollector) Collect(ch chan<- prometheus.Metric) {
+	status := collector.syncService.GetStatus()
+
+	var lag uint64
+	if status.TendermintBlockHeight > status.SyncBlockHeight {
+		lag = status.TendermintBlockHeight - status.SyncBlockHeight
+	}
+
+	ch <- prometheus.MustNewConstMetric(
+		collector.tendermintBlockHeightDesc, prometheus.GaugeValue, float64(status.TendermintBlockHeight),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		collector.syncBlockHeightDesc, prometheus.GaugeValue, float64(status.SyncBlockHeight),
+	)
+	ch <- prometheus.MustNewConstMetric(collector.lagDesc, prometheus.GaugeValue, float64(lag))
+}
+
+type pgxConnPoolCollector struct {
+	mux   sync.RWMutex
+	pools map[string]*pgxpool.Pool
+
+	acquiredConnsDesc        *prometheus.Desc
+	idleConnsDesc            *prometheus.Desc
+	totalConnsDesc           *prometheus.Desc
+	maxConnsDesc             *prometheus.Desc
+	acquireCountDesc         *prometheus.Desc
+	acquireDurationDesc      *prometheus.Desc
+	canceledAcquireCountDesc *prometheus.Desc
+	emptyAcquireCountDesc    *prometheus.Desc
+}
+
+func newPgxConnPoolCollector() *pgxConnPoolCollector {
+	newDesc := func(name string, help string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(PROMETHEUS_NAMESPACE, "pgxpool", name), help, []string{"pool"}, nil,
+		)
+	}
+
+	return &pgxConnPoolCollector{
+		pools: make(map[string]*pgxpool.Pool),
+
+		acquiredConnsDesc:        newDesc("acquired_conns", "Number of currently acquired connections"),
+		idleConnsDesc:            newDesc("idle_conns", "Number of currently idle connections"),
+		totalConnsDesc:           newDesc("total_conns", "Total number of connections"),
+		maxConnsDesc:             newDesc("max_conns", "Maximum size of the pool"),
+		acquireCountDesc:         newDesc("acquire_total", "Number of successful connection acquires"),
+		acquireDurationDesc:      newDesc("acquire_duration_seconds_total", "Total time spent on successful acquires"),
+		canceledAcquireCountDesc: newDesc("canceled_acquire_total", "Number of acquires canceled by context"),
+		emptyAcquireCountDesc:    newDesc("empty_acquire_total", "Number of acquires which waited for a connection"),
+	}
+}
+
+func (collector *pgxConnPoolCollector) Add(name string, pool *pgxpool.Pool) {
+	collector.mux.Lock()
+	defer collector.mux.Unlock()
+
+	collector.pools[name] = pool
+}
+
+func (collector *pgxConnPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.acquiredConnsDesc
+	ch <- collector.idleConnsDesc
+	ch <- collector.totalConnsDesc
+	ch <- collector.maxConnsDesc
+	ch <- collector.acquireCountDesc
+	ch <- collector.acquireDurationDesc
+	ch <- collector.canceledAcquireCountDesc
+	ch <- collector.emptyAcquireCountDesc
+}
+
+func (collector *pgxConnPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	collector.mux.RLock()
+	defer collector.mux.RUnlock()
+
+	for name, pool := range collector.pools {
+		stat := pool.Stat()
+
+		ch <- prometheus.MustNewConstMetric(
+			collector.acquiredConnsDesc, prometheus.GaugeValue, float64(stat.AcquiredConns()), name,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			collector.idleConnsDesc, prometheus.GaugeValue, float64(stat.IdleConns()), name,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			collector.totalConnsDesc, prometheus.GaugeValue, float64(stat.TotalConns()), name,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			collector.maxConnsDesc, prometheus.GaugeValue, float64(stat.MaxConns()), name,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			collector.acquireCountDesc, prometheus.CounterValue, float64(stat.AcquireCount()), name,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			collector.acquireDurationDesc, prometheus.CounterValue, stat.AcquireDuration().Seconds(), name,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			collector.canceledAcquireCountDesc, prometheus.CounterValue, float64(stat.CanceledAcquireCount()), name,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			collector.emptyAcquireCountDesc, prometheus.CounterValue, float64(stat.EmptyAcquireCount()), name,
+		)
+	}
+}
@@ -0,0 +1,74 @@
+package infrastructure_test
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chainindex/infrastructure"
+	"github.com/crypto-com/chainindex/usecase"
+	. "github.com/crypto-com/chainindex/usecase/test/mock"
+)
+
+var _ = Describe("PrometheusMetrics", func() {
+	scrape := func(metrics *infrastructure.PrometheusMetrics) string {
+		recorder := httptest.NewRecorder()
+		metrics.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+
+		body, err := ioutil.ReadAll(recorder.Body)
+		Expect(err).To(BeNil())
+		return string(body)
+	}
+
+	It("should implement Metrics", func() {
+		var _ usecase.Metrics = infrastructure.NewPrometheusMetrics()
+	})
+
+	It("should expose recorded metrics with labels", func() {
+		metrics := infrastructure.NewPrometheusMetrics()
+
+		metrics.AddCounter(usecase.METRIC_TENDERMINT_RPC_ERRORS_TOTAL, 2, usecase.MetricLabels{
+			"method": "block",
+		})
+
+		Expect(scrape(metrics)).To(ContainSubstring(`chainindex_tendermint_rpc_errors_total{method="block"} 2`))
+	})
+
+	It("should record labels provided by WithLabels on every observation", func() {
+		metrics := infrastructure.NewPrometheusMetrics()
+
+		metrics.WithLabels(usecase.MetricLabels{
+			"index": "shadow",
+		}).SetGauge(usecase.METRIC_SYNC_WORKING_BLOCK_WORKERS, 5, nil)
+
+		Expect(scrape(metrics)).To(ContainSubstring(`chainindex_sync_working_block_workers{index="shadow"} 5`))
+	})
+
+	It("should ignore undeclared metrics", func() {
+		metrics := infrastructure.NewPrometheusMetrics()
+
+		Expect(func() {
+			metrics.AddCounter("undeclared_total", 1, nil)
+		}).NotTo(Panic())
+	})
+
+	Describe("RegisterSyncStatus", func() {
+		It("should expose sync lag from the sync service status", func() {
+			mockSyncService := new(MockSyncService)
+			mockSyncService.On("GetStatus").Return(usecase.SyncStatus{
+				TendermintBlockHeight: 120,
+				SyncBlockHeight:       100,
+			})
+			metrics := infrastructure.NewPrometheusMetrics()
+
+			metrics.RegisterSyncStatus(mockSyncService)
+
+			output := scrape(metrics)
+			Expect(output).To(ContainSubstring("chainindex_sync_tendermint_block_height 120"))
+			Expect(output).To(ContainSubstring("chainindex_sync_block_height 100"))
+			Expect(output).To(ContainSubstring("chainindex_sync_lag_blocks 20"))
+		})
+	})
+})
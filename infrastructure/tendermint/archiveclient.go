@@ -0,0 +1,66 @@
+package tendermint
+
+import (
+	"bytes"
+	"fmt"
+
+	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
+	"github.com/crypto-com/chainindex/adapter/tendermint/types"
+)
+
+// ArchiveClient reads Tendermint responses from the raw block archive instead
+// of a Tendermint node. It parses the archived responses in the same way as
+// HTTPClient so that workers can sync from the archive transparently.
+type ArchiveClient struct {
+	archive tendermintadapter.RawBlockArchive
+}
+
+func NewArchiveClient(archive tendermintadapter.RawBlockArchive) *ArchiveClient {
+	return &ArchiveClient{
+		archive,
+	}
+}
+
+func (client *ArchiveClient) Genesis() (*types.Genesis, error) {
+	rawGenesis, err := client.archive.FindGenesis()
+	if err != nil {
+		return nil, fmt.Errorf("error reading genesis from archive: %w", err)
+	}
+
+	return parseGenesisResp(bytes.NewReader(rawGenesis))
+}
+
+// LatestBlockHeight returns the highest height of which both block and block
+// results are archived
+func (client *ArchiveClient) LatestBlockHeight() (uint64, error) {
+	height, err := client.archive.LatestHeight()
+	if err != nil {
+		return uint64(0), fmt.Errorf("error reading latest height from archive: %w", err)
+	}
+
+	return height, nil
+}
+
+func (client *ArchiveClient) BlockResults(height uint64) (*types.BlockResults, error) {
+	rawBlockResults, err := client.archive.FindBlockResults(height)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block results at height %d from archive: %w", height, err)
+	}
+
+	return parseBlockResultsResp(bytes.NewReader(rawBlockResults))
+}
+
+func (client *ArchiveClient) Block(height uint64) (*types.Block, error) {
+	rawBlock, err := client.archive.FindBlock(height)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block at height %d from archive: %w", height, err)
+	}
+
+	return parseBlockResp(bytes.NewReader(rawBlock))
+}
+
+// UnconfirmedTxs always returns no transaction because the mempool is not
+// archived
+func (client *ArchiveClient) UnconfirmedTxs() ([]string, error) {
+	return []string{}, nil
+}
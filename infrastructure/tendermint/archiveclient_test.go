@@ -0,0 +1,95 @@
+package tendermint_test
+
+import (
+	"errors"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
+	. "github.com/crypto-com/chainindex/adapter/tendermint/test/mock"
+	"github.com/crypto-com/chainindex/infrastructure/tendermint"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+)
+
+var _ = Describe("ArchiveClient", func() {
+	It("should implement Client", func() {
+		var _ tendermintadapter.Client = tendermint.NewArchiveClient(new(MockRawBlockArchive))
+	})
+
+	Describe("Block", func() {
+		It("should return the same block as parsed from Tendermint node", func() {
+			server := ghttp.NewServer()
+			defer server.Close()
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block", "height=3510"),
+					ghttp.RespondWith(http.StatusOK, BLOCK_JSON),
+				),
+			)
+			expectedBlock, err := tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{}).Block(uint64(3510))
+			Expect(err).To(BeNil())
+
+			mockArchive := new(MockRawBlockArchive)
+			mockArchive.On("FindBlock", uint64(3510)).Return([]byte(BLOCK_JSON), nil)
+
+			client := tendermint.NewArchiveClient(mockArchive)
+
+			block, err := client.Block(uint64(3510))
+			Expect(err).To(BeNil())
+			Expect(block).To(Equal(expectedBlock))
+		})
+
+		It("should return ErrNotArchived when block is not archived", func() {
+			mockArchive := new(MockRawBlockArchive)
+			mockArchive.On("FindBlock", uint64(3510)).Return(nil, tendermintadapter.ErrNotArchived)
+
+			client := tendermint.NewArchiveClient(mockArchive)
+
+			_, err := client.Block(uint64(3510))
+			Expect(errors.Is(err, tendermintadapter.ErrNotArchived)).To(BeTrue())
+		})
+	})
+
+	Describe("BlockResults", func() {
+		It("should return parsed archived block results", func() {
+			mockArchive := new(MockRawBlockArchive)
+			mockArchive.On("FindBlockResults", uint64(3813)).Return([]byte(BLOCK_RESULTS_JSON), nil)
+
+			client := tendermint.NewArchiveClient(mockArchive)
+
+			blockResults, err := client.BlockResults(uint64(3813))
+			Expect(err).To(BeNil())
+			Expect(blockResults.Height).To(Equal(uint64(3813)))
+			Expect(blockResults.TxsEvents).To(HaveLen(2))
+		})
+	})
+
+	Describe("Genesis", func() {
+		It("should return parsed archived genesis", func() {
+			mockArchive := new(MockRawBlockArchive)
+			mockArchive.On("FindGenesis").Return([]byte(GENESIS_JSON), nil)
+
+			client := tendermint.NewArchiveClient(mockArchive)
+
+			genesis, err := client.Genesis()
+			Expect(err).To(BeNil())
+			Expect(genesis.ChainID).To(Equal("testnet-thaler-crypto-com-chain-42"))
+		})
+	})
+
+	Describe("LatestBlockHeight", func() {
+		It("should return latest archived height", func() {
+			mockArchive := new(MockRawBlockArchive)
+			mockArchive.On("LatestHeight").Return(uint64(1000), nil)
+
+			client := tendermint.NewArchiveClient(mockArchive)
+
+			height, err := client.LatestBlockHeight()
+			Expect(err).To(BeNil())
+			Expect(height).To(Equal(uint64(1000)))
+		})
+	})
+})
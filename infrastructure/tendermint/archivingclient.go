@@ -0,0 +1,72 @@
+package tendermint
+
+import (
+	"bytes"
+	"fmt"
+
+	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
+	"github.com/crypto-com/chainindex/adapter/tendermint/types"
+)
+
+// ArchivingClient requests Tendermint responses from the underlying raw client
+// and stores them verbatim into the archive before parsing
+type ArchivingClient struct {
+	client  tendermintadapter.RawClient
+	archive tendermintadapter.RawBlockArchive
+}
+
+func NewArchivingClient(
+	client tendermintadapter.RawClient,
+	archive tendermintadapter.RawBlockArchive,
+) *ArchivingClient {
+	return &ArchivingClient{
+		client,
+		archive,
+	}
+}
+
+func (client *ArchivingClient) Genesis() (*types.Genesis, error) {
+	rawGenesis, err := client.client.RawGenesis()
+	if err != nil {
+		return nil, err
+	}
+	if err = client.archive.StoreGenesis(rawGenesis); err != nil {
+		return nil, fmt.Errorf("error archiving genesis: %v", err)
+	}
+
+	return parseGenesisResp(bytes.NewReader(rawGenesis))
+}
+
+func (client *ArchivingClient) LatestBlockHeight() (uint64, error) {
+	return client.client.LatestBlockHeight()
+}
+
+func (client *ArchivingClient) BlockResults(height uint64) (*types.BlockResults, error) {
+	rawBlockResults, err := client.client.RawBlockResults(height)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.archive.StoreBlockResults(height, rawBlockResults); err != nil {
+		return nil, fmt.Errorf("error archiving block results at height %d: %v", height, err)
+	}
+
+	return parseBlockResultsResp(bytes.NewReader(rawBlockResults))
+}
+
+func (client *ArchivingClient) Block(height uint64) (*types.Block, error) {
+	rawBlock, err := client.client.RawBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.archive.StoreBlock(height, rawBlock); err != nil {
+		return nil, fmt.Errorf("error archiving block at height %d: %v", height, err)
+	}
+
+	return parseBlockResp(bytes.NewReader(rawBlock))
+}
+
+// UnconfirmedTxs is passed through to the underlying raw client without
+// archiving because the mempool is not part of the chain
+func (client *ArchivingClient) UnconfirmedTxs() ([]string, error) {
+	return client.client.UnconfirmedTxs()
+}
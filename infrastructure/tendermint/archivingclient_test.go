@@ -0,0 +1,115 @@
+package tendermint_test
+
+import (
+	"errors"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
+	. "github.com/crypto-com/chainindex/adapter/tendermint/test/mock"
+	"github.com/crypto-com/chainindex/infrastructure/tendermint"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+)
+
+var _ = Describe("ArchivingClient", func() {
+	var server *ghttp.Server
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should implement Client", func() {
+		var _ tendermintadapter.Client = tendermint.NewArchivingClient(
+			tendermint.NewHTTPClient("http://localhost:26657", new(FakeMetrics), tendermint.HTTPClientOptions{}),
+			new(MockRawBlockArchive),
+		)
+	})
+
+	Describe("Genesis", func() {
+		It("should archive verbatim genesis response before returning parsed genesis", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/genesis"),
+					ghttp.RespondWith(http.StatusOK, GENESIS_JSON),
+				),
+			)
+
+			mockArchive := new(MockRawBlockArchive)
+			mockArchive.On("StoreGenesis", []byte(GENESIS_JSON)).Return(nil)
+
+			client := tendermint.NewArchivingClient(tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{}), mockArchive)
+
+			genesis, err := client.Genesis()
+			Expect(err).To(BeNil())
+			Expect(genesis.ChainID).To(Equal("testnet-thaler-crypto-com-chain-42"))
+			mockArchive.AssertExpectations(GinkgoT())
+		})
+	})
+
+	Describe("Block", func() {
+		It("should archive verbatim block response before returning parsed block", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block", "height=3510"),
+					ghttp.RespondWith(http.StatusOK, BLOCK_JSON),
+				),
+			)
+
+			mockArchive := new(MockRawBlockArchive)
+			mockArchive.On("StoreBlock", uint64(3510), []byte(BLOCK_JSON)).Return(nil)
+
+			client := tendermint.NewArchivingClient(tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{}), mockArchive)
+
+			block, err := client.Block(uint64(3510))
+			Expect(err).To(BeNil())
+			Expect(block.Height).To(Equal(uint64(3510)))
+			Expect(block.Hash).To(Equal("2CD22EA622D190B9ABCAB797E2F60F6F4FCFC19CC0A67642E5E7856CEAD78163"))
+			mockArchive.AssertExpectations(GinkgoT())
+		})
+
+		It("should return error when archiving fails", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block", "height=3510"),
+					ghttp.RespondWith(http.StatusOK, BLOCK_JSON),
+				),
+			)
+
+			mockArchive := new(MockRawBlockArchive)
+			mockArchive.On("StoreBlock", uint64(3510), []byte(BLOCK_JSON)).Return(errors.New("archive error"))
+
+			client := tendermint.NewArchivingClient(tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{}), mockArchive)
+
+			_, err := client.Block(uint64(3510))
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("BlockResults", func() {
+		It("should archive verbatim block results response before returning parsed block results", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block_results", "height=3813"),
+					ghttp.RespondWith(http.StatusOK, BLOCK_RESULTS_JSON),
+				),
+			)
+
+			mockArchive := new(MockRawBlockArchive)
+			mockArchive.On("StoreBlockResults", uint64(3813), []byte(BLOCK_RESULTS_JSON)).Return(nil)
+
+			client := tendermint.NewArchivingClient(tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{}), mockArchive)
+
+			blockResults, err := client.BlockResults(uint64(3813))
+			Expect(err).To(BeNil())
+			Expect(blockResults.Height).To(Equal(uint64(3813)))
+			mockArchive.AssertExpectations(GinkgoT())
+		})
+	})
+})
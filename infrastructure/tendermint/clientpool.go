@@ -0,0 +1,324 @@
+package tendermint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
+	"github.com/crypto-com/chainindex/adapter/tendermint/types"
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+const DEFAULT_HEALTH_CHECK_INTERVAL = 5 * time.Second
+
+// ClientPool spreads requests across several Tendermint RPC endpoints in
+// round-robin. Requests fail over to the next endpoint on error. Endpoints
+// are health-checked periodically, and an endpoint failing or lagging behind
+// the others is skipped until it recovers
+type ClientPool struct {
+	logger  usecase.Logger
+	options ClientPoolOptions
+
+	endpoints []*clientPoolEndpoint
+	nextIndex uint32
+}
+
+type ClientPoolOptions struct {
+	HealthCheckInterval time.Duration
+	// Endpoint whose latest block height is behind the highest one among the
+	// endpoints by more than this number of blocks is considered unhealthy.
+	// Lagging is not checked when it is 0
+	MaxLagBlocks uint64
+}
+
+func NewClientPool(
+	logger usecase.Logger,
+	metrics usecase.Metrics,
+	serverUrls []string,
+	httpClientOptions HTTPClientOptions,
+	options ClientPoolOptions,
+) *ClientPool {
+	if options.HealthCheckInterval == 0 {
+		options.HealthCheckInterval = DEFAULT_HEALTH_CHECK_INTERVAL
+	}
+
+	// Endpoints are assumed healthy until checked
+	endpoints := make([]*clientPoolEndpoint, 0, len(serverUrls))
+	for _, serverUrl := range serverUrls {
+		endpoints = append(endpoints, &clientPoolEndpoint{
+			url:     serverUrl,
+			client:  NewHTTPClient(serverUrl, metrics, httpClientOptions),
+			healthy: true,
+		})
+	}
+
+	return &ClientPool{
+		logger: logger.WithFields(usecase.LogFields{
+			"module": "ClientPool",
+		}),
+		options: options,
+
+		endpoints: endpoints,
+	}
+}
+
+// Run health checks on the endpoints periodically until the context is done
+func (pool *ClientPool) Run(ctx context.Context) {
+	for {
+		pool.CheckHealth()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pool.options.HealthCheckInterval):
+		}
+	}
+}
+
+// CheckHealth requests the latest block height from every endpoint. Endpoints
+// failing or lagging behind are marked unhealthy
+func (pool *ClientPool) CheckHealth() {
+	var wg sync.WaitGroup
+	for _, endpoint := range pool.endpoints {
+		wg.Add(1)
+		go func(endpoint *clientPoolEndpoint) {
+			defer wg.Done()
+
+			latestBlockHeight, err := endpoint.client.LatestBlockHeight()
+			endpoint.recordCheck(latestBlockHeight, err)
+		}(endpoint)
+	}
+	wg.Wait()
+
+	highestBlockHeight := uint64(0)
+	for _, endpoint := range pool.endpoints {
+		status := endpoint.status()
+		if status.LastError == nil && status.LatestBlockHeight > highestBlockHeight {
+			highestBlockHeight = status.LatestBlockHeight
+		}
+	}
+
+	for _, endpoint := range pool.endpoints {
+		status := endpoint.status()
+		if status.LastError != nil {
+			pool.logger.WithFields(usecase.LogFields{
+				"endpoint": endpoint.url,
+			}).Errorf("Tendermint endpoint is unhealthy: %s", *status.LastError)
+			continue
+		}
+
+		if pool.options.MaxLagBlocks > 0 && status.LatestBlockHeight+pool.options.MaxLagBlocks < highestBlockHeight {
+			err := fmt.Errorf(
+				"lagging behind at height %d while highest height is %d", status.LatestBlockHeight, highestBlockHeight,
+			)
+			endpoint.markUnhealthy(err)
+			pool.logger.WithFields(usecase.LogFields{
+				"endpoint": endpoint.url,
+			}).Errorf("Tendermint endpoint is unhealthy: %v", err)
+		}
+	}
+}
+
+func (pool *ClientPool) EndpointStatuses() []tendermintadapter.EndpointStatus {
+	statuses := make([]tendermintadapter.EndpointStatus, 0, len(pool.endpoints))
+	for _, endpoint := range pool.endpoints {
+		statuses = append(statuses, endpoint.status())
+	}
+
+	return statuses
+}
+
+func (pool *ClientPool) Genesis() (*types.Genesis, error) {
+	var genesis *types.Genesis
+	err := pool.request(uint64(0), func(client *HTTPClient) error {
+		var err error
+		genesis, err = client.Genesis()
+		return err
+	})
+
+	return genesis, err
+}
+
+func (pool *ClientPool) LatestBlockHeight() (uint64, error) {
+	var latestBlockHeight uint64
+	err := pool.request(uint64(0), func(client *HTTPClient) error {
+		var err error
+		latestBlockHeight, err = client.LatestBlockHeight()
+		return err
+	})
+
+	return latestBlockHeight, err
+}
+
+func (pool *ClientPool) BlockResults(height uint64) (*types.BlockResults, error) {
+	var blockResults *types.BlockResults
+	err := pool.request(height, func(client *HTTPClient) error {
+		var err error
+		blockResults, err = client.BlockResults(height)
+		return err
+	})
+
+	return blockResults, err
+}
+
+func (pool *ClientPool) Block(height uint64) (*types.Block, error) {
+	var block *types.Block
+	err := pool.request(height, func(client *HTTPClient) error {
+		var err error
+		block, err = client.Block(height)
+		return err
+	})
+
+	return block, err
+}
+
+func (pool *ClientPool) RawGenesis() ([]byte, error) {
+	var rawGenesis []byte
+	err := pool.request(uint64(0), func(client *HTTPClient) error {
+		var err error
+		rawGenesis, err = client.RawGenesis()
+		return err
+	})
+
+	return rawGenesis, err
+}
+
+func (pool *ClientPool) RawBlock(height uint64) ([]byte, error) {
+	var rawBlock []byte
+	err := pool.request(height, func(client *HTTPClient) error {
+		var err error
+		rawBlock, err = client.RawBlock(height)
+		return err
+	})
+
+	return rawBlock, err
+}
+
+func (pool *ClientPool) RawBlockResults(height uint64) ([]byte, error) {
+	var rawBlockResults []byte
+	err := pool.request(height, func(client *HTTPClient) error {
+		var err error
+		rawBlockResults, err = client.RawBlockResults(height)
+		return err
+	})
+
+	return rawBlockResults, err
+}
+
+// request tries the endpoints in turn, starting from the next endpoint in
+// round-robin, until one of them succeeds. Healthy endpoints known to have
+// reached the height are tried first. Unhealthy endpoints are only tried as a
+// last resort
+func (pool *ClientPool) request(height uint64, doRequest func(client *HTTPClient) error) error {
+	if len(pool.endpoints) == 0 {
+		return errors.New("error requesting Tendermint: no endpoint configured")
+	}
+
+	size := len(pool.endpoints)
+	start := int(atomic.AddUint32(&pool.nextIndex, 1)-1) % size
+
+	preferred := make([]*clientPoolEndpoint, 0, size)
+	fallback := make([]*clientPoolEndpoint, 0, size)
+	for i := 0; i < size; i += 1 {
+		endpoint := pool.endpoints[(start+i)%size]
+		if endpoint.isAvailableAt(height) {
+			preferred = append(preferred, endpoint)
+		} else {
+			fallback = append(fallback, endpoint)
+		}
+	}
+
+	var err error
+	for _, endpoint := range append(preferred, fallback...) {
+		if err = doRequest(endpoint.client); err == nil {
+			return nil
+		}
+
+		endpoint.markUnhealthy(err)
+		pool.logger.WithFields(usecase.LogFields{
+			"endpoint": endpoint.url,
+		}).Errorf("error requesting Tendermint endpoint, failing over to the next one: %v", err)
+	}
+
+	return fmt.Errorf("error requesting all Tendermint endpoints: %v", err)
+}
+
+type clientPoolEndpoint struct {
+	sync.RWMutex
+
+	url    string
+	client *HTTPClient
+
+	healthy           bool
+	latestBlockHeight uint64
+	lastError         *string
+	lastCheckedAt     *time.Time
+}
+
+// isAvailableAt returns true when the endpoint is healthy and not known to be
+// behind the height
+func (endpoint *clientPoolEndpoint) isAvailableAt(height uint64) bool {
+	endpoint.RLock()
+	defer endpoint.RUnlock()
+
+	if !endpoint.healthy {
+		return false
+	}
+	return endpoint.latestBlockHeight == 0 || endpoint.latestBlockHeight >= height
+}
+
+func (endpoint *clientPoolEndpoint) recordCheck(latestBlockHeight uint64, err error) {
+	endpoint.Lock()
+	defer endpoint.Unlock()
+
+	checkedAt := time.Now()
+	endpoint.lastCheckedAt = &checkedAt
+	if err != nil {
+		errMessage := err.Error()
+		endpoint.healthy = false
+		endpoint.lastError = &errMessage
+		return
+	}
+
+	endpoint.healthy = true
+	endpoint.latestBlockHeight = latestBlockHeight
+	endpoint.lastError = nil
+}
+
+// markUnhealthy excludes the endpoint until it passes the next health check
+func (endpoint *clientPoolEndpoint) markUnhealthy(err error) {
+	endpoint.Lock()
+	defer endpoint.Unlock()
+
+	errMessage := err.Error()
+	endpoint.healthy = false
+	endpoint.lastError = &errMessage
+}
+
+func (endpoint *clientPoolEndpoint) status() tendermintadapter.EndpointStatus {
+	endpoint.RLock()
+	defer endpoint.RUnlock()
+
+	return tendermintadapter.EndpointStatus{
+		URL:               endpoint.url,
+		Healthy:           endpoint.healthy,
+		LatestBlockHeight: endpoint.latestBlockHeight,
+		LastError:         endpoint.lastError,
+		LastCheckedAt:     endpoint.lastCheckedAt,
+	}
+}
+
+func (pool *ClientPool) UnconfirmedTxs() ([]string, error) {
+	var txs []string
+	err := pool.request(uint64(0), func(client *HTTPClient) error {
+		var err error
+		txs, err = client.UnconfirmedTxs()
+		return err
+	})
+
+	return txs, err
+}
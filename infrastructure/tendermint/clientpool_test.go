@@ -0,0 +1,167 @@
+package tendermint_test
+
+import (
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
+	"github.com/crypto-com/chainindex/infrastructure/tendermint"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+)
+
+func latestBlockResultsJSON(height uint64) string {
+	return fmt.Sprintf(`{
+	"jsonrpc": "2.0",
+	"id": -1,
+	"result": {
+		"height": "%d",
+		"txs_results": null,
+		"begin_block_events": null,
+		"end_block_events": null,
+		"validator_updates": null,
+		"consensus_param_updates": null
+	}
+}`, height)
+}
+
+var _ = Describe("ClientPool", func() {
+	var firstServer *ghttp.Server
+	var secondServer *ghttp.Server
+	var pool *tendermint.ClientPool
+
+	BeforeEach(func() {
+		firstServer = ghttp.NewServer()
+		secondServer = ghttp.NewServer()
+		pool = tendermint.NewClientPool(
+			new(FakeLogger),
+			new(FakeMetrics),
+			[]string{firstServer.URL(), secondServer.URL()},
+			tendermint.HTTPClientOptions{},
+			tendermint.ClientPoolOptions{
+				MaxLagBlocks: 10,
+			},
+		)
+	})
+
+	AfterEach(func() {
+		firstServer.Close()
+		secondServer.Close()
+	})
+
+	It("should implement Client, RawClient and EndpointStatusProvider", func() {
+		var _ tendermintadapter.Client = pool
+		var _ tendermintadapter.RawClient = pool
+		var _ tendermintadapter.EndpointStatusProvider = pool
+	})
+
+	Describe("Block", func() {
+		It("should spread requests across endpoints", func() {
+			for _, server := range []*ghttp.Server{firstServer, secondServer} {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/block", "height=3510"),
+						ghttp.RespondWith(http.StatusOK, BLOCK_JSON),
+					),
+				)
+			}
+
+			for i := 0; i < 2; i += 1 {
+				block, err := pool.Block(uint64(3510))
+				Expect(err).To(BeNil())
+				Expect(block.Height).To(Equal(uint64(3510)))
+			}
+			Expect(firstServer.ReceivedRequests()).To(HaveLen(1))
+			Expect(secondServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("should fail over to the next endpoint and mark the failing endpoint unhealthy", func() {
+			firstServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusInternalServerError, ""),
+			)
+			secondServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block", "height=3510"),
+					ghttp.RespondWith(http.StatusOK, BLOCK_JSON),
+				),
+			)
+
+			block, err := pool.Block(uint64(3510))
+			Expect(err).To(BeNil())
+			Expect(block.Height).To(Equal(uint64(3510)))
+
+			statuses := pool.EndpointStatuses()
+			Expect(statuses[0].Healthy).To(BeFalse())
+			Expect(*statuses[0].LastError).To(ContainSubstring("500"))
+			Expect(statuses[1].Healthy).To(BeTrue())
+		})
+
+		It("should return error when all endpoints fail", func() {
+			firstServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusInternalServerError, ""),
+			)
+			secondServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusInternalServerError, ""),
+			)
+
+			_, err := pool.Block(uint64(3510))
+			Expect(err).To(MatchError(ContainSubstring("error requesting all Tendermint endpoints")))
+		})
+	})
+
+	Describe("CheckHealth", func() {
+		It("should mark endpoint lagging behind unhealthy and skip it", func() {
+			firstServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusOK, latestBlockResultsJSON(3000)),
+			)
+			secondServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusOK, latestBlockResultsJSON(3600)),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block", "height=3510"),
+					ghttp.RespondWith(http.StatusOK, BLOCK_JSON),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block", "height=3510"),
+					ghttp.RespondWith(http.StatusOK, BLOCK_JSON),
+				),
+			)
+
+			pool.CheckHealth()
+
+			statuses := pool.EndpointStatuses()
+			Expect(statuses[0].Healthy).To(BeFalse())
+			Expect(statuses[0].LatestBlockHeight).To(Equal(uint64(3000)))
+			Expect(*statuses[0].LastError).To(ContainSubstring("lagging behind"))
+			Expect(statuses[1].Healthy).To(BeTrue())
+			Expect(statuses[1].LatestBlockHeight).To(Equal(uint64(3600)))
+			Expect(statuses[1].LastCheckedAt).NotTo(BeNil())
+
+			for i := 0; i < 2; i += 1 {
+				_, err := pool.Block(uint64(3510))
+				Expect(err).To(BeNil())
+			}
+			Expect(firstServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("should mark recovered endpoint healthy again", func() {
+			firstServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusInternalServerError, ""),
+				ghttp.RespondWith(http.StatusOK, latestBlockResultsJSON(3600)),
+			)
+			secondServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusOK, latestBlockResultsJSON(3600)),
+				ghttp.RespondWith(http.StatusOK, latestBlockResultsJSON(3600)),
+			)
+
+			pool.CheckHealth()
+			Expect(pool.EndpointStatuses()[0].Healthy).To(BeFalse())
+
+			pool.CheckHealth()
+			Expect(pool.EndpointStatuses()[0].Healthy).To(BeTrue())
+			Expect(pool.EndpointStatuses()[0].LastError).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,160 @@
+package tendermint
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/crypto-com/chainindex/adapter/tendermint/types"
+)
+
+const (
+	FILE_CLIENT_GENESIS_FILE       = "genesis.json"
+	FILE_CLIENT_BLOCK_DIR          = "block"
+	FILE_CLIENT_BLOCK_RESULTS_DIR  = "block_results"
+	FILE_CLIENT_RESPONSE_EXTENSION = ".json"
+)
+
+// FileClient reads Tendermint responses recorded in a directory instead of
+// requesting a Tendermint node. The directory contains verbatim responses of
+// the genesis, block and block_results endpoints:
+//
+//	genesis.json
+//	block/<height>.json
+//	block_results/<height>.json
+//
+// Responses are parsed in the same way as HTTPClient so that the indexer can
+// replay a recorded chain without network access.
+type FileClient struct {
+	dir string
+}
+
+func NewFileClient(dir string) *FileClient {
+	return &FileClient{
+		dir,
+	}
+}
+
+func (client *FileClient) Genesis() (*types.Genesis, error) {
+	rawGenesis, err := client.RawGenesis()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseGenesisResp(bytes.NewReader(rawGenesis))
+}
+
+// LatestBlockHeight returns the highest height of which both block and block
+// results are recorded
+func (client *FileClient) LatestBlockHeight() (uint64, error) {
+	blockHeights, err := client.recordedHeights(FILE_CLIENT_BLOCK_DIR)
+	if err != nil {
+		return uint64(0), err
+	}
+	blockResultsHeights, err := client.recordedHeights(FILE_CLIENT_BLOCK_RESULTS_DIR)
+	if err != nil {
+		return uint64(0), err
+	}
+
+	latestHeight := uint64(0)
+	for height := range blockResultsHeights {
+		if blockHeights[height] && height > latestHeight {
+			latestHeight = height
+		}
+	}
+
+	return latestHeight, nil
+}
+
+func (client *FileClient) BlockResults(height uint64) (*types.BlockResults, error) {
+	rawBlockResults, err := client.RawBlockResults(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlockResultsResp(bytes.NewReader(rawBlockResults))
+}
+
+func (client *FileClient) Block(height uint64) (*types.Block, error) {
+	rawBlock, err := client.RawBlock(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlockResp(bytes.NewReader(rawBlock))
+}
+
+// UnconfirmedTxs always returns no transaction because the mempool is not
+// recorded
+func (client *FileClient) UnconfirmedTxs() ([]string, error) {
+	return []string{}, nil
+}
+
+// RawGenesis returns the recorded response of Tendermint genesis endpoint
+func (client *FileClient) RawGenesis() ([]byte, error) {
+	return client.readFile(filepath.Join(client.dir, FILE_CLIENT_GENESIS_FILE))
+}
+
+// RawBlock returns the recorded response of Tendermint block endpoint
+func (client *FileClient) RawBlock(height uint64) ([]byte, error) {
+	return client.readFile(heightFilePath(client.dir, FILE_CLIENT_BLOCK_DIR, height))
+}
+
+// RawBlockResults returns the recorded response of Tendermint block_results
+// endpoint
+func (client *FileClient) RawBlockResults(height uint64) ([]byte, error) {
+	return client.readFile(heightFilePath(client.dir, FILE_CLIENT_BLOCK_RESULTS_DIR, height))
+}
+
+// heightFilePath returns the path of the response file at height in the sub
+// directory
+func heightFilePath(dir string, subDir string, height uint64) string {
+	return filepath.Join(dir, subDir, strconv.FormatUint(height, 10)+FILE_CLIENT_RESPONSE_EXTENSION)
+}
+
+// readFile returns error wrapping os.ErrNotExist when the response is not
+// recorded
+func (client *FileClient) readFile(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Tendermint response from file %s: %w", path, err)
+	}
+
+	return data, nil
+}
+
+// recordedHeights returns the heights of all response files in the sub
+// directory. A missing sub directory has no recorded height
+func (client *FileClient) recordedHeights(subDir string) (map[uint64]bool, error) {
+	dir, err := os.Open(filepath.Join(client.dir, subDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return make(map[uint64]bool), nil
+		}
+		return nil, fmt.Errorf("error opening Tendermint response directory %s: %v", subDir, err)
+	}
+	defer dir.Close()
+
+	names, err := dir.Readdirnames(-1)
+	if err != nil {
+		return nil, fmt.Errorf("error listing Tendermint response directory %s: %v", subDir, err)
+	}
+
+	heights := make(map[uint64]bool, len(names))
+	for _, name := range names {
+		if !strings.HasSuffix(name, FILE_CLIENT_RESPONSE_EXTENSION) {
+			continue
+		}
+		height, parseErr := strconv.ParseUint(strings.TrimSuffix(name, FILE_CLIENT_RESPONSE_EXTENSION), 10, 64)
+		if parseErr != nil {
+			continue
+		}
+		heights[height] = true
+	}
+
+	return heights, nil
+}
@@ -0,0 +1,116 @@
+package tendermint_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
+	"github.com/crypto-com/chainindex/infrastructure/tendermint"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+)
+
+var _ = Describe("FileClient", func() {
+	var dir string
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "fileclient")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	writeFile := func(path string, data string) {
+		path = filepath.Join(dir, path)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(data), 0644)).To(Succeed())
+	}
+
+	It("should implement Client and RawClient", func() {
+		var _ tendermintadapter.Client = tendermint.NewFileClient(dir)
+		var _ tendermintadapter.RawClient = tendermint.NewFileClient(dir)
+	})
+
+	Describe("Block", func() {
+		It("should return the same block as parsed from Tendermint node", func() {
+			server := ghttp.NewServer()
+			defer server.Close()
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block", "height=3510"),
+					ghttp.RespondWith(http.StatusOK, BLOCK_JSON),
+				),
+			)
+			expectedBlock, err := tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{}).Block(uint64(3510))
+			Expect(err).To(BeNil())
+
+			writeFile("block/3510.json", BLOCK_JSON)
+			client := tendermint.NewFileClient(dir)
+
+			block, err := client.Block(uint64(3510))
+			Expect(err).To(BeNil())
+			Expect(block).To(Equal(expectedBlock))
+		})
+
+		It("should return error wrapping os.ErrNotExist when block is not recorded", func() {
+			client := tendermint.NewFileClient(dir)
+
+			_, err := client.Block(uint64(3510))
+			Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+		})
+	})
+
+	Describe("BlockResults", func() {
+		It("should return parsed recorded block results", func() {
+			writeFile("block_results/3813.json", BLOCK_RESULTS_JSON)
+			client := tendermint.NewFileClient(dir)
+
+			blockResults, err := client.BlockResults(uint64(3813))
+			Expect(err).To(BeNil())
+			Expect(blockResults.Height).To(Equal(uint64(3813)))
+			Expect(blockResults.TxsEvents).To(HaveLen(2))
+		})
+	})
+
+	Describe("Genesis", func() {
+		It("should return parsed recorded genesis", func() {
+			writeFile("genesis.json", GENESIS_JSON)
+			client := tendermint.NewFileClient(dir)
+
+			genesis, err := client.Genesis()
+			Expect(err).To(BeNil())
+			Expect(genesis.ChainID).To(Equal("testnet-thaler-crypto-com-chain-42"))
+		})
+	})
+
+	Describe("LatestBlockHeight", func() {
+		It("should return the highest height of which both block and block results are recorded", func() {
+			writeFile("block/1.json", BLOCK_JSON)
+			writeFile("block/2.json", BLOCK_JSON)
+			writeFile("block/3.json", BLOCK_JSON)
+			writeFile("block_results/1.json", BLOCK_RESULTS_JSON)
+			writeFile("block_results/2.json", BLOCK_RESULTS_JSON)
+			writeFile("block_results/README", "")
+			client := tendermint.NewFileClient(dir)
+
+			height, err := client.LatestBlockHeight()
+			Expect(err).To(BeNil())
+			Expect(height).To(Equal(uint64(2)))
+		})
+
+		It("should return 0 when no block is recorded", func() {
+			client := tendermint.NewFileClient(dir)
+
+			height, err := client.LatestBlockHeight()
+			Expect(err).To(BeNil())
+			Expect(height).To(Equal(uint64(0)))
+		})
+	})
+})
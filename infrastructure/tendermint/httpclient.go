@@ -3,6 +3,7 @@ package tendermint
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"sort"
 	"strconv"
@@ -14,22 +15,39 @@ import (
 	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
 	"github.com/crypto-com/chainindex/adapter/tendermint/types"
 	"github.com/crypto-com/chainindex/internal/primptr"
+	"github.com/crypto-com/chainindex/usecase"
 )
 
+const DEFAULT_HTTP_CLIENT_TIMEOUT = 10 * time.Second
+
+// Maximum number of transactions Tendermint returns from unconfirmed_txs
+// endpoint in a single request
+const UNCONFIRMED_TXS_LIMIT = 100
+
 type HTTPClient struct {
 	httpClient *http.Client
 	serverUrl  string
+	metrics    usecase.Metrics
 }
 
-func NewHTTPClient(serverUrl string) *HTTPClient {
+type HTTPClientOptions struct {
+	// Timeout of each request including reading the response body
+	Timeout time.Duration
+}
+
+func NewHTTPClient(serverUrl string, metrics usecase.Metrics, options HTTPClientOptions) *HTTPClient {
+	if options.Timeout == 0 {
+		options.Timeout = DEFAULT_HTTP_CLIENT_TIMEOUT
+	}
+
 	httpClient := &http.Client{
-		// TODO: configurable timeout
-		Timeout: 10 * time.Second,
+		Timeout: options.Timeout,
 	}
 
 	return &HTTPClient{
 		httpClient,
 		serverUrl,
+		metrics,
 	}
 }
 
@@ -42,7 +60,7 @@ func (client *HTTPClient) Genesis() (*types.Genesis, error) {
 	}
 	defer rawRespBody.Close()
 
-	genesis, err := client.parseGenesisResp(rawRespBody)
+	genesis, err := parseGenesisResp(rawRespBody)
 	if err != nil {
 		return nil, err
 	}
@@ -50,24 +68,35 @@ func (client *HTTPClient) Genesis() (*types.Genesis, error) {
 	return genesis, nil
 }
 
-func (client *HTTPClient) parseGenesisResp(rawRespReader io.Reader) (*types.Genesis, error) {
+func parseGenesisResp(rawRespReader io.Reader) (*types.Genesis, error) {
 	var resp types.GenesisResp
 	if err := jsoniter.NewDecoder(rawRespReader).Decode(&resp); err != nil {
 		return nil, fmt.Errorf("error unmarshalling Tendermint genesis response: %v", err)
 	}
 
+	validators := make([]types.GenesisValidator, 0, len(resp.Result.Genesis.Validators))
+	for _, rawValidator := range resp.Result.Genesis.Validators {
+		validators = append(validators, types.GenesisValidator{
+			Address: tendermintadapter.AddressFromPubKey(rawValidator.PubKey.Value),
+			Power:   rawValidator.Power,
+		})
+	}
+
 	return &types.Genesis{
-		GenesisTime: resp.Result.Genesis.GenesisTime,
-		ChainID:     resp.Result.Genesis.ChainID,
-		AppHash:     resp.Result.Genesis.AppHash,
+		GenesisTime:     resp.Result.Genesis.GenesisTime,
+		ChainID:         resp.Result.Genesis.ChainID,
+		ConsensusParams: resp.Result.Genesis.ConsensusParams,
+		Validators:      validators,
+		AppHash:         resp.Result.Genesis.AppHash,
 		AppState: types.GenesisAppState{
-			CouncilNodes: client.parseGenesisCouncilNodes(resp.Result.Genesis.AppState.CouncilNodes),
-			Distribution: client.parseGenesisDistribution(resp.Result.Genesis.AppState.Distribution),
+			CouncilNodes:  parseGenesisCouncilNodes(resp.Result.Genesis.AppState.CouncilNodes),
+			Distribution:  parseGenesisDistribution(resp.Result.Genesis.AppState.Distribution),
+			NetworkParams: resp.Result.Genesis.AppState.NetworkParams,
 		},
 	}, nil
 }
 
-func (client *HTTPClient) parseGenesisCouncilNodes(rawNodes map[string][]interface{}) []types.GenesisCouncilNode {
+func parseGenesisCouncilNodes(rawNodes map[string][]interface{}) []types.GenesisCouncilNode {
 	nodes := make([]types.GenesisCouncilNode, 0, len(rawNodes))
 	for address, rawNode := range rawNodes {
 		pubkey, _ := rawNode[2].(map[string]interface{})
@@ -87,7 +116,7 @@ func (client *HTTPClient) parseGenesisCouncilNodes(rawNodes map[string][]interfa
 	return nodes
 }
 
-func (client *HTTPClient) parseGenesisDistribution(rawDistribution map[string][]string) []types.GenesisDistribution {
+func parseGenesisDistribution(rawDistribution map[string][]string) []types.GenesisDistribution {
 	distribution := make([]types.GenesisDistribution, 0, len(rawDistribution))
 	for stakingAddress, rawEntry := range rawDistribution {
 		distType := rawEntry[0]
@@ -126,7 +155,7 @@ func (client *HTTPClient) LatestBlockHeight() (uint64, error) {
 	}
 	defer rawRespBody.Close()
 
-	blockResults, err := client.parseBlockResultsResp(rawRespBody)
+	blockResults, err := parseBlockResultsResp(rawRespBody)
 	if err != nil {
 		return uint64(0), err
 	}
@@ -143,7 +172,7 @@ func (client *HTTPClient) BlockResults(height uint64) (*types.BlockResults, erro
 	}
 	defer rawRespBody.Close()
 
-	blockResults, err := client.parseBlockResultsResp(rawRespBody)
+	blockResults, err := parseBlockResultsResp(rawRespBody)
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +180,7 @@ func (client *HTTPClient) BlockResults(height uint64) (*types.BlockResults, erro
 	return blockResults, nil
 }
 
-func (client *HTTPClient) parseBlockResultsResp(rawRespReader io.Reader) (*types.BlockResults, error) {
+func parseBlockResultsResp(rawRespReader io.Reader) (*types.BlockResults, error) {
 	var err error
 
 	var resp types.BlockResultsResp
@@ -159,14 +188,21 @@ func (client *HTTPClient) parseBlockResultsResp(rawRespReader io.Reader) (*types
 		return nil, fmt.Errorf("error unmarshalling Tendermint block_results response: %v", err)
 	}
 
-	var txsResults [][]types.BlockResultsEvent
+	var txsEvents [][]types.BlockResultsEvent
+	var txsResults []types.BlockResultsTxResult
 	if resp.Result.TxsEvents != nil {
-		txsResults = client.parseBlockResultsTxsEvents(resp.Result.TxsEvents)
+		txsEvents = parseBlockResultsTxsEvents(resp.Result.TxsEvents)
+		txsResults = parseBlockResultsTxsResults(resp.Result.TxsEvents)
 	}
 
 	var beginBlockEvents []types.BlockResultsEvent
 	if resp.Result.BeginBlockEvents != nil {
-		beginBlockEvents = client.parseBlockResultsEvent(resp.Result.BeginBlockEvents)
+		beginBlockEvents = parseBlockResultsEvent(resp.Result.BeginBlockEvents)
+	}
+
+	var endBlockEvents []types.BlockResultsEvent
+	if resp.Result.EndBlockEvents != nil {
+		endBlockEvents = parseBlockResultsEvent(resp.Result.EndBlockEvents)
 	}
 
 	height, err := strconv.ParseUint(resp.Result.Height, 10, 64)
@@ -175,22 +211,37 @@ func (client *HTTPClient) parseBlockResultsResp(rawRespReader io.Reader) (*types
 	}
 	return &types.BlockResults{
 		Height:           uint64(height),
-		TxsEvents:        txsResults,
+		TxsEvents:        txsEvents,
+		TxsResults:       txsResults,
 		BeginBlockEvents: beginBlockEvents,
-		ValidatorUpdates: client.parseBlockResultsValidatorUpdates(resp.Result.ValidatorUpdates),
+		EndBlockEvents:   endBlockEvents,
+		ValidatorUpdates: parseBlockResultsValidatorUpdates(resp.Result.ValidatorUpdates),
 	}, nil
 }
 
-func (client *HTTPClient) parseBlockResultsTxsEvents(rawResults []types.RawBlockResultsTxResult) [][]types.BlockResultsEvent {
+func parseBlockResultsTxsResults(rawResults []types.RawBlockResultsTxResult) []types.BlockResultsTxResult {
+	results := make([]types.BlockResultsTxResult, 0, len(rawResults))
+	for _, rawResult := range rawResults {
+		results = append(results, types.BlockResultsTxResult{
+			Code:      uint32(rawResult.Code),
+			Log:       rawResult.Log,
+			Codespace: rawResult.Codespace,
+		})
+	}
+
+	return results
+}
+
+func parseBlockResultsTxsEvents(rawResults []types.RawBlockResultsTxResult) [][]types.BlockResultsEvent {
 	results := make([][]types.BlockResultsEvent, 0, len(rawResults))
 	for _, rawResult := range rawResults {
-		results = append(results, client.parseBlockResultsEvent(rawResult.Events))
+		results = append(results, parseBlockResultsEvent(rawResult.Events))
 	}
 
 	return results
 }
 
-func (client *HTTPClient) parseBlockResultsEvent(rawEvents []types.RawBlockResultsEvent) []types.BlockResultsEvent {
+func parseBlockResultsEvent(rawEvents []types.RawBlockResultsEvent) []types.BlockResultsEvent {
 	if rawEvents == nil {
 		return nil
 	}
@@ -213,7 +264,7 @@ func (client *HTTPClient) parseBlockResultsEvent(rawEvents []types.RawBlockResul
 	return events
 }
 
-func (client *HTTPClient) parseBlockResultsValidatorUpdates(rawUpdates []types.RawBlockResultsValidator) []types.BlockResultsValidator {
+func parseBlockResultsValidatorUpdates(rawUpdates []types.RawBlockResultsValidator) []types.BlockResultsValidator {
 	if rawUpdates == nil {
 		return nil
 	}
@@ -242,7 +293,7 @@ func (client *HTTPClient) Block(height uint64) (*types.Block, error) {
 	}
 	defer rawRespBody.Close()
 
-	block, err := client.parseBlockResp(rawRespBody)
+	block, err := parseBlockResp(rawRespBody)
 	if err != nil {
 		return nil, err
 	}
@@ -250,7 +301,7 @@ func (client *HTTPClient) Block(height uint64) (*types.Block, error) {
 	return block, nil
 }
 
-func (client *HTTPClient) parseBlockResp(rawRespReader io.Reader) (*types.Block, error) {
+func parseBlockResp(rawRespReader io.Reader) (*types.Block, error) {
 	var err error
 
 	var resp types.BlockResp
@@ -262,18 +313,77 @@ func (client *HTTPClient) parseBlockResp(rawRespReader io.Reader) (*types.Block,
 	if err != nil {
 		return nil, fmt.Errorf("error converting block height to unsigned integer: %v", err)
 	}
+	evidences, err := parseBlockEvidences(&resp)
+	if err != nil {
+		return nil, err
+	}
 	return &types.Block{
 		Height:         height,
 		Hash:           resp.Result.BlockID.Hash,
+		ChainID:        resp.Result.Block.Header.ChainID,
+		LastBlockHash:  resp.Result.Block.Header.LastBlockID.Hash,
 		Time:           resp.Result.Block.Header.Time,
 		AppHash:        resp.Result.Block.Header.AppHash,
 		PropserAddress: resp.Result.Block.Header.ProposerAddress,
 		Txs:            resp.Result.Block.Data.Txs,
-		Signatures:     client.parseBlockSignatures(resp.Result.Block.LastCommit.Signatures),
+		Signatures:     parseBlockSignatures(resp.Result.Block.LastCommit.Signatures),
+		Evidences:      evidences,
 	}, nil
 }
 
-func (client *HTTPClient) parseBlockSignatures(rawSignatures []types.RawBlockSignature) []types.BlockSignature {
+func parseBlockEvidences(resp *types.BlockResp) ([]types.BlockEvidence, error) {
+	rawEvidences := resp.Result.Block.Evidence.Evidence
+	if rawEvidences == nil {
+		return nil, nil
+	}
+
+	evidences := make([]types.BlockEvidence, 0, len(rawEvidences))
+	for i := range rawEvidences {
+		rawEvidence := &rawEvidences[i]
+		voteA, err := parseBlockEvidenceVote(&rawEvidence.Value.VoteA)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing vote A of evidence %d: %v", i, err)
+		}
+		voteB, err := parseBlockEvidenceVote(&rawEvidence.Value.VoteB)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing vote B of evidence %d: %v", i, err)
+		}
+
+		evidences = append(evidences, types.BlockEvidence{
+			Type:       rawEvidence.Type,
+			PubKeyType: rawEvidence.Value.PubKey.Type,
+			PubKey:     rawEvidence.Value.PubKey.Value,
+			VoteA:      *voteA,
+			VoteB:      *voteB,
+		})
+	}
+
+	return evidences, nil
+}
+
+func parseBlockEvidenceVote(rawVote *types.RawBlockEvidenceVote) (*types.BlockEvidenceVote, error) {
+	var err error
+
+	var vote types.BlockEvidenceVote
+	if vote.Height, err = strconv.ParseUint(rawVote.Height, 10, 64); err != nil {
+		return nil, fmt.Errorf("error converting vote height to unsigned integer: %v", err)
+	}
+	if vote.Round, err = strconv.ParseUint(rawVote.Round, 10, 64); err != nil {
+		return nil, fmt.Errorf("error converting vote round to unsigned integer: %v", err)
+	}
+	if vote.ValidatorIndex, err = strconv.ParseUint(rawVote.ValidatorIndex, 10, 64); err != nil {
+		return nil, fmt.Errorf("error converting vote validator index to unsigned integer: %v", err)
+	}
+	vote.Type = rawVote.Type
+	vote.BlockHash = rawVote.BlockID.Hash
+	vote.Timestamp = rawVote.Timestamp
+	vote.ValidatorAddress = rawVote.ValidatorAddress
+	vote.Signature = rawVote.Signature
+
+	return &vote, nil
+}
+
+func parseBlockSignatures(rawSignatures []types.RawBlockSignature) []types.BlockSignature {
 	if rawSignatures == nil {
 		return nil
 	}
@@ -292,7 +402,52 @@ func (client *HTTPClient) parseBlockSignatures(rawSignatures []types.RawBlockSig
 	return signatures
 }
 
+// UnconfirmedTxs returns at most UNCONFIRMED_TXS_LIMIT transactions in the
+// mempool of the node
+func (client *HTTPClient) UnconfirmedTxs() ([]string, error) {
+	var err error
+
+	rawRespBody, err := client.request("unconfirmed_txs", "limit="+strconv.Itoa(UNCONFIRMED_TXS_LIMIT))
+	if err != nil {
+		return nil, err
+	}
+	defer rawRespBody.Close()
+
+	return parseUnconfirmedTxsResp(rawRespBody)
+}
+
+func parseUnconfirmedTxsResp(rawRespReader io.Reader) ([]string, error) {
+	var err error
+
+	var resp types.UnconfirmedTxsResp
+	if err = jsoniter.NewDecoder(rawRespReader).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("error unmarshalling Tendermint unconfirmed_txs response: %v", err)
+	}
+
+	if resp.Result.Txs == nil {
+		return []string{}, nil
+	}
+	return resp.Result.Txs, nil
+}
+
 func (client *HTTPClient) request(method string, queryString ...string) (io.ReadCloser, error) {
+	startedAt := time.Now()
+	rawRespBody, err := client.doRequest(method, queryString...)
+	metricLabels := usecase.MetricLabels{
+		"method": method,
+	}
+	client.metrics.ObserveHistogram(
+		usecase.METRIC_TENDERMINT_RPC_DURATION_SECONDS, time.Since(startedAt).Seconds(), metricLabels,
+	)
+	if err != nil {
+		client.metrics.AddCounter(usecase.METRIC_TENDERMINT_RPC_ERRORS_TOTAL, 1, metricLabels)
+		return nil, err
+	}
+
+	return rawRespBody, nil
+}
+
+func (client *HTTPClient) doRequest(method string, queryString ...string) (io.ReadCloser, error) {
 	var err error
 
 	url := client.serverUrl + "/" + method
@@ -311,3 +466,34 @@ func (client *HTTPClient) request(method string, queryString ...string) (io.Read
 
 	return rawResp.Body, nil
 }
+
+// RawGenesis returns the verbatim response of Tendermint genesis endpoint
+func (client *HTTPClient) RawGenesis() ([]byte, error) {
+	return client.requestRaw("genesis")
+}
+
+// RawBlock returns the verbatim response of Tendermint block endpoint
+func (client *HTTPClient) RawBlock(height uint64) ([]byte, error) {
+	return client.requestRaw("block", "height="+strconv.FormatUint(height, 10))
+}
+
+// RawBlockResults returns the verbatim response of Tendermint block_results
+// endpoint
+func (client *HTTPClient) RawBlockResults(height uint64) ([]byte, error) {
+	return client.requestRaw("block_results", "height="+strconv.FormatUint(height, 10))
+}
+
+func (client *HTTPClient) requestRaw(method string, queryString ...string) ([]byte, error) {
+	rawRespBody, err := client.request(method, queryString...)
+	if err != nil {
+		return nil, err
+	}
+	defer rawRespBody.Close()
+
+	rawResp, err := ioutil.ReadAll(rawRespBody)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Tendermint %s response: %v", method, err)
+	}
+
+	return rawResp, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/crypto-com/chainindex/adapter/tendermint/types"
 	"github.com/crypto-com/chainindex/infrastructure/tendermint"
 	"github.com/crypto-com/chainindex/internal/primptr"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
 )
 
 var _ = Describe("HTTPClient", func() {
@@ -22,7 +23,7 @@ var _ = Describe("HTTPClient", func() {
 	})
 
 	It("should implement Client", func() {
-		var _ tendermintadapter.Client = tendermint.NewHTTPClient("http://localhost:26657")
+		var _ tendermintadapter.Client = tendermint.NewHTTPClient("http://localhost:26657", new(FakeMetrics), tendermint.HTTPClientOptions{})
 	})
 
 	Describe("Genesis", func() {
@@ -34,17 +35,58 @@ var _ = Describe("HTTPClient", func() {
 				),
 			)
 
-			client := tendermint.NewHTTPClient(server.URL())
+			client := tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{})
 
 			genesis, err := client.Genesis()
 			Expect(err).To(BeNil())
 
 			genesisTime, _ := time.Parse("2006-01-02T15:04:05.000000Z", "2020-05-01T12:09:01.568951Z")
+
+			var expectedConsensusParams types.GenesisConsensusParams
+			expectedConsensusParams.Block.MaxBytes = "22020096"
+			expectedConsensusParams.Block.MaxGas = "-1"
+			expectedConsensusParams.Block.TimeIotaMs = "1000"
+			expectedConsensusParams.Evidence.MaxAgeNumBlocks = "200"
+			expectedConsensusParams.Evidence.MaxAgeDuration = "5400000000000"
+			expectedConsensusParams.Validator.PubKeyTypes = []string{"ed25519"}
+
+			var expectedNetworkParams types.GenesisNetworkParams
+			expectedNetworkParams.InitialFeePolicy.Coefficient = 1250
+			expectedNetworkParams.InitialFeePolicy.Constant = 1100
+			expectedNetworkParams.JailingConfig.BlockSigningWindow = 720
+			expectedNetworkParams.JailingConfig.MissedBlockThreshold = 360
+			expectedNetworkParams.MaxValidators = 50
+			expectedNetworkParams.RequiredCouncilNodeStake = "5000000000000000"
+			expectedNetworkParams.RewardsConfig.MonetaryExpansionCap = "2000000000000000000"
+			expectedNetworkParams.RewardsConfig.MonetaryExpansionDecay = 999860
+			expectedNetworkParams.RewardsConfig.MonetaryExpansionR0 = 350
+			expectedNetworkParams.RewardsConfig.MonetaryExpansionTau = 999999999999999999
+			expectedNetworkParams.RewardsConfig.RewardPeriodSeconds = 86400
+			expectedNetworkParams.SlashingConfig.ByzantineSlashPercent = "0.200"
+			expectedNetworkParams.SlashingConfig.LivenessSlashPercent = "0.100"
+			expectedNetworkParams.UnbondingPeriod = 5400
+
 			Expect(*genesis).To(Equal(types.Genesis{
-				GenesisTime: genesisTime,
-				ChainID:     "testnet-thaler-crypto-com-chain-42",
-				AppHash:     "F62DDB49D7EB8ED0883C735A0FB7DE7F2A3FA322FCD2AA832F452A62B38607D5",
+				GenesisTime:     genesisTime,
+				ChainID:         "testnet-thaler-crypto-com-chain-42",
+				ConsensusParams: expectedConsensusParams,
+				Validators: []types.GenesisValidator{
+					{
+						Address: "FA7B721B5704DF98EF3ECD3796DDEF6AA2A80257",
+						Power:   "60000000",
+					},
+					{
+						Address: "7570B2D23A4C7B638BEFE02EB4FC7927BFDED6B7",
+						Power:   "60000000",
+					},
+					{
+						Address: "D527DAECDE0501CF2E785A8DC0D9F4A64760F0BB",
+						Power:   "60000000",
+					},
+				},
+				AppHash: "F62DDB49D7EB8ED0883C735A0FB7DE7F2A3FA322FCD2AA832F452A62B38607D5",
 				AppState: types.GenesisAppState{
+					NetworkParams: expectedNetworkParams,
 					CouncilNodes: []types.GenesisCouncilNode{
 						{
 							StakingAccountAddress: "0x6fc1e3124a7ed07f3710378b68f7046c7300179d",
@@ -148,7 +190,7 @@ var _ = Describe("HTTPClient", func() {
 				),
 			)
 
-			client := tendermint.NewHTTPClient(server.URL())
+			client := tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{})
 
 			blockHeight, err := client.LatestBlockHeight()
 			Expect(err).To(BeNil())
@@ -156,6 +198,56 @@ var _ = Describe("HTTPClient", func() {
 		})
 	})
 
+	Describe("UnconfirmedTxs", func() {
+		It("should return base64 encoded transactions in the mempool", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/unconfirmed_txs", "limit=100"),
+					ghttp.RespondWith(http.StatusOK, `{
+	"jsonrpc": "2.0",
+	"id": -1,
+	"result": {
+		"n_txs": "1",
+		"total": "1",
+		"total_bytes": "12",
+		"txs": ["AAAEBuKX03ws"]
+	}
+}`),
+				),
+			)
+
+			client := tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{})
+
+			txs, err := client.UnconfirmedTxs()
+			Expect(err).To(BeNil())
+			Expect(txs).To(Equal([]string{"AAAEBuKX03ws"}))
+		})
+
+		It("should return empty transactions when the mempool is empty", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/unconfirmed_txs", "limit=100"),
+					ghttp.RespondWith(http.StatusOK, `{
+	"jsonrpc": "2.0",
+	"id": -1,
+	"result": {
+		"n_txs": "0",
+		"total": "0",
+		"total_bytes": "0",
+		"txs": null
+	}
+}`),
+				),
+			)
+
+			client := tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{})
+
+			txs, err := client.UnconfirmedTxs()
+			Expect(err).To(BeNil())
+			Expect(txs).To(BeEmpty())
+		})
+	})
+
 	Describe("BlockResults", func() {
 		It("should return nil Events when there are no transactions nor events", func() {
 			server.AppendHandlers(
@@ -165,7 +257,7 @@ var _ = Describe("HTTPClient", func() {
 				),
 			)
 
-			client := tendermint.NewHTTPClient(server.URL())
+			client := tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{})
 
 			anyBlockHeight := uint64(1)
 			blockResults, err := client.BlockResults(anyBlockHeight)
@@ -173,7 +265,9 @@ var _ = Describe("HTTPClient", func() {
 			Expect(*blockResults).To(Equal(types.BlockResults{
 				Height:           anyBlockHeight,
 				TxsEvents:        nil,
+				TxsResults:       nil,
 				BeginBlockEvents: nil,
+				EndBlockEvents:   nil,
 				ValidatorUpdates: nil,
 			}))
 		})
@@ -186,7 +280,7 @@ var _ = Describe("HTTPClient", func() {
 				),
 			)
 
-			client := tendermint.NewHTTPClient(server.URL())
+			client := tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{})
 
 			anyBlockHeight := uint64(3813)
 			blockResults, err := client.BlockResults(anyBlockHeight)
@@ -225,6 +319,18 @@ var _ = Describe("HTTPClient", func() {
 						},
 					},
 				},
+				TxsResults: []types.BlockResultsTxResult{
+					{
+						Code:      0,
+						Log:       "",
+						Codespace: "",
+					},
+					{
+						Code:      0,
+						Log:       "",
+						Codespace: "",
+					},
+				},
 				BeginBlockEvents: []types.BlockResultsEvent{
 					{
 						Type: "staking_change",
@@ -287,6 +393,17 @@ var _ = Describe("HTTPClient", func() {
 						},
 					},
 				},
+				EndBlockEvents: []types.BlockResultsEvent{
+					{
+						Type: "block_filter",
+						Attributes: []types.BlockResultsEventAttribute{
+							{
+								Key:   "ZXRoYmxvb20=",
+								Value: "AAAABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA==",
+							},
+						},
+					},
+				},
 				ValidatorUpdates: []types.BlockResultsValidator{
 					{
 						PubKey: types.BlockResultsValidatorPubKey{
@@ -318,7 +435,7 @@ var _ = Describe("HTTPClient", func() {
 				),
 			)
 
-			client := tendermint.NewHTTPClient(server.URL())
+			client := tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{})
 
 			anyBlockHeight := uint64(1)
 			block, err := client.Block(anyBlockHeight)
@@ -327,6 +444,8 @@ var _ = Describe("HTTPClient", func() {
 			Expect(*block).To(Equal(types.Block{
 				Height:         anyBlockHeight,
 				Hash:           "BD3D9499EF527035BAE14E7F4932BD1778C1E90141A7A76A1FE67B7ECCC2663E",
+				ChainID:        "testnet-thaler-crypto-com-chain-42",
+				LastBlockHash:  "",
 				Time:           blockTime,
 				AppHash:        "F62DDB49D7EB8ED0883C735A0FB7DE7F2A3FA322FCD2AA832F452A62B38607D5",
 				PropserAddress: "D527DAECDE0501CF2E785A8DC0D9F4A64760F0BB",
@@ -343,15 +462,21 @@ var _ = Describe("HTTPClient", func() {
 				),
 			)
 
-			client := tendermint.NewHTTPClient(server.URL())
+			client := tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{})
 
 			anyBlockHeight := uint64(3510)
 			block, err := client.Block(anyBlockHeight)
 			Expect(err).To(BeNil())
 			blockTime, _ := time.Parse("2006-01-02T15:04:05.000000000Z", "2020-05-07T10:00:25.582998694Z")
+			parseTime := func(value string) time.Time {
+				t, _ := time.Parse(time.RFC3339Nano, value)
+				return t
+			}
 			Expect(*block).To(Equal(types.Block{
 				Height:         anyBlockHeight,
 				Hash:           "2CD22EA622D190B9ABCAB797E2F60F6F4FCFC19CC0A67642E5E7856CEAD78163",
+				ChainID:        "testnet-thaler-crypto-com-chain-42",
+				LastBlockHash:  "BE33BBB643E19D90DDE8FEA7334F43DF8479F3D41ACD91F3B89FF97742ED2C1A",
 				Time:           blockTime,
 				AppHash:        "4AC923568B9DE0AA67A04FC60CC4EA10ECC69D636AACFC519911BB448FEED222",
 				PropserAddress: "7570B2D23A4C7B638BEFE02EB4FC7927BFDED6B7",
@@ -377,9 +502,92 @@ var _ = Describe("HTTPClient", func() {
 						Signature:        "TuflcSDZPgVTM618J9JF/tlMFwM8Z/eWrHPjixzeWukIlkFHsNMprRRPnHUKZlu+yDdSwgj6eJ0PrqRm7y/eDw==",
 					},
 				},
+				Evidences: []types.BlockEvidence{
+					{
+						Type:       "tendermint/DuplicateVoteEvidence",
+						PubKeyType: "tendermint/PubKeyEd25519",
+						PubKey:     "rXhu7xhqYBtJftVLKxvKN0XnpyOzxFnUEfAhD1dEF/8=",
+						VoteA: types.BlockEvidenceVote{
+							Type:             1,
+							Height:           uint64(3509),
+							Round:            uint64(0),
+							BlockHash:        "2C278F10E96EB892FF456D91EB48CFFA31679692102A6FC6F600DC51ABAAE989",
+							Timestamp:        parseTime("2020-05-07T10:00:25.395605367Z"),
+							ValidatorAddress: "34C725CABA703269B3F1D1A907A84DE5FEE96469",
+							ValidatorIndex:   uint64(0),
+							Signature:        "tBWO1Wf9lf39WXpU2aJ1hzZF8nXl+D0izGt1FT1acA/nu/ezE2pVeQDgrU85b16ENTuYy375p2hdaXvyjESCBw==",
+						},
+						VoteB: types.BlockEvidenceVote{
+							Type:             1,
+							Height:           uint64(3509),
+							Round:            uint64(0),
+							BlockHash:        "BE33BBB643E19D90DDE8FEA7334F43DF8479F3D41ACD91F3B89FF97742ED2C1A",
+							Timestamp:        parseTime("2020-05-07T10:00:25.330002296Z"),
+							ValidatorAddress: "34C725CABA703269B3F1D1A907A84DE5FEE96469",
+							ValidatorIndex:   uint64(0),
+							Signature:        "LqTXlX4msVieutlPzQ9sQCa/ClvY4VYYDjfAZQBH7mT2cJjUS3r7hK8q4VUCktT0Regryrbb40Bts2SJaaYbBw==",
+						},
+					},
+					{
+						Type:       "tendermint/DuplicateVoteEvidence",
+						PubKeyType: "tendermint/PubKeyEd25519",
+						PubKey:     "rXhu7xhqYBtJftVLKxvKN0XnpyOzxFnUEfAhD1dEF/8=",
+						VoteA: types.BlockEvidenceVote{
+							Type:             2,
+							Height:           uint64(3509),
+							Round:            uint64(0),
+							BlockHash:        "",
+							Timestamp:        parseTime("2020-05-07T10:00:25.809310628Z"),
+							ValidatorAddress: "34C725CABA703269B3F1D1A907A84DE5FEE96469",
+							ValidatorIndex:   uint64(0),
+							Signature:        "q3abt8718Z1jZcVPjrkqhxmrYM40HEIEzg/1Cu0NbwFkXJBhz1gNRueLJ8jZ1RTu0hbTTLoHUApE0lOdv4efAg==",
+						},
+						VoteB: types.BlockEvidenceVote{
+							Type:             2,
+							Height:           uint64(3509),
+							Round:            uint64(0),
+							BlockHash:        "BE33BBB643E19D90DDE8FEA7334F43DF8479F3D41ACD91F3B89FF97742ED2C1A",
+							Timestamp:        parseTime("2020-05-07T10:00:25.617739872Z"),
+							ValidatorAddress: "34C725CABA703269B3F1D1A907A84DE5FEE96469",
+							ValidatorIndex:   uint64(0),
+							Signature:        "W6wNdtDCsUbdodju57/2BlkmUjo6U0PH+Cf19u4RSlaBYS7svNpZOgdQqmXJnUInRjGJp8opE7a9FnnHe3oTAw==",
+						},
+					},
+				},
 			}))
 		})
 	})
+
+	Describe("RawBlock", func() {
+		It("should return verbatim block response", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block", "height=3510"),
+					ghttp.RespondWith(http.StatusOK, BLOCK_JSON),
+				),
+			)
+
+			client := tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{})
+
+			rawBlock, err := client.RawBlock(uint64(3510))
+			Expect(err).To(BeNil())
+			Expect(string(rawBlock)).To(Equal(BLOCK_JSON))
+		})
+
+		It("should return error when Tendermint responds with error status", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block", "height=3510"),
+					ghttp.RespondWith(http.StatusInternalServerError, ""),
+				),
+			)
+
+			client := tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{})
+
+			_, err := client.RawBlock(uint64(3510))
+			Expect(err).NotTo(BeNil())
+		})
+	})
 })
 
 const (
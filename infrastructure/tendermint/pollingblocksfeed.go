@@ -1,6 +1,7 @@
 package tendermint
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -32,27 +33,32 @@ func NewPollingBlocksFeed(
 	}
 }
 
-func (feed *PollingBlocksFeed) Subscribe(subscriberCh chan<- uint64) error {
+func (feed *PollingBlocksFeed) Subscribe(ctx context.Context, subscriberCh chan<- uint64) error {
 	if feed.subscribed {
 		return errors.New("error subscribing to blocks feed: already subscrbed")
 	}
 
 	feed.subscribed = true
 	go func() {
-		_ = feed.pollInBackground(subscriberCh)
+		feed.pollInBackground(ctx, subscriberCh)
 	}()
 
 	return nil
 }
 
-func (feed *PollingBlocksFeed) pollInBackground(subscriberCh chan<- uint64) error {
+func (feed *PollingBlocksFeed) pollInBackground(ctx context.Context, subscriberCh chan<- uint64) {
 	for {
 		err := feed.Poll(subscriberCh)
 		if err != nil {
 			feed.logger.Errorf("error polling latest block height: %v", err)
 		}
 
-		<-time.After(feed.options.PollingInterval)
+		select {
+		case <-ctx.Done():
+			feed.logger.Info("stop polling")
+			return
+		case <-time.After(feed.options.PollingInterval):
+		}
 	}
 }
 
@@ -0,0 +1,172 @@
+package tendermint
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
+	"github.com/crypto-com/chainindex/adapter/tendermint/types"
+)
+
+type RecordingClientOptions struct {
+	// Only blocks and block results within the height range are recorded. The
+	// range is unbounded on the side which is 0
+	FromHeight uint64
+	ToHeight   uint64
+}
+
+// RecordingClient requests Tendermint responses from the underlying raw client
+// and writes them verbatim into a fixtures directory before parsing. The
+// directory has the same layout as read by FileClient, so that a recorded
+// chain can be replayed offline
+type RecordingClient struct {
+	client  tendermintadapter.RawClient
+	dir     string
+	options RecordingClientOptions
+}
+
+func NewRecordingClient(
+	client tendermintadapter.RawClient,
+	dir string,
+	options RecordingClientOptions,
+) *RecordingClient {
+	return &RecordingClient{
+		client,
+		dir,
+		options,
+	}
+}
+
+func (client *RecordingClient) Genesis() (*types.Genesis, error) {
+	rawGenesis, err := client.RawGenesis()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseGenesisResp(bytes.NewReader(rawGenesis))
+}
+
+func (client *RecordingClient) LatestBlockHeight() (uint64, error) {
+	return client.client.LatestBlockHeight()
+}
+
+func (client *RecordingClient) BlockResults(height uint64) (*types.BlockResults, error) {
+	rawBlockResults, err := client.RawBlockResults(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlockResultsResp(bytes.NewReader(rawBlockResults))
+}
+
+func (client *RecordingClient) Block(height uint64) (*types.Block, error) {
+	rawBlock, err := client.RawBlock(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlockResp(bytes.NewReader(rawBlock))
+}
+
+// UnconfirmedTxs is passed through to the underlying raw client without
+// recording because the mempool is not part of the chain
+func (client *RecordingClient) UnconfirmedTxs() ([]string, error) {
+	return client.client.UnconfirmedTxs()
+}
+
+// RawGenesis returns the verbatim genesis response after recording it
+func (client *RecordingClient) RawGenesis() ([]byte, error) {
+	rawGenesis, err := client.client.RawGenesis()
+	if err != nil {
+		return nil, err
+	}
+	if err = client.writeFile(filepath.Join(client.dir, FILE_CLIENT_GENESIS_FILE), rawGenesis); err != nil {
+		return nil, fmt.Errorf("error recording genesis: %v", err)
+	}
+
+	return rawGenesis, nil
+}
+
+// RawBlock returns the verbatim block response after recording it when the
+// height is within the height range
+func (client *RecordingClient) RawBlock(height uint64) ([]byte, error) {
+	rawBlock, err := client.client.RawBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	if client.isRecording(height) {
+		if err = client.writeFile(heightFilePath(client.dir, FILE_CLIENT_BLOCK_DIR, height), rawBlock); err != nil {
+			return nil, fmt.Errorf("error recording block at height %d: %v", height, err)
+		}
+	}
+
+	return rawBlock, nil
+}
+
+// RawBlockResults returns the verbatim block_results response after recording
+// it when the height is within the height range
+func (client *RecordingClient) RawBlockResults(height uint64) ([]byte, error) {
+	rawBlockResults, err := client.client.RawBlockResults(height)
+	if err != nil {
+		return nil, err
+	}
+	if client.isRecording(height) {
+		if err = client.writeFile(
+			heightFilePath(client.dir, FILE_CLIENT_BLOCK_RESULTS_DIR, height), rawBlockResults,
+		); err != nil {
+			return nil, fmt.Errorf("error recording block results at height %d: %v", height, err)
+		}
+	}
+
+	return rawBlockResults, nil
+}
+
+func (client *RecordingClient) isRecording(height uint64) bool {
+	if client.options.FromHeight != 0 && height < client.options.FromHeight {
+		return false
+	}
+	if client.options.ToHeight != 0 && height > client.options.ToHeight {
+		return false
+	}
+
+	return true
+}
+
+// writeFile writes into a temporary file and renames it, so that a partially
+// written response is never read by FileClient
+func (client *RecordingClient) writeFile(path string, data []byte) error {
+	var err error
+
+	dir := filepath.Dir(path)
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("error creating directory %s: %v", dir, err)
+	}
+
+	tmpFile, err := ioutil.TempFile(dir, ".recording-*")
+	if err != nil {
+		return fmt.Errorf("error creating temporary file: %v", err)
+	}
+	defer func() {
+		// Removing the renamed temporary file has no effect
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	if _, err = tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return fmt.Errorf("error writing file %s: %v", path, err)
+	}
+	if err = tmpFile.Close(); err != nil {
+		return fmt.Errorf("error writing file %s: %v", path, err)
+	}
+	if err = os.Chmod(tmpFile.Name(), 0644); err != nil {
+		return fmt.Errorf("error writing file %s: %v", path, err)
+	}
+	if err = os.Rename(tmpFile.Name(), path); err != nil {
+		return fmt.Errorf("error writing file %s: %v", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package tendermint_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
+	"github.com/crypto-com/chainindex/infrastructure/tendermint"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+)
+
+var _ = Describe("RecordingClient", func() {
+	var server *ghttp.Server
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		server = ghttp.NewServer()
+		dir, err = ioutil.TempDir("", "recordingclient")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		_ = os.RemoveAll(dir)
+	})
+
+	newHTTPClient := func() *tendermint.HTTPClient {
+		return tendermint.NewHTTPClient(server.URL(), new(FakeMetrics), tendermint.HTTPClientOptions{})
+	}
+
+	It("should implement Client and RawClient", func() {
+		var _ tendermintadapter.Client = tendermint.NewRecordingClient(
+			newHTTPClient(), dir, tendermint.RecordingClientOptions{},
+		)
+		var _ tendermintadapter.RawClient = tendermint.NewRecordingClient(
+			newHTTPClient(), dir, tendermint.RecordingClientOptions{},
+		)
+	})
+
+	Describe("Genesis", func() {
+		It("should record verbatim genesis response before returning parsed genesis", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/genesis"),
+					ghttp.RespondWith(http.StatusOK, GENESIS_JSON),
+				),
+			)
+
+			client := tendermint.NewRecordingClient(newHTTPClient(), dir, tendermint.RecordingClientOptions{})
+
+			genesis, err := client.Genesis()
+			Expect(err).To(BeNil())
+			Expect(genesis.ChainID).To(Equal("testnet-thaler-crypto-com-chain-42"))
+
+			recorded, err := ioutil.ReadFile(filepath.Join(dir, "genesis.json"))
+			Expect(err).To(BeNil())
+			Expect(string(recorded)).To(Equal(GENESIS_JSON))
+		})
+	})
+
+	Describe("Block", func() {
+		It("should record verbatim block response which is replayed as the same block", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block", "height=3510"),
+					ghttp.RespondWith(http.StatusOK, BLOCK_JSON),
+				),
+			)
+
+			client := tendermint.NewRecordingClient(newHTTPClient(), dir, tendermint.RecordingClientOptions{})
+
+			block, err := client.Block(uint64(3510))
+			Expect(err).To(BeNil())
+
+			recorded, err := ioutil.ReadFile(filepath.Join(dir, "block", "3510.json"))
+			Expect(err).To(BeNil())
+			Expect(string(recorded)).To(Equal(BLOCK_JSON))
+
+			replayedBlock, err := tendermint.NewFileClient(dir).Block(uint64(3510))
+			Expect(err).To(BeNil())
+			Expect(replayedBlock).To(Equal(block))
+		})
+
+		It("should not record block outside of the height range", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block", "height=3510"),
+					ghttp.RespondWith(http.StatusOK, BLOCK_JSON),
+				),
+			)
+
+			client := tendermint.NewRecordingClient(newHTTPClient(), dir, tendermint.RecordingClientOptions{
+				FromHeight: uint64(1),
+				ToHeight:   uint64(3509),
+			})
+
+			block, err := client.Block(uint64(3510))
+			Expect(err).To(BeNil())
+			Expect(block.Height).To(Equal(uint64(3510)))
+
+			_, err = os.Stat(filepath.Join(dir, "block", "3510.json"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("should return error and not record when requesting fails", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block", "height=3510"),
+					ghttp.RespondWith(http.StatusInternalServerError, ""),
+				),
+			)
+
+			client := tendermint.NewRecordingClient(newHTTPClient(), dir, tendermint.RecordingClientOptions{})
+
+			_, err := client.Block(uint64(3510))
+			Expect(err).NotTo(BeNil())
+
+			_, err = os.Stat(filepath.Join(dir, "block", "3510.json"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Describe("BlockResults", func() {
+		It("should record verbatim block results response within the height range", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/block_results", "height=3813"),
+					ghttp.RespondWith(http.StatusOK, BLOCK_RESULTS_JSON),
+				),
+			)
+
+			client := tendermint.NewRecordingClient(newHTTPClient(), dir, tendermint.RecordingClientOptions{
+				FromHeight: uint64(3813),
+			})
+
+			blockResults, err := client.BlockResults(uint64(3813))
+			Expect(err).To(BeNil())
+			Expect(blockResults.Height).To(Equal(uint64(3813)))
+
+			recorded, err := ioutil.ReadFile(filepath.Join(dir, "block_results", "3813.json"))
+			Expect(err).To(BeNil())
+			Expect(string(recorded)).To(Equal(BLOCK_RESULTS_JSON))
+		})
+	})
+})
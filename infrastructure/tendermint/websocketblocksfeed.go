@@ -0,0 +1,301 @@
+package tendermint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	jsoniter "github.com/json-iterator/go"
+
+	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+const NEW_BLOCK_SUBSCRIPTION_QUERY = "tm.event='NewBlock'"
+const DEFAULT_MIN_RECONNECT_BACKOFF = 1 * time.Second
+const DEFAULT_FALLBACK_POLLING_INTERVAL = 5 * time.Second
+
+// WebSocketBlocksFeed subscribes to Tendermint NewBlock events through the
+// /websocket endpoint. When the connection is lost it falls back to polling
+// the latest block height while reconnecting with exponential backoff.
+type WebSocketBlocksFeed struct {
+	logger       usecase.Logger
+	websocketURL string
+	options      WebSocketBlocksFeedOptions
+
+	pollingFeed *PollingBlocksFeed
+	subscribed  bool
+}
+
+func NewWebSocketBlocksFeed(
+	logger usecase.Logger,
+	client tendermintadapter.Client,
+	websocketURL string,
+	options WebSocketBlocksFeedOptions,
+) *WebSocketBlocksFeed {
+	if options.FallbackPollingInterval == 0 {
+		options.FallbackPollingInterval = DEFAULT_FALLBACK_POLLING_INTERVAL
+	}
+	if options.MinReconnectBackoff == 0 {
+		options.MinReconnectBackoff = DEFAULT_MIN_RECONNECT_BACKOFF
+	}
+	if options.MaxReconnectBackoff < options.MinReconnectBackoff {
+		options.MaxReconnectBackoff = options.MinReconnectBackoff
+	}
+
+	return &WebSocketBlocksFeed{
+		logger: logger.WithFields(usecase.LogFields{
+			"module": "WebSocketBlocksFeed",
+		}),
+		websocketURL: websocketURL,
+		options:      options,
+
+		pollingFeed: NewPollingBlocksFeed(logger, client, PollingBlocksFeedOptions{
+			PollingInterval: options.FallbackPollingInterval,
+		}),
+	}
+}
+
+func (feed *WebSocketBlocksFeed) Subscribe(ctx context.Context, subscriberCh chan<- uint64) error {
+	if feed.subscribed {
+		return errors.New("error subscribing to blocks feed: already subscrbed")
+	}
+
+	feed.subscribed = true
+	go feed.runInBackground(ctx, subscriberCh)
+
+	return nil
+}
+
+func (feed *WebSocketBlocksFeed) runInBackground(ctx context.Context, subscriberCh chan<- uint64) {
+	backoff := feed.options.MinReconnectBackoff
+	for {
+		connected, err := feed.Listen(ctx, subscriberCh)
+		if ctx.Err() != nil {
+			feed.logger.Info("stop listening to NewBlock events")
+			return
+		}
+		if err != nil {
+			feed.logger.Errorf("error listening to NewBlock events: %v", err)
+		}
+		if connected {
+			backoff = feed.options.MinReconnectBackoff
+		}
+
+		feed.logger.Infof("websocket disconnected, polling block height before reconnecting in %s", backoff)
+		if !feed.pollUntil(ctx, subscriberCh, time.Now().Add(backoff)) {
+			feed.logger.Info("stop polling")
+			return
+		}
+
+		backoff *= 2
+		if backoff > feed.options.MaxReconnectBackoff {
+			backoff = feed.options.MaxReconnectBackoff
+		}
+	}
+}
+
+// pollUntil falls back to polling the latest block height until the deadline.
+// It returns false when the context is done before the deadline
+func (feed *WebSocketBlocksFeed) pollUntil(ctx context.Context, subscriberCh chan<- uint64, deadline time.Time) bool {
+	for {
+		if err := feed.pollingFeed.Poll(subscriberCh); err != nil {
+			feed.logger.Errorf("error polling latest block height: %v", err)
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return true
+		}
+		if remaining > feed.options.FallbackPollingInterval {
+			remaining = feed.options.FallbackPollingInterval
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(remaining):
+		}
+	}
+}
+
+// Listen connects to the websocket endpoint, subscribes to NewBlock events and
+// broadcasts heights to the subscriber until the connection is closed or the
+// context is done. It returns whether the subscription has been established.
+func (feed *WebSocketBlocksFeed) Listen(ctx context.Context, subscriberCh chan<- uint64) (bool, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, feed.websocketURL, nil)
+	if err != nil {
+		return false, fmt.Errorf("error connecting to %s: %v", feed.websocketURL, err)
+	}
+	defer conn.Close()
+
+	// Unblock ReadMessage by closing the connection once the context is done
+	listenDoneCh := make(chan bool)
+	defer close(listenDoneCh)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close()
+		case <-listenDoneCh:
+		}
+	}()
+
+	if err = conn.WriteJSON(newSubscribeRequest(NEW_BLOCK_SUBSCRIPTION_QUERY)); err != nil {
+		return false, fmt.Errorf("error sending subscribe request: %v", err)
+	}
+	feed.extendReadDeadline(conn)
+	conn.SetPingHandler(func(appData string) error {
+		feed.extendReadDeadline(conn)
+		return conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(time.Second))
+	})
+
+	connected := false
+	for {
+		_, message, readErr := conn.ReadMessage()
+		if readErr != nil {
+			return connected, fmt.Errorf("error reading message: %v", readErr)
+		}
+		feed.extendReadDeadline(conn)
+
+		maybeHeight, parseErr := ParseNewBlockEventHeight(message)
+		if parseErr != nil {
+			return connected, parseErr
+		}
+		if !connected {
+			connected = true
+			feed.logger.Info("subscribed to NewBlock events")
+			// Catch up with blocks produced while the subscription was not ready
+			if err := feed.pollingFeed.Poll(subscriberCh); err != nil {
+				feed.logger.Errorf("error polling latest block height: %v", err)
+			}
+		}
+		if maybeHeight == nil {
+			continue
+		}
+
+		feed.broadcast(ctx, subscriberCh, *maybeHeight)
+	}
+}
+
+func (feed *WebSocketBlocksFeed) extendReadDeadline(conn *websocket.Conn) {
+	if feed.options.ReadTimeout == 0 {
+		return
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(feed.options.ReadTimeout))
+}
+
+func (feed *WebSocketBlocksFeed) broadcast(ctx context.Context, subscriberCh chan<- uint64, height uint64) {
+	if height <= feed.pollingFeed.lastBroadcastedHeight {
+		return
+	}
+
+	feed.logger.WithFields(usecase.LogFields{
+		"height": height,
+	}).Debug("broadcasting new block height to subscriber")
+	select {
+	case <-ctx.Done():
+	case subscriberCh <- height:
+		feed.pollingFeed.lastBroadcastedHeight = height
+	}
+}
+
+type WebSocketBlocksFeedOptions struct {
+	FallbackPollingInterval time.Duration
+	MinReconnectBackoff     time.Duration
+	MaxReconnectBackoff     time.Duration
+	// Maximum duration without any message or ping before the connection is
+	// considered dead. Zero means no timeout.
+	ReadTimeout time.Duration
+}
+
+// WebSocketURLFromHTTPRPCURL returns the websocket endpoint of a Tendermint
+// HTTP RPC URL. e.g. http://localhost:26657 -> ws://localhost:26657/websocket
+func WebSocketURLFromHTTPRPCURL(httpRPCURL string) (string, error) {
+	rpcURL, err := url.Parse(httpRPCURL)
+	if err != nil {
+		return "", fmt.Errorf("error parsing Tendermint HTTP RPC URL: %v", err)
+	}
+
+	switch rpcURL.Scheme {
+	case "http":
+		rpcURL.Scheme = "ws"
+	case "https":
+		rpcURL.Scheme = "wss"
+	case "ws", "wss":
+	default:
+		return "", fmt.Errorf("error parsing Tendermint HTTP RPC URL: unsupported scheme %s", rpcURL.Scheme)
+	}
+	rpcURL.Path = strings.TrimSuffix(rpcURL.Path, "/") + "/websocket"
+
+	return rpcURL.String(), nil
+}
+
+// ParseNewBlockEventHeight parses a JSON-RPC message received from the
+// websocket endpoint. It returns the block height when the message is a
+// NewBlock event and nil when it is any other successful response.
+func ParseNewBlockEventHeight(message []byte) (*uint64, error) {
+	var resp NewBlockEventResp
+	if err := jsoniter.Unmarshal(message, &resp); err != nil {
+		return nil, fmt.Errorf("error decoding websocket message: %v", err)
+	}
+	if resp.Error != nil {
+		return nil, fmt.Errorf(
+			"error from websocket endpoint: %d %s %s", resp.Error.Code, resp.Error.Message, resp.Error.Data,
+		)
+	}
+
+	if resp.Result.Data.Value.Block == nil {
+		return nil, nil
+	}
+	height, err := strconv.ParseUint(resp.Result.Data.Value.Block.Header.Height, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing NewBlock event height: %v", err)
+	}
+
+	return &height, nil
+}
+
+func newSubscribeRequest(query string) JSONRPCRequest {
+	return JSONRPCRequest{
+		JSONRPC: "2.0",
+		ID:      0,
+		Method:  "subscribe",
+		Params: map[string]string{
+			"query": query,
+		},
+	}
+}
+
+type JSONRPCRequest struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      int               `json:"id"`
+	Method  string            `json:"method"`
+	Params  map[string]string `json:"params"`
+}
+
+type NewBlockEventResp struct {
+	Error  *JSONRPCError `json:"error"`
+	Result struct {
+		Query string `json:"query"`
+		Data  struct {
+			Type  string `json:"type"`
+			Value struct {
+				Block *struct {
+					Header struct {
+						Height string `json:"height"`
+					} `json:"header"`
+				} `json:"block"`
+			} `json:"value"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+type JSONRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data"`
+}
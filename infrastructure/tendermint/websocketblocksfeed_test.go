@@ -0,0 +1,246 @@
+package tendermint_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	tendermintadapter "github.com/crypto-com/chainindex/adapter/tendermint"
+	. "github.com/crypto-com/chainindex/adapter/tendermint/test/mock"
+	"github.com/crypto-com/chainindex/infrastructure/tendermint"
+	. "github.com/crypto-com/chainindex/usecase/test/fake"
+)
+
+var _ = Describe("WebSocketBlocksFeed", func() {
+	It("should implement BlocksFeed", func() {
+		anyLogger := new(FakeLogger)
+		anyTendermintClient := new(MockTendermintClient)
+		anyOptions := tendermint.WebSocketBlocksFeedOptions{}
+
+		var _ tendermintadapter.BlocksFeed = tendermint.NewWebSocketBlocksFeed(
+			anyLogger,
+			anyTendermintClient,
+			"ws://localhost:26657/websocket",
+			anyOptions,
+		)
+	})
+
+	Describe("Subscribe", func() {
+		var ctx context.Context
+		var cancel context.CancelFunc
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		It("should send heights of NewBlock events to the subscriber channel", func() {
+			server, _ := newNewBlockEventServer([]string{
+				NEW_BLOCK_SUBSCRIBE_RESULT_JSON,
+				newBlockEventJSON("1001"),
+				newBlockEventJSON("1002"),
+			})
+			defer server.Close()
+
+			mockTendermintClient := new(MockTendermintClient)
+			mockTendermintClient.On("LatestBlockHeight").Return(uint64(1000), nil)
+
+			feed := tendermint.NewWebSocketBlocksFeed(
+				new(FakeLogger),
+				mockTendermintClient,
+				"ws"+strings.TrimPrefix(server.URL, "http"),
+				tendermint.WebSocketBlocksFeedOptions{
+					MinReconnectBackoff: 10 * time.Second,
+				},
+			)
+
+			subscriberCh := make(chan uint64, 3)
+			err := feed.Subscribe(ctx, subscriberCh)
+			Expect(err).To(BeNil())
+
+			Eventually(subscriberCh).Should(Receive(Equal(uint64(1000))))
+			Eventually(subscriberCh).Should(Receive(Equal(uint64(1001))))
+			Eventually(subscriberCh).Should(Receive(Equal(uint64(1002))))
+		})
+
+		It("should fall back to polling when the websocket endpoint is unavailable", func() {
+			mockTendermintClient := new(MockTendermintClient)
+			mockTendermintClient.On("LatestBlockHeight").Return(uint64(1000), nil)
+
+			feed := tendermint.NewWebSocketBlocksFeed(
+				new(FakeLogger),
+				mockTendermintClient,
+				"ws://127.0.0.1:1/websocket",
+				tendermint.WebSocketBlocksFeedOptions{
+					MinReconnectBackoff: 10 * time.Second,
+				},
+			)
+
+			subscriberCh := make(chan uint64, 1)
+			err := feed.Subscribe(ctx, subscriberCh)
+			Expect(err).To(BeNil())
+
+			Eventually(subscriberCh).Should(Receive(Equal(uint64(1000))))
+		})
+
+		It("should close the websocket connection when the context is done", func() {
+			server, disconnectedCh := newNewBlockEventServer([]string{
+				NEW_BLOCK_SUBSCRIBE_RESULT_JSON,
+			})
+			defer server.Close()
+
+			mockTendermintClient := new(MockTendermintClient)
+			mockTendermintClient.On("LatestBlockHeight").Return(uint64(1000), nil)
+
+			feed := tendermint.NewWebSocketBlocksFeed(
+				new(FakeLogger),
+				mockTendermintClient,
+				"ws"+strings.TrimPrefix(server.URL, "http"),
+				tendermint.WebSocketBlocksFeedOptions{
+					MinReconnectBackoff: 10 * time.Second,
+				},
+			)
+
+			subscriberCh := make(chan uint64, 1)
+			err := feed.Subscribe(ctx, subscriberCh)
+			Expect(err).To(BeNil())
+			Eventually(subscriberCh).Should(Receive(Equal(uint64(1000))))
+			Consistently(disconnectedCh).ShouldNot(BeClosed())
+
+			cancel()
+
+			Eventually(disconnectedCh).Should(BeClosed())
+		})
+
+		It("should return error when subscribing twice", func() {
+			mockTendermintClient := new(MockTendermintClient)
+			mockTendermintClient.On("LatestBlockHeight").Return(uint64(0), errors.New("unavailable"))
+
+			feed := tendermint.NewWebSocketBlocksFeed(
+				new(FakeLogger),
+				mockTendermintClient,
+				"ws://127.0.0.1:1/websocket",
+				tendermint.WebSocketBlocksFeedOptions{},
+			)
+
+			Expect(feed.Subscribe(ctx, make(chan uint64, 1))).To(BeNil())
+			Expect(feed.Subscribe(ctx, make(chan uint64, 1))).NotTo(BeNil())
+		})
+	})
+
+	Describe("ParseNewBlockEventHeight", func() {
+		It("should return height of NewBlock event", func() {
+			height, err := tendermint.ParseNewBlockEventHeight([]byte(newBlockEventJSON("1234")))
+
+			Expect(err).To(BeNil())
+			Expect(*height).To(Equal(uint64(1234)))
+		})
+
+		It("should return nil when message is subscription result", func() {
+			height, err := tendermint.ParseNewBlockEventHeight([]byte(NEW_BLOCK_SUBSCRIBE_RESULT_JSON))
+
+			Expect(err).To(BeNil())
+			Expect(height).To(BeNil())
+		})
+
+		It("should return error when message is JSON-RPC error", func() {
+			_, err := tendermint.ParseNewBlockEventHeight([]byte(`{
+				"jsonrpc": "2.0",
+				"id": 0,
+				"error": {
+					"code": -32603,
+					"message": "Internal error",
+					"data": "already subscribed"
+				}
+			}`))
+
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("WebSocketURLFromHTTPRPCURL", func() {
+		It("should convert HTTP RPC URL to websocket URL", func() {
+			Expect(tendermint.WebSocketURLFromHTTPRPCURL("http://localhost:26657")).To(
+				Equal("ws://localhost:26657/websocket"),
+			)
+			Expect(tendermint.WebSocketURLFromHTTPRPCURL("https://example.com/tendermint/")).To(
+				Equal("wss://example.com/tendermint/websocket"),
+			)
+		})
+
+		It("should return error when scheme is unsupported", func() {
+			_, err := tendermint.WebSocketURLFromHTTPRPCURL("tcp://localhost:26657")
+
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
+
+// newNewBlockEventServer starts a websocket server which replies to the
+// subscribe request with the provided messages and keeps the connection open.
+// The returned channel is closed when the client disconnects
+func newNewBlockEventServer(messages []string) (*httptest.Server, chan bool) {
+	upgrader := websocket.Upgrader{}
+	disconnectedCh := make(chan bool)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			return
+		}
+		defer close(disconnectedCh)
+		defer conn.Close()
+
+		if _, _, err = conn.ReadMessage(); err != nil {
+			return
+		}
+		for _, message := range messages {
+			if err = conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+				return
+			}
+		}
+		for {
+			if _, _, err = conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})), disconnectedCh
+}
+
+func newBlockEventJSON(height string) string {
+	return `{
+		"jsonrpc": "2.0",
+		"id": 0,
+		"result": {
+			"query": "tm.event='NewBlock'",
+			"data": {
+				"type": "tendermint/event/NewBlock",
+				"value": {
+					"block": {
+						"header": {
+							"chain_id": "test-chain-y3m1e6-AB",
+							"height": "` + height + `",
+							"time": "2020-05-27T07:42:47.047404Z"
+						}
+					}
+				}
+			}
+		}
+	}`
+}
+
+const NEW_BLOCK_SUBSCRIBE_RESULT_JSON = `{
+	"jsonrpc": "2.0",
+	"id": 0,
+	"result": {}
+}`
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS raw_block_archive;
\ No newline at end of file
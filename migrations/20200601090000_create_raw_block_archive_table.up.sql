@@ -0,0 +1,7 @@
+/* Append-only archive of verbatim Tendermint responses. Genesis is archived at height 0 */
+CREATE TABLE raw_block_archive (
+  height BIGINT NOT NULL,
+  kind VARCHAR NOT NULL,
+  data BYTEA NOT NULL,
+  PRIMARY KEY(height, kind)
+);
@@ -0,0 +1,8 @@
+DELETE FROM activities WHERE event_phase = 'end_block';
+
+ALTER TABLE activities DROP CONSTRAINT IF EXISTS activities_block_height_event_phase_event_position_key;
+ALTER TABLE activities ADD CONSTRAINT activities_block_height_event_position_key
+  UNIQUE(block_height, event_position);
+
+ALTER TABLE activities DROP COLUMN IF EXISTS event_phase;
+DROP TYPE IF EXISTS event_phase;
@@ -0,0 +1,9 @@
+CREATE TYPE event_phase AS ENUM ('begin_block', 'end_block');
+
+/* Events indexed before end block events are supported are all begin block events */
+ALTER TABLE activities ADD COLUMN event_phase EVENT_PHASE NULL;
+UPDATE activities SET event_phase = 'begin_block' WHERE event_position IS NOT NULL;
+
+ALTER TABLE activities DROP CONSTRAINT IF EXISTS activities_block_height_event_position_key;
+ALTER TABLE activities ADD CONSTRAINT activities_block_height_event_phase_event_position_key
+  UNIQUE(block_height, event_phase, event_position);
@@ -0,0 +1,7 @@
+DELETE FROM activities WHERE tx_status = 'failed';
+
+DROP INDEX IF EXISTS activities_tx_status_index;
+ALTER TABLE activities DROP COLUMN IF EXISTS tx_codespace;
+ALTER TABLE activities DROP COLUMN IF EXISTS tx_log;
+ALTER TABLE activities DROP COLUMN IF EXISTS tx_status;
+DROP TYPE IF EXISTS transaction_status;
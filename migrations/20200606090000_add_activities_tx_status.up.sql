@@ -0,0 +1,10 @@
+CREATE TYPE transaction_status AS ENUM ('success', 'failed');
+
+/* Transactions indexed before failed transactions are recorded are all successful */
+ALTER TABLE activities ADD COLUMN tx_status TRANSACTION_STATUS NULL;
+ALTER TABLE activities ADD COLUMN tx_log VARCHAR NULL;
+ALTER TABLE activities ADD COLUMN tx_codespace VARCHAR NULL;
+UPDATE activities SET tx_status = 'success'
+  WHERE type IN ('transfer', 'deposit', 'unbond', 'withdraw', 'nodejoin', 'unjail');
+
+CREATE INDEX activities_tx_status_index ON activities(tx_status);
@@ -0,0 +1,7 @@
+/* Chain-wide parameters declared in the genesis, keyed by chain id */
+CREATE TABLE chain_params (
+  chain_id VARCHAR NOT NULL,
+  network_params JSONB NOT NULL,
+  consensus_params JSONB NOT NULL,
+  PRIMARY KEY(chain_id)
+);
@@ -0,0 +1,11 @@
+/* Blocks failing deterministically at parse or store stage, kept for inspection and retry */
+CREATE TABLE quarantined_blocks (
+  height BIGINT NOT NULL,
+  stage VARCHAR NOT NULL,
+  error VARCHAR NOT NULL,
+  payload JSONB NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 1,
+  quarantined_at BIGINT NOT NULL,
+  last_attempted_at BIGINT NOT NULL,
+  PRIMARY KEY(height)
+);
@@ -0,0 +1,10 @@
+/* State snapshot the index is bootstrapped from. History before its height is unavailable */
+CREATE TABLE index_bootstrap (
+  height BIGINT NOT NULL,
+  block_hash VARCHAR NOT NULL,
+  chain_id VARCHAR NOT NULL,
+  source VARCHAR NOT NULL,
+  bootstrapped_at BIGINT NOT NULL,
+  PRIMARY KEY(height),
+  FOREIGN KEY(height) REFERENCES blocks(height)
+);
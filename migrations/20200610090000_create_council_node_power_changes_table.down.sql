@@ -0,0 +1 @@
+DROP TABLE IF EXISTS council_node_power_changes;
@@ -0,0 +1,11 @@
+/* Voting power of council nodes from validator updates. The validator set at a height is reconstructed from the latest power change at or before it */
+CREATE TABLE council_node_power_changes (
+  council_node_id BIGINT NOT NULL,
+  block_height BIGINT NOT NULL,
+  power BIGINT NOT NULL,
+  PRIMARY KEY(council_node_id, block_height),
+  FOREIGN KEY(council_node_id) REFERENCES council_nodes(id),
+  FOREIGN KEY(block_height) REFERENCES blocks(height)
+);
+
+CREATE INDEX council_node_power_changes_block_height_index ON council_node_power_changes(block_height);
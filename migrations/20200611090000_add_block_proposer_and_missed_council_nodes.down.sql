@@ -0,0 +1,40 @@
+/* Shift the signatures back to the block carrying the commit, flagging the proposer of that block. Signatures of the last stored block are discarded as no stored block carries them */
+CREATE TEMPORARY TABLE shifted_block_committed_council_nodes AS
+SELECT
+  c.block_height + 1 AS block_height,
+  c.council_node_id,
+  c.signature,
+  COALESCE(b.proposer_council_node_id = c.council_node_id, FALSE) AS is_proposer
+FROM block_committed_council_nodes c
+INNER JOIN blocks b ON b.height = c.block_height + 1;
+
+DELETE FROM block_committed_council_nodes;
+
+INSERT INTO block_committed_council_nodes (block_height, council_node_id, signature, is_proposer)
+SELECT block_height, council_node_id, signature, is_proposer FROM shifted_block_committed_council_nodes;
+
+UPDATE blocks SET committed_council_nodes = NULL WHERE committed_council_nodes IS NOT NULL;
+
+UPDATE blocks SET committed_council_nodes = committed.committed_council_nodes
+FROM (
+  SELECT
+    s.block_height,
+    jsonb_agg(jsonb_build_object(
+      'id', n.id,
+      'name', n.name,
+      'address', n.address,
+      'signature', s.signature,
+      'is_proposer', s.is_proposer
+    ) ORDER BY s.is_proposer DESC, n.id) AS committed_council_nodes
+  FROM shifted_block_committed_council_nodes s
+  INNER JOIN council_nodes n ON n.id = s.council_node_id
+  GROUP BY s.block_height
+) committed
+WHERE committed.block_height = blocks.height;
+
+DROP TABLE shifted_block_committed_council_nodes;
+
+DROP TABLE IF EXISTS block_missed_council_nodes;
+
+DROP INDEX IF EXISTS blocks_proposer_council_node_id_index;
+ALTER TABLE blocks DROP COLUMN IF EXISTS proposer_council_node_id;
@@ -0,0 +1,55 @@
+/* Signatures of a block are carried by the next block. The proposer is kept on the block so that it is known before the commit is seen */
+ALTER TABLE blocks ADD COLUMN proposer_council_node_id INT NULL;
+
+CREATE INDEX blocks_proposer_council_node_id_index ON blocks(proposer_council_node_id);
+
+/* Council nodes in the validator set of a block which have not signed its commit */
+CREATE TABLE block_missed_council_nodes (
+  block_height BIGINT,
+  council_node_id INT,
+  PRIMARY KEY(block_height, council_node_id),
+  FOREIGN KEY(block_height) REFERENCES blocks(height),
+  FOREIGN KEY(council_node_id) REFERENCES council_nodes(id)
+);
+
+CREATE INDEX block_missed_council_nodes_council_node_id_index ON block_missed_council_nodes(council_node_id);
+
+/* Stored signatures were attributed to the block carrying the commit, and is_proposer flagged the proposer of that block. Keep the flagged proposer on the block, then shift the signatures to the signed previous block. Signatures of a previous block which is not stored are discarded. Missed signatures of already stored blocks are not backfilled */
+UPDATE blocks SET proposer_council_node_id = c.council_node_id
+FROM block_committed_council_nodes c
+WHERE c.block_height = blocks.height AND c.is_proposer;
+
+CREATE TEMPORARY TABLE shifted_block_committed_council_nodes AS
+SELECT
+  c.block_height - 1 AS block_height,
+  c.council_node_id,
+  c.signature,
+  COALESCE(b.proposer_council_node_id = c.council_node_id, FALSE) AS is_proposer
+FROM block_committed_council_nodes c
+INNER JOIN blocks b ON b.height = c.block_height - 1;
+
+DELETE FROM block_committed_council_nodes;
+
+INSERT INTO block_committed_council_nodes (block_height, council_node_id, signature, is_proposer)
+SELECT block_height, council_node_id, signature, is_proposer FROM shifted_block_committed_council_nodes;
+
+UPDATE blocks SET committed_council_nodes = NULL WHERE committed_council_nodes IS NOT NULL;
+
+UPDATE blocks SET committed_council_nodes = committed.committed_council_nodes
+FROM (
+  SELECT
+    s.block_height,
+    jsonb_agg(jsonb_build_object(
+      'id', n.id,
+      'name', n.name,
+      'address', n.address,
+      'signature', s.signature,
+      'is_proposer', s.is_proposer
+    ) ORDER BY s.is_proposer DESC, n.id) AS committed_council_nodes
+  FROM shifted_block_committed_council_nodes s
+  INNER JOIN council_nodes n ON n.id = s.council_node_id
+  GROUP BY s.block_height
+) committed
+WHERE committed.block_height = blocks.height;
+
+DROP TABLE shifted_block_committed_council_nodes;
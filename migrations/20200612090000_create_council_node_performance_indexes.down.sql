@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS blocks_time_index;
+
+DROP INDEX IF EXISTS activities_affected_council_node_id_index;
@@ -0,0 +1,3 @@
+CREATE INDEX activities_affected_council_node_id_index ON activities(affected_council_node_id);
+
+CREATE INDEX blocks_time_index ON blocks(time);
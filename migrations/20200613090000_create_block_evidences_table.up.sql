@@ -0,0 +1,16 @@
+/* Duplicate vote evidences carried by a block. The council node is NULL when the offending address is not a known council node */
+CREATE TABLE block_evidences (
+  block_height BIGINT,
+  position INT,
+  type VARCHAR NOT NULL,
+  council_node_id INT NULL,
+  council_node_address VARCHAR NOT NULL,
+  vote_height BIGINT NOT NULL,
+  vote_a JSONB NOT NULL,
+  vote_b JSONB NOT NULL,
+  PRIMARY KEY(block_height, position),
+  FOREIGN KEY(block_height) REFERENCES blocks(height),
+  FOREIGN KEY(council_node_id) REFERENCES council_nodes(id)
+);
+
+CREATE INDEX block_evidences_council_node_id_index ON block_evidences(council_node_id);
@@ -0,0 +1,12 @@
+/* Transactions observed in the Tendermint mempool which are not yet included in a block */
+CREATE TABLE pending_transactions (
+  txid VARCHAR NOT NULL,
+  type ACTIVITY_TYPE NOT NULL,
+  inputs JSONB NULL,
+  output_count INTEGER NULL,
+  staking_account_address VARCHAR NULL,
+  first_seen_at BIGINT NOT NULL,
+  last_seen_at BIGINT NOT NULL,
+  PRIMARY KEY(txid)
+);
+CREATE INDEX pending_transactions_first_seen_at_index ON pending_transactions(first_seen_at);
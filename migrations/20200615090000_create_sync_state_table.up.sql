@@ -0,0 +1,26 @@
+/* Synchronization progress of the index. The table only ever holds a single row */
+CREATE TABLE sync_state (
+  id BOOLEAN NOT NULL DEFAULT TRUE,
+  last_stored_height BIGINT NOT NULL,
+  last_observed_tendermint_height BIGINT NOT NULL,
+  chain_id VARCHAR NULL,
+  parser_version INTEGER NOT NULL,
+  last_error VARCHAR NULL,
+  last_error_at BIGINT NULL,
+  created_at BIGINT NOT NULL,
+  updated_at BIGINT NOT NULL,
+  PRIMARY KEY(id),
+  CHECK(id)
+);
+
+/* Indexes built before the sync state is recorded resume from the latest stored block. Their parser version is unknown */
+INSERT INTO sync_state (last_stored_height, last_observed_tendermint_height, chain_id, parser_version, created_at, updated_at)
+  SELECT
+    MAX(height),
+    MAX(height),
+    (SELECT chain_id FROM chain_params LIMIT 1),
+    0,
+    (EXTRACT(EPOCH FROM NOW()) * 1000000000)::BIGINT,
+    (EXTRACT(EPOCH FROM NOW()) * 1000000000)::BIGINT
+  FROM blocks
+  HAVING COUNT(*) > 0;
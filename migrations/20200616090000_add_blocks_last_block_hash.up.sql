@@ -0,0 +1,2 @@
+/* Hash of the previous block. Blocks stored before it is recorded have no parent hash */
+ALTER TABLE blocks ADD COLUMN last_block_hash VARCHAR NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS activities_joined_council_node_id_index;
+DROP INDEX IF EXISTS activities_staking_account_address_index;
+
+DELETE FROM council_node_power_changes WHERE power = 0;
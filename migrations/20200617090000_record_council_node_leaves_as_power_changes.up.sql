@@ -0,0 +1,10 @@
+/* Leaving is recorded as a power change to 0. Only the last time each council node has left is known for the blocks already stored */
+INSERT INTO council_node_power_changes (council_node_id, block_height, power)
+  SELECT id, last_left_at_block_height, 0
+  FROM council_nodes
+  WHERE last_left_at_block_height IS NOT NULL
+ON CONFLICT (council_node_id, block_height) DO NOTHING;
+
+/* Staking accounts and council nodes are recomputed from the activities when block data are rewound */
+CREATE INDEX activities_staking_account_address_index ON activities(staking_account_address);
+CREATE INDEX activities_joined_council_node_id_index ON activities(joined_council_node_id);
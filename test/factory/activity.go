@@ -41,7 +41,11 @@ func RandomTransferActivity() chainindex.Activity {
 		BlockHeight:                random.Uint64(),
 		Type:                       chainindex.ACTIVITY_TRANSFER,
 		MaybeTxID:                  RandomTxIdPtr(),
+		MaybeTxStatus:              primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
+		MaybeTxLog:                 nil,
+		MaybeTxCodespace:           nil,
 		MaybeEventPosition:         nil,
+		MaybeEventPhase:            nil,
 		MaybeFee:                   RandomFee(),
 		MaybeTxInputs:              RandomTxInputsPtrOfSize(3),
 		MaybeOutputCount:           RandomUint32Ptr(),
@@ -60,7 +64,11 @@ func RandomGenesisActivity() chainindex.Activity {
 		BlockHeight:                uint64(1),
 		Type:                       chainindex.ACTIVITY_GENESIS,
 		MaybeTxID:                  nil,
+		MaybeTxStatus:              nil,
+		MaybeTxLog:                 nil,
+		MaybeTxCodespace:           nil,
 		MaybeEventPosition:         nil,
+		MaybeEventPhase:            nil,
 		MaybeFee:                   nil,
 		MaybeTxInputs:              nil,
 		MaybeOutputCount:           nil,
@@ -79,7 +87,11 @@ func RandomDepositActivity() chainindex.Activity {
 		BlockHeight:                random.Uint64(),
 		Type:                       chainindex.ACTIVITY_DEPOSIT,
 		MaybeTxID:                  RandomTxIdPtr(),
+		MaybeTxStatus:              primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
+		MaybeTxLog:                 nil,
+		MaybeTxCodespace:           nil,
 		MaybeEventPosition:         nil,
+		MaybeEventPhase:            nil,
 		MaybeFee:                   RandomFee(),
 		MaybeTxInputs:              nil,
 		MaybeOutputCount:           nil,
@@ -100,7 +112,11 @@ func RandomUnbondActivity() chainindex.Activity {
 		BlockHeight:                random.Uint64(),
 		Type:                       chainindex.ACTIVITY_UNBOND,
 		MaybeTxID:                  RandomTxIdPtr(),
+		MaybeTxStatus:              primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
+		MaybeTxLog:                 nil,
+		MaybeTxCodespace:           nil,
 		MaybeEventPosition:         nil,
+		MaybeEventPhase:            nil,
 		MaybeFee:                   RandomFee(),
 		MaybeTxInputs:              nil,
 		MaybeOutputCount:           nil,
@@ -119,7 +135,11 @@ func RandomWithdrawActivity() chainindex.Activity {
 		BlockHeight:                random.Uint64(),
 		Type:                       chainindex.ACTIVITY_WITHDRAW,
 		MaybeTxID:                  RandomTxIdPtr(),
+		MaybeTxStatus:              primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
+		MaybeTxLog:                 nil,
+		MaybeTxCodespace:           nil,
 		MaybeEventPosition:         nil,
+		MaybeEventPhase:            nil,
 		MaybeFee:                   RandomFee(),
 		MaybeTxInputs:              nil,
 		MaybeOutputCount:           RandomUint32Ptr(),
@@ -138,7 +158,11 @@ func RandomNodeJoinActivity() chainindex.Activity {
 		BlockHeight:                random.Uint64(),
 		Type:                       chainindex.ACTIVITY_NODEJOIN,
 		MaybeTxID:                  RandomTxIdPtr(),
+		MaybeTxStatus:              primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
+		MaybeTxLog:                 nil,
+		MaybeTxCodespace:           nil,
 		MaybeEventPosition:         nil,
+		MaybeEventPhase:            nil,
 		MaybeFee:                   bignum.Int0(),
 		MaybeTxInputs:              nil,
 		MaybeOutputCount:           nil,
@@ -157,7 +181,11 @@ func RandomUnjailActivity() chainindex.Activity {
 		BlockHeight:                random.Uint64(),
 		Type:                       chainindex.ACTIVITY_UNJAIL,
 		MaybeTxID:                  RandomTxIdPtr(),
+		MaybeTxStatus:              primptr.Uint8(chainindex.TRANSACTION_STATUS_SUCCESS),
+		MaybeTxLog:                 nil,
+		MaybeTxCodespace:           nil,
 		MaybeEventPosition:         nil,
+		MaybeEventPhase:            nil,
 		MaybeFee:                   RandomFee(),
 		MaybeTxInputs:              nil,
 		MaybeOutputCount:           nil,
@@ -176,7 +204,11 @@ func RandomRewardActivity() chainindex.Activity {
 		BlockHeight:                random.Uint64(),
 		Type:                       chainindex.ACTIVITY_REWARD,
 		MaybeTxID:                  nil,
+		MaybeTxStatus:              nil,
+		MaybeTxLog:                 nil,
+		MaybeTxCodespace:           nil,
 		MaybeEventPosition:         RandomUint32Ptr(),
+		MaybeEventPhase:            RandomEventPhasePtr(),
 		MaybeFee:                   nil,
 		MaybeTxInputs:              nil,
 		MaybeOutputCount:           nil,
@@ -195,7 +227,11 @@ func RandomSlashActivity() chainindex.Activity {
 		BlockHeight:                random.Uint64(),
 		Type:                       chainindex.ACTIVITY_SLASH,
 		MaybeTxID:                  nil,
+		MaybeTxStatus:              nil,
+		MaybeTxLog:                 nil,
+		MaybeTxCodespace:           nil,
 		MaybeEventPosition:         RandomUint32Ptr(),
+		MaybeEventPhase:            RandomEventPhasePtr(),
 		MaybeFee:                   nil,
 		MaybeTxInputs:              nil,
 		MaybeOutputCount:           nil,
@@ -214,7 +250,11 @@ func RandomJailActivity() chainindex.Activity {
 		BlockHeight:                random.Uint64(),
 		Type:                       chainindex.ACTIVITY_JAIL,
 		MaybeTxID:                  nil,
+		MaybeTxStatus:              nil,
+		MaybeTxLog:                 nil,
+		MaybeTxCodespace:           nil,
 		MaybeEventPosition:         RandomUint32Ptr(),
+		MaybeEventPhase:            RandomEventPhasePtr(),
 		MaybeFee:                   nil,
 		MaybeTxInputs:              nil,
 		MaybeOutputCount:           nil,
@@ -268,6 +308,18 @@ func RandomStakingAddress() string {
 	return "0x" + hex.EncodeToString(RandomHex(20))
 }
 
+func RandomEventPhase() chainindex.EventPhase {
+	return uint8(random.RandomInt([]int{
+		int(chainindex.EVENT_PHASE_BEGIN_BLOCK),
+		int(chainindex.EVENT_PHASE_END_BLOCK),
+	}))
+}
+
+func RandomEventPhasePtr() *chainindex.EventPhase {
+	value := RandomEventPhase()
+	return &value
+}
+
 func RandomPunishmentKind() chainindex.PunishmentKind {
 	return uint8(random.RandomInt([]int{
 		int(chainindex.PUNISHMENT_KIND_NON_LIVE),
@@ -12,10 +12,11 @@ import (
 
 func RandomBlock() chainindex.Block {
 	return chainindex.Block{
-		Height:  random.Uint64(),
-		Hash:    RandomBlockHash(),
-		Time:    RandomUTCTime(),
-		AppHash: RandomAppHash(),
+		Height:          random.Uint64(),
+		Hash:            RandomBlockHash(),
+		Time:            RandomUTCTime(),
+		AppHash:         RandomAppHash(),
+		ProposerAddress: RandomTendermintAddress(),
 	}
 }
 
@@ -34,7 +35,30 @@ func RandomBlockSignature() chainindex.BlockSignature {
 		BlockHeight:        random.Uint64(),
 		CouncilNodeAddress: RandomTendermintAddress(),
 		Signature:          RandomTendermintSignature(),
-		IsProposer:         random.Bool(),
+	}
+}
+
+func RandomBlockEvidence() chainindex.BlockEvidence {
+	voteHeight := random.Uint64()
+	return chainindex.BlockEvidence{
+		BlockHeight:        random.Uint64(),
+		Position:           random.Uint32(),
+		Type:               "tendermint/DuplicateVoteEvidence",
+		CouncilNodeAddress: RandomTendermintAddress(),
+		VoteA:              RandomBlockEvidenceVote(voteHeight),
+		VoteB:              RandomBlockEvidenceVote(voteHeight),
+	}
+}
+
+func RandomBlockEvidenceVote(height uint64) chainindex.BlockEvidenceVote {
+	return chainindex.BlockEvidenceVote{
+		Type:           uint8(2),
+		Height:         height,
+		Round:          uint64(0),
+		BlockHash:      RandomBlockHash(),
+		Timestamp:      RandomUTCTime(),
+		ValidatorIndex: uint64(random.Uint8()),
+		Signature:      RandomTendermintSignature(),
 	}
 }
 
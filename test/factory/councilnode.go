@@ -32,6 +32,15 @@ func RandomCouncilNodeLeftUpdate() chainindex.CouncilNodeUpdate {
 	}
 }
 
+func RandomCouncilNodePowerChangedUpdate() chainindex.CouncilNodeUpdate {
+	power := random.Uint64()
+	return chainindex.CouncilNodeUpdate{
+		Address:    RandomTendermintAddress(),
+		Type:       chainindex.COUNCIL_NODE_UPDATE_TYPE_POWER_CHANGED,
+		MaybePower: &power,
+	}
+}
+
 func RandomPubKeyType() chainindex.PubKeyType {
 	return chainindex.PUBKEY_TYPE_ED25519
 }
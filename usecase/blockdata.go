@@ -11,9 +11,18 @@ import (
 type BlockData struct {
 	Block              chainindex.Block
 	Signatures         []chainindex.BlockSignature
+	Evidences          []chainindex.BlockEvidence
 	Activities         []chainindex.Activity
 	Reward             *chainindex.BlockReward
 	CouncilNodeUpdates []chainindex.CouncilNodeUpdate
+	// ChainParams is only present in the genesis block data
+	ChainParams *chainindex.ChainParams
+	// Quarantined block data only carries the block height. It is skipped
+	// when storing so that synchronization continues past the block
+	Quarantined bool
+	// TendermintHeight is the latest Tendermint block height observed when the
+	// block data is stored. It is recorded into the sync state
+	TendermintHeight uint64
 }
 
 func (data *BlockData) String() string {
@@ -22,4 +31,11 @@ func (data *BlockData) String() string {
 
 type BlockDataRepository interface {
 	Store(blockData *BlockData) error
+	// Store consecutive block data atomically in a single transaction
+	StoreBatch(blockDataList []*BlockData) error
+	// Delete all stored block data and everything derived from them
+	Reset() error
+	// Delete stored block data from the height onward and roll everything
+	// derived from them back to the previous height
+	Rewind(fromHeight uint64) error
 }
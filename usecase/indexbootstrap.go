@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/crypto-com/chainindex"
+)
+
+// StateSnapshot is a trusted state of the chain at a height. Indexing
+// bootstrapped from it continues from the next height, without the history
+// before it. It allows indexing against pruned nodes which no longer serve the
+// blocks from genesis
+type StateSnapshot struct {
+	// Block at the snapshot height. Only the header is kept
+	Block chainindex.Block
+	// Staking accounts at the snapshot height. Council nodes are seeded from
+	// the current council node of the accounts
+	StakingAccounts []chainindex.StakingAccount
+	// ChainParams are optional. They are only available when the genesis is
+	// still served
+	ChainParams *chainindex.ChainParams
+
+	ChainID string
+	// Where the snapshot is loaded from (e.g. the file path)
+	Source string
+}
+
+type IndexBootstrapRepository interface {
+	// Bootstrap seeds an empty index with the snapshot in a single
+	// transaction. It fails when any block is already stored
+	Bootstrap(snapshot *StateSnapshot, bootstrappedAt time.Time) error
+}
@@ -0,0 +1,44 @@
+package usecase
+
+type Metrics interface {
+	SetGauge(name string, value float64, labels MetricLabels)
+	AddCounter(name string, delta float64, labels MetricLabels)
+	ObserveHistogram(name string, value float64, labels MetricLabels)
+
+	WithLabels(labels MetricLabels) Metrics
+}
+
+type MetricLabels map[string]string
+
+// Metric names. Labels provided on each observation must be declared by the
+// Metrics implementation
+const (
+	// labels: index
+	METRIC_SYNC_STORED_BLOCKS_TOTAL = "sync_stored_blocks_total"
+	// labels: index
+	METRIC_SYNC_STORE_DURATION_SECONDS = "sync_store_duration_seconds"
+	// labels: index
+	METRIC_SYNC_STORE_ERRORS_TOTAL = "sync_store_errors_total"
+	// labels: index, stage
+	METRIC_SYNC_QUARANTINED_BLOCKS_TOTAL = "sync_quarantined_blocks_total"
+	// labels: index
+	METRIC_SYNC_WORKING_BLOCK_WORKERS = "sync_working_block_workers"
+	// labels: index
+	METRIC_SYNC_MAX_BLOCK_WORKERS = "sync_max_block_workers"
+	// labels: index
+	METRIC_SYNC_BLOCK_WORKERS_LIMIT = "sync_block_workers_limit"
+	// labels: index
+	METRIC_SYNC_BLOCK_RETRIES_TOTAL = "sync_block_retries_total"
+	// labels: index
+	METRIC_SYNC_BLOCK_RETRY_BACKOFF_SECONDS = "sync_block_retry_backoff_seconds"
+	// labels: index
+	METRIC_SYNC_SLIDING_WINDOW_BLOCKS = "sync_sliding_window_blocks"
+
+	// labels: method
+	METRIC_TENDERMINT_RPC_DURATION_SECONDS = "tendermint_rpc_duration_seconds"
+	// labels: method
+	METRIC_TENDERMINT_RPC_ERRORS_TOTAL = "tendermint_rpc_errors_total"
+
+	// labels: route, method, status
+	METRIC_HTTPAPI_REQUEST_DURATION_SECONDS = "httpapi_request_duration_seconds"
+)
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/crypto-com/chainindex"
+)
+
+// PendingTransaction is a transaction in the Tendermint mempool which is not
+// yet included in a block. Only the information decodable from the raw
+// transaction is known before it is executed
+type PendingTransaction struct {
+	TxID                       string
+	Type                       chainindex.ActivityType
+	MaybeTxInputs              []chainindex.TxInput
+	MaybeOutputCount           *uint32
+	MaybeStakingAccountAddress *string
+}
+
+type PendingTransactionRepository interface {
+	// Replace makes the transactions the whole set of pending transactions
+	// observed at the time. Transactions no longer pending are removed and
+	// transactions already pending keep the time they are first seen
+	Replace(txs []PendingTransaction, observedAt time.Time) error
+}
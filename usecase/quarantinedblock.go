@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+)
+
+// Stages of the synchronization pipeline at which a block can fail
+const (
+	QUARANTINE_STAGE_PARSE = "parse"
+	QUARANTINE_STAGE_STORE = "store"
+)
+
+// QuarantinedBlock is a block failing deterministically. It is put aside with
+// the error and the payload it failed on until it is retried successfully
+type QuarantinedBlock struct {
+	Height uint64
+	Stage  string
+	Error  string
+	// JSON encoded payload the block failed on. Raw Tendermint responses at
+	// parse stage and parsed block data at store stage
+	Payload []byte
+
+	QuarantinedAt time.Time
+}
+
+type QuarantinedBlockRepository interface {
+	// Quarantine inserts the block or records another failed attempt of an
+	// already quarantined block
+	Quarantine(block *QuarantinedBlock) error
+	// Release removes the block from quarantine
+	Release(height uint64) error
+}
+
+// ErrQuarantinedBlockOutOfOrder is returned when retrying a quarantined block
+// which does not directly follow the last stored block. Staking accounts,
+// council nodes and transaction outputs are running totals, so the block
+// cannot be stored after the later blocks
+var ErrQuarantinedBlockOutOfOrder = errors.New("quarantined block does not follow the last stored block")
+
+// QuarantinedBlockRetrier processes a quarantined block again and releases it
+// on success. Only the block right after the last stored block can be retried,
+// otherwise ErrQuarantinedBlockOutOfOrder is returned
+type QuarantinedBlockRetrier interface {
+	Retry(height uint64) error
+}
@@ -1,7 +1,11 @@
 package usecase
 
+import "context"
+
 type SyncService interface {
-	Sync() error
+	// Sync blocks until the context is done or synchronization fails. It
+	// returns the error causing synchronization to stop
+	Sync(ctx context.Context) error
 	GetStatus() SyncStatus
 }
 
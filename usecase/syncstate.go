@@ -0,0 +1,12 @@
+package usecase
+
+import "time"
+
+// SyncStateRepository records diagnostics of synchronization into the sync
+// state. The stored heights are checkpointed by BlockDataRepository in the same
+// transaction as the block data
+type SyncStateRepository interface {
+	// RecordError keeps the latest synchronization error. It replaces the
+	// previously recorded one
+	RecordError(message string, occurredAt time.Time) error
+}
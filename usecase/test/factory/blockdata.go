@@ -12,7 +12,7 @@ func RandomBlockData() usecase.BlockData {
 	reward := RandomBlockReward()
 	return usecase.BlockData{
 		Block:              block,
-		Signatures:         RandomBlockSignaturesOfSize(3, block.Height),
+		Signatures:         RandomBlockSignaturesOfSize(3, block.Height-1),
 		Activities:         RandomAllActivities(),
 		Reward:             &reward,
 		CouncilNodeUpdates: RandomCouncilNodeUpdatesOfSize(3),
@@ -0,0 +1,17 @@
+package usecasefake
+
+import "github.com/crypto-com/chainindex/usecase"
+
+type FakeMetrics struct{}
+
+func NewFakeMetrics() *FakeMetrics {
+	return &FakeMetrics{}
+}
+
+func (metrics *FakeMetrics) SetGauge(_ string, _ float64, _ usecase.MetricLabels)         {}
+func (metrics *FakeMetrics) AddCounter(_ string, _ float64, _ usecase.MetricLabels)       {}
+func (metrics *FakeMetrics) ObserveHistogram(_ string, _ float64, _ usecase.MetricLabels) {}
+
+func (metrics *FakeMetrics) WithLabels(_ usecase.MetricLabels) usecase.Metrics {
+	return metrics
+}
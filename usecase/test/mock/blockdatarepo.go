@@ -0,0 +1,35 @@
+package usecasemock
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+type MockBlockDataRepo struct {
+	mock.Mock
+}
+
+func (repo *MockBlockDataRepo) Store(blockData *usecase.BlockData) error {
+	args := repo.Called(blockData)
+
+	return args.Error(0)
+}
+
+func (repo *MockBlockDataRepo) StoreBatch(blockDataList []*usecase.BlockData) error {
+	args := repo.Called(blockDataList)
+
+	return args.Error(0)
+}
+
+func (repo *MockBlockDataRepo) Reset() error {
+	args := repo.Called()
+
+	return args.Error(0)
+}
+
+func (repo *MockBlockDataRepo) Rewind(fromHeight uint64) error {
+	args := repo.Called(fromHeight)
+
+	return args.Error(0)
+}
@@ -0,0 +1,29 @@
+package usecasemock
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+type MockMetrics struct {
+	mock.Mock
+}
+
+func (metrics *MockMetrics) SetGauge(name string, value float64, labels usecase.MetricLabels) {
+	metrics.Called(name, value, labels)
+}
+
+func (metrics *MockMetrics) AddCounter(name string, delta float64, labels usecase.MetricLabels) {
+	metrics.Called(name, delta, labels)
+}
+
+func (metrics *MockMetrics) ObserveHistogram(name string, value float64, labels usecase.MetricLabels) {
+	metrics.Called(name, value, labels)
+}
+
+func (metrics *MockMetrics) WithLabels(labels usecase.MetricLabels) usecase.Metrics {
+	args := metrics.Called(labels)
+
+	return args.Get(0).(usecase.Metrics)
+}
@@ -0,0 +1,19 @@
+package usecasemock
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+type MockPendingTransactionRepo struct {
+	mock.Mock
+}
+
+func (repo *MockPendingTransactionRepo) Replace(txs []usecase.PendingTransaction, observedAt time.Time) error {
+	args := repo.Called(txs, observedAt)
+
+	return args.Error(0)
+}
@@ -0,0 +1,23 @@
+package usecasemock
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+type MockQuarantinedBlockRepo struct {
+	mock.Mock
+}
+
+func (repo *MockQuarantinedBlockRepo) Quarantine(block *usecase.QuarantinedBlock) error {
+	args := repo.Called(block)
+
+	return args.Error(0)
+}
+
+func (repo *MockQuarantinedBlockRepo) Release(height uint64) error {
+	args := repo.Called(height)
+
+	return args.Error(0)
+}
@@ -0,0 +1,15 @@
+package usecasemock
+
+import (
+	"github.com/stretchr/testify/mock"
+)
+
+type MockQuarantinedBlockRetrier struct {
+	mock.Mock
+}
+
+func (retrier *MockQuarantinedBlockRetrier) Retry(height uint64) error {
+	args := retrier.Called(height)
+
+	return args.Error(0)
+}
@@ -0,0 +1,25 @@
+package usecasemock
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chainindex/usecase"
+)
+
+type MockSyncService struct {
+	mock.Mock
+}
+
+func (syncService *MockSyncService) Sync(ctx context.Context) error {
+	args := syncService.Called(ctx)
+
+	return args.Error(0)
+}
+
+func (syncService *MockSyncService) GetStatus() usecase.SyncStatus {
+	args := syncService.Called()
+
+	return args.Get(0).(usecase.SyncStatus)
+}
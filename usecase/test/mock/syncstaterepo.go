@@ -0,0 +1,17 @@
+package usecasemock
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSyncStateRepo struct {
+	mock.Mock
+}
+
+func (repo *MockSyncStateRepo) RecordError(message string, occurredAt time.Time) error {
+	args := repo.Called(message, occurredAt)
+
+	return args.Error(0)
+}
@@ -11,7 +11,9 @@ type ActivityViewRepo interface {
 	ListTransactions(filter TransactionFilter, pagination *Pagination) ([]Transaction, *PaginationResult, error)
 	FindTransactionByTxId(txid string) (*Transaction, error)
 	ListEvents(filter EventFilter, pagination *Pagination) ([]Event, *PaginationResult, error)
-	FindEventByBlockHeightEventPosition(blockHeight uint64, eventPosition uint64) (*Event, error)
+	FindEventByBlockHeightEventPosition(
+		blockHeight uint64, eventPhase chainindex.EventPhase, eventPosition uint64,
+	) (*Event, error)
 
 	TransactionsCount() (uint64, error)
 
@@ -22,6 +24,7 @@ type ActivityViewRepo interface {
 type TransactionFilter struct {
 	MaybeTypes                 []chainindex.TransactionType
 	MaybeStakingAccountAddress *string
+	MaybeStatus                *chainindex.TransactionStatus
 }
 
 type Transaction struct {
@@ -30,6 +33,9 @@ type Transaction struct {
 	BlockTime                  time.Time            `json:"block_time"`
 	BlockHash                  string               `json:"block_hash"`
 	MaybeTxID                  *string              `json:"txid"`
+	Status                     string               `json:"status"`
+	MaybeLog                   *string              `json:"log"`
+	MaybeCodespace             *string              `json:"codespace"`
 	MaybeFee                   *bignum.WBigInt      `json:"fee"`
 	MaybeInputs                []TransactionInput   `json:"inputs"`
 	MaybeOutputCount           *uint64              `json:"output_count"`
@@ -58,6 +64,7 @@ type Event struct {
 	BlockTime                time.Time            `json:"block_time"`
 	BlockHash                string               `json:"block_hash"`
 	EventPosition            uint64               `json:"event_position"`
+	EventPhase               string               `json:"event_phase"`
 	StakingAccountAddress    string               `json:"staking_account_address"`
 	MaybeStakingAccountNonce *uint64              `json:"staking_account_nonce"`
 	MaybeBonded              *bignum.WBigInt      `json:"bonded"`
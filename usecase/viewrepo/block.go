@@ -34,6 +34,8 @@ type Block struct {
 	TransactionCount           uint64             `json:"transaction_count"`
 	EventCount                 uint64             `json:"event_count"`
 	MaybeCommittedCouncilNodes []BlockCouncilNode `json:"committed_council_nodes"`
+	// Evidences are only present in block detail
+	MaybeEvidences []BlockEvidence `json:"evidences"`
 }
 
 type BlockCouncilNode struct {
@@ -44,12 +46,50 @@ type BlockCouncilNode struct {
 	IsProposer bool   `json:"is_proposer"`
 }
 
+// BlockEvidence is a duplicate vote evidence carried by a block, with the slash
+// and jail activities in the block punishing its council node for Byzantine
+// fault
+type BlockEvidence struct {
+	BlockHeight          uint64                    `json:"block_height"`
+	BlockTime            time.Time                 `json:"block_time"`
+	BlockHash            string                    `json:"block_hash"`
+	Position             uint64                    `json:"position"`
+	Type                 string                    `json:"type"`
+	MaybeCouncilNodeId   *uint64                   `json:"council_node_id"`
+	MaybeCouncilNodeName *string                   `json:"council_node_name"`
+	CouncilNodeAddress   string                    `json:"council_node_address"`
+	VoteHeight           uint64                    `json:"vote_height"`
+	VoteA                BlockEvidenceVote         `json:"vote_a"`
+	VoteB                BlockEvidenceVote         `json:"vote_b"`
+	Punishments          []BlockEvidencePunishment `json:"punishments"`
+}
+
+type BlockEvidenceVote struct {
+	Type           uint8     `json:"type"`
+	Height         uint64    `json:"height"`
+	Round          uint64    `json:"round"`
+	BlockHash      string    `json:"block_hash"`
+	Timestamp      time.Time `json:"timestamp"`
+	ValidatorIndex uint64    `json:"validator_index"`
+	Signature      string    `json:"signature"`
+}
+
+type BlockEvidencePunishment struct {
+	Type                       string          `json:"type"`
+	MaybeEventPosition         *uint64         `json:"event_position"`
+	MaybeStakingAccountAddress *string         `json:"staking_account_address"`
+	MaybeBonded                *bignum.WBigInt `json:"bonded"`
+	MaybeUnbonded              *bignum.WBigInt `json:"unbonded"`
+	MaybeJailedUntil           *time.Time      `json:"jailed_until"`
+}
+
 type BlockEvent struct {
 	Type                       string               `json:"type"`
 	BlockHeight                uint64               `json:"block_height"`
 	BlockTime                  time.Time            `json:"block_time"`
 	BlockHash                  string               `json:"block_hash"`
 	MaybeEventPosition         *uint64              `json:"event_position"`
+	MaybeEventPhase            *string              `json:"event_phase"`
 	MaybeStakingAccountAddress *string              `json:"staking_account_address"`
 	MaybeStakingAccountNonce   *uint64              `json:"staking_account_nonce"`
 	MaybeBonded                *bignum.WBigInt      `json:"bonded"`
@@ -0,0 +1,53 @@
+package viewrepo
+
+type ChainParamsViewRepo interface {
+	Find() (*ChainParams, error)
+}
+
+type ChainParams struct {
+	ChainID         string                     `json:"chain_id"`
+	NetworkParams   ChainParamsNetworkParams   `json:"network_params"`
+	ConsensusParams ChainParamsConsensusParams `json:"consensus_params"`
+}
+
+type ChainParamsNetworkParams struct {
+	InitialFeePolicy struct {
+		Coefficient uint64 `json:"coefficient"`
+		Constant    uint64 `json:"constant"`
+	} `json:"initial_fee_policy"`
+	JailingConfig struct {
+		BlockSigningWindow   uint64 `json:"block_signing_window"`
+		MissedBlockThreshold uint64 `json:"missed_block_threshold"`
+	} `json:"jailing_config"`
+	MaxValidators uint64 `json:"max_validators"`
+	// Amounts are kept as their decimal string representation as they are
+	// stored
+	RequiredCouncilNodeStake string `json:"required_council_node_stake"`
+	RewardsConfig            struct {
+		MonetaryExpansionCap   string `json:"monetary_expansion_cap"`
+		MonetaryExpansionDecay uint64 `json:"monetary_expansion_decay"`
+		MonetaryExpansionR0    uint64 `json:"monetary_expansion_r0"`
+		MonetaryExpansionTau   uint64 `json:"monetary_expansion_tau"`
+		RewardPeriodSeconds    uint64 `json:"reward_period_seconds"`
+	} `json:"rewards_config"`
+	SlashingConfig struct {
+		ByzantineSlashPercent string `json:"byzantine_slash_percent"`
+		LivenessSlashPercent  string `json:"liveness_slash_percent"`
+	} `json:"slashing_config"`
+	UnbondingPeriod uint64 `json:"unbonding_period"`
+}
+
+type ChainParamsConsensusParams struct {
+	Block struct {
+		MaxBytes   int64 `json:"max_bytes"`
+		MaxGas     int64 `json:"max_gas"`
+		TimeIotaMs int64 `json:"time_iota_ms"`
+	} `json:"block"`
+	Evidence struct {
+		MaxAgeNumBlocks int64 `json:"max_age_num_blocks"`
+		MaxAgeDuration  int64 `json:"max_age_duration"`
+	} `json:"evidence"`
+	Validator struct {
+		PubKeyTypes []string `json:"pub_key_types"`
+	} `json:"validator"`
+}
@@ -8,9 +8,25 @@ import (
 )
 
 type CouncilNodeViewRepo interface {
-	ListActive(pagination *Pagination) ([]CouncilNodeListItem, *PaginationResult, error)
+	ListActive(order CouncilNodeListOrder, pagination *Pagination) ([]CouncilNodeListItem, *PaginationResult, error)
 	FindById(id uint64) (*CouncilNode, error)
+	// FindPerformanceById returns the signing, proposing and punishment record
+	// of the council node over the window ending at the latest stored block.
+	// Returns ErrNotFound when the council node does not exist
+	FindPerformanceById(id uint64, window CouncilNodePerformanceWindow) (*CouncilNodePerformance, error)
 	ListActivitiesById(id uint64, filter ActivityFilter, pagination *Pagination) ([]StakingAccountActivity, *PaginationResult, error)
+	// ListPowerChangesById lists voting power changes of the council node
+	// from the latest. Returns ErrNotFound when the council node does not exist
+	ListPowerChangesById(id uint64, pagination *Pagination) ([]CouncilNodePowerChange, *PaginationResult, error)
+	// ListEvidencesById lists duplicate vote evidences against the council
+	// node from the latest. Returns ErrNotFound when the council node does
+	// not exist
+	ListEvidencesById(id uint64, pagination *Pagination) ([]BlockEvidence, *PaginationResult, error)
+	// ListValidatorsAtHeight lists council nodes in the validator set at the
+	// height with their voting power at the height, or at the latest stored
+	// height when height is nil. Returns ErrNotFound when the block at the
+	// height is not stored
+	ListValidatorsAtHeight(maybeHeight *uint64) (*ValidatorSet, error)
 
 	Stats() (*CouncilNodeStats, error)
 
@@ -30,6 +46,20 @@ type CouncilNodeListItem struct {
 	MaybeLastLeftAtBlockHeight *uint64                    `json:"last_left_at_block_height"`
 	SharePercentage            float64                    `json:"share_percentage"`
 	CumulativeSharePercentage  float64                    `json:"cumulative_share_percentage"`
+	// Uptime over the latest 1k blocks. Nil when the council node has no
+	// signing record in the window
+	MaybeUptimePercentage *float64 `json:"uptime_percentage"`
+}
+
+type CouncilNodeListOrder = string
+
+const (
+	COUNCIL_NODE_LIST_ORDER_BONDED CouncilNodeListOrder = "bonded"
+	COUNCIL_NODE_LIST_ORDER_UPTIME CouncilNodeListOrder = "uptime"
+)
+
+func IsValidCouncilNodeListOrder(order string) bool {
+	return order == COUNCIL_NODE_LIST_ORDER_BONDED || order == COUNCIL_NODE_LIST_ORDER_UPTIME
 }
 
 type CouncilNode struct {
@@ -44,6 +74,55 @@ type CouncilNode struct {
 	CreatedAtBlockHeight       uint64                     `json:"created_at_block_height"`
 	MaybeLastLeftAtBlockHeight *uint64                    `json:"last_left_at_block_height"`
 	IsActive                   bool                       `json:"is_active"`
+	MaybeLiveness              *CouncilNodeLiveness       `json:"liveness"`
+}
+
+// CouncilNodeLiveness is the signing record of a council node over its latest
+// blocks in the block signing window, which the chain jails a council node as
+// NonLive from when it has missed more than the threshold
+type CouncilNodeLiveness struct {
+	BlockSigningWindow   uint64  `json:"block_signing_window"`
+	MissedBlockThreshold uint64  `json:"missed_block_threshold"`
+	SignedBlockCount     uint64  `json:"signed_block_count"`
+	MissedBlockCount     uint64  `json:"missed_block_count"`
+	SigningRatio         float64 `json:"signing_ratio"`
+}
+
+type CouncilNodePerformanceWindow = string
+
+const (
+	COUNCIL_NODE_PERFORMANCE_WINDOW_1K_BLOCKS CouncilNodePerformanceWindow = "1k"
+	COUNCIL_NODE_PERFORMANCE_WINDOW_24H       CouncilNodePerformanceWindow = "24h"
+	COUNCIL_NODE_PERFORMANCE_WINDOW_7D        CouncilNodePerformanceWindow = "7d"
+	COUNCIL_NODE_PERFORMANCE_WINDOW_30D       CouncilNodePerformanceWindow = "30d"
+)
+
+func IsValidCouncilNodePerformanceWindow(window string) bool {
+	switch window {
+	case COUNCIL_NODE_PERFORMANCE_WINDOW_1K_BLOCKS,
+		COUNCIL_NODE_PERFORMANCE_WINDOW_24H,
+		COUNCIL_NODE_PERFORMANCE_WINDOW_7D,
+		COUNCIL_NODE_PERFORMANCE_WINDOW_30D:
+		return true
+	}
+	return false
+}
+
+// CouncilNodePerformance is the record of a council node over the blocks from
+// FromBlockHeight to ToBlockHeight. Both heights are 0 when no block is stored
+type CouncilNodePerformance struct {
+	Window             CouncilNodePerformanceWindow `json:"window"`
+	FromBlockHeight    uint64                       `json:"from_block_height"`
+	ToBlockHeight      uint64                       `json:"to_block_height"`
+	SignedBlockCount   uint64                       `json:"signed_block_count"`
+	ProposedBlockCount uint64                       `json:"proposed_block_count"`
+	MissedBlockCount   uint64                       `json:"missed_block_count"`
+	// Nil when the council node has no signing record in the window
+	MaybeUptimePercentage *float64        `json:"uptime_percentage"`
+	JailCount             uint64          `json:"jail_count"`
+	SlashCount            uint64          `json:"slash_count"`
+	SlashedBonded         *bignum.WBigInt `json:"slashed_bonded"`
+	SlashedUnbonded       *bignum.WBigInt `json:"slashed_unbonded"`
 }
 
 type ActivityFilter struct {
@@ -64,3 +143,26 @@ type CouncilNodeStats struct {
 	Count       uint64          `json:"count"`
 	TotalStaked *bignum.WBigInt `json:"total_staked"`
 }
+
+type CouncilNodePowerChange struct {
+	BlockHeight uint64    `json:"block_height"`
+	BlockTime   time.Time `json:"block_time"`
+	Power       uint64    `json:"power"`
+}
+
+type ValidatorSet struct {
+	BlockHeight uint64      `json:"block_height"`
+	Validators  []Validator `json:"validators"`
+}
+
+type Validator struct {
+	CouncilNodeId uint64 `json:"council_node_id"`
+	Name          string `json:"name"`
+	PubKeyType    string `json:"pubkey_type"`
+	PubKey        string `json:"pubkey"`
+	Address       string `json:"address"`
+	// Voting power is unknown until the first validator update of the council
+	// node
+	MaybePower                     *uint64 `json:"power"`
+	MaybePowerChangedAtBlockHeight *uint64 `json:"power_changed_at_block_height"`
+}
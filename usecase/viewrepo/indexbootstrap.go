@@ -0,0 +1,19 @@
+package viewrepo
+
+import "time"
+
+type IndexBootstrapViewRepo interface {
+	// Find returns adapter.ErrNotFound when the index is indexed from genesis
+	// rather than bootstrapped from a state snapshot
+	Find() (*IndexBootstrap, error)
+}
+
+// IndexBootstrap is the state snapshot the index is bootstrapped from. Blocks
+// and activities before its height are unavailable
+type IndexBootstrap struct {
+	Height         uint64    `json:"height"`
+	BlockHash      string    `json:"block_hash"`
+	ChainID        string    `json:"chain_id"`
+	Source         string    `json:"source"`
+	BootstrappedAt time.Time `json:"bootstrapped_at"`
+}
@@ -0,0 +1,23 @@
+package viewrepo
+
+import "time"
+
+const TRANSACTION_STATUS_PENDING = "pending"
+
+// PendingTransactionViewRepo serves transactions observed in the mempool which
+// are not yet indexed from a block
+type PendingTransactionViewRepo interface {
+	ListPendingTransactions(pagination *Pagination) ([]PendingTransaction, *PaginationResult, error)
+	FindPendingTransactionByTxId(txid string) (*PendingTransaction, error)
+}
+
+type PendingTransaction struct {
+	TxID                       string             `json:"txid"`
+	Type                       string             `json:"type"`
+	Status                     string             `json:"status"`
+	MaybeInputs                []TransactionInput `json:"inputs"`
+	MaybeOutputCount           *uint64            `json:"output_count"`
+	MaybeStakingAccountAddress *string            `json:"staking_account_address"`
+	FirstSeenAt                time.Time          `json:"first_seen_at"`
+	LastSeenAt                 time.Time          `json:"last_seen_at"`
+}
@@ -0,0 +1,22 @@
+package viewrepo
+
+import (
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+type QuarantinedBlockViewRepo interface {
+	ListQuarantinedBlocks(pagination *Pagination) ([]QuarantinedBlock, *PaginationResult, error)
+	FindQuarantinedBlock(height uint64) (*QuarantinedBlock, error)
+}
+
+type QuarantinedBlock struct {
+	Height          uint64              `json:"height"`
+	Stage           string              `json:"stage"`
+	Error           string              `json:"error"`
+	Payload         jsoniter.RawMessage `json:"payload"`
+	Attempts        uint64              `json:"attempts"`
+	QuarantinedAt   time.Time           `json:"quarantined_at"`
+	LastAttemptedAt time.Time           `json:"last_attempted_at"`
+}
@@ -38,8 +38,12 @@ type StakingAccountActivity struct {
 	BlockTime                  time.Time            `json:"block_time"`
 	BlockHash                  string               `json:"block_hash"`
 	MaybeTxID                  *string              `json:"txid"`
+	MaybeTxStatus              *string              `json:"status"`
+	MaybeTxLog                 *string              `json:"log"`
+	MaybeTxCodespace           *string              `json:"codespace"`
 	MaybeFee                   *bignum.WBigInt      `json:"fee"`
 	MaybeEventPosition         *uint64              `json:"event_position"`
+	MaybeEventPhase            *string              `json:"event_phase"`
 	MaybeInputs                []TransactionInput   `json:"inputs"`
 	MaybeOutputCount           *uint64              `json:"output_count"`
 	MaybeStakingAccountAddress *string              `json:"staking_account_address"`
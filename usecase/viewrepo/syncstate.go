@@ -0,0 +1,24 @@
+package viewrepo
+
+import "time"
+
+type SyncStateViewRepo interface {
+	// FindSyncState returns adapter.ErrNotFound when neither a block is stored
+	// nor an error is recorded yet
+	FindSyncState() (*SyncState, error)
+}
+
+// SyncState is the synchronization progress checkpointed together with the
+// stored blocks. It is available to any process with access to the database
+type SyncState struct {
+	LastStoredHeight             uint64  `json:"last_stored_height"`
+	LastObservedTendermintHeight uint64  `json:"last_observed_tendermint_height"`
+	MaybeChainID                 *string `json:"chain_id"`
+	// Parser version of the oldest stored blocks. 0 when they are stored
+	// before the parser version is recorded
+	ParserVersion    uint32     `json:"parser_version"`
+	MaybeLastError   *string    `json:"last_error"`
+	MaybeLastErrorAt *time.Time `json:"last_error_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
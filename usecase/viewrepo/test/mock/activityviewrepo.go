@@ -3,6 +3,7 @@ package usecasevewrepomock
 import (
 	"github.com/stretchr/testify/mock"
 
+	"github.com/crypto-com/chainindex"
 	"github.com/crypto-com/chainindex/usecase/viewrepo"
 )
 
@@ -36,9 +37,10 @@ func (repo *MockActivityViewRepo) ListEvents(
 
 func (repo *MockActivityViewRepo) FindEventByBlockHeightEventPosition(
 	blockHeight uint64,
+	eventPhase chainindex.EventPhase,
 	eventPosition uint64,
 ) (*viewrepo.Event, error) {
-	args := repo.Called(blockHeight, eventPosition)
+	args := repo.Called(blockHeight, eventPhase, eventPosition)
 
 	return args.Get(0).(*viewrepo.Event), args.Error(1)
 }
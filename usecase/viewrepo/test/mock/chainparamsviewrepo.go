@@ -0,0 +1,16 @@
+package usecasevewrepomock
+
+import (
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockChainParamsViewRepo struct {
+	mock.Mock
+}
+
+func (repo *MockChainParamsViewRepo) Find() (*viewrepo.ChainParams, error) {
+	args := repo.Called()
+
+	return args.Get(0).(*viewrepo.ChainParams), args.Error(1)
+}
@@ -10,9 +10,10 @@ type MockCouncilNodeViewRepo struct {
 }
 
 func (repo *MockCouncilNodeViewRepo) ListActive(
+	order viewrepo.CouncilNodeListOrder,
 	pagination *viewrepo.Pagination,
 ) ([]viewrepo.CouncilNodeListItem, *viewrepo.PaginationResult, error) {
-	args := repo.Called(pagination)
+	args := repo.Called(order, pagination)
 
 	return args.Get(0).([]viewrepo.CouncilNodeListItem), args.Get(1).(*viewrepo.PaginationResult), args.Error(2)
 }
@@ -23,6 +24,15 @@ func (repo *MockCouncilNodeViewRepo) FindById(id uint64) (*viewrepo.CouncilNode,
 	return args.Get(0).(*viewrepo.CouncilNode), args.Error(1)
 }
 
+func (repo *MockCouncilNodeViewRepo) FindPerformanceById(
+	id uint64,
+	window viewrepo.CouncilNodePerformanceWindow,
+) (*viewrepo.CouncilNodePerformance, error) {
+	args := repo.Called(id, window)
+
+	return args.Get(0).(*viewrepo.CouncilNodePerformance), args.Error(1)
+}
+
 func (repo *MockCouncilNodeViewRepo) ListActivitiesById(
 	id uint64,
 	filter viewrepo.ActivityFilter,
@@ -33,6 +43,30 @@ func (repo *MockCouncilNodeViewRepo) ListActivitiesById(
 	return args.Get(0).([]viewrepo.StakingAccountActivity), args.Get(1).(*viewrepo.PaginationResult), args.Error(2)
 }
 
+func (repo *MockCouncilNodeViewRepo) ListPowerChangesById(
+	id uint64,
+	pagination *viewrepo.Pagination,
+) ([]viewrepo.CouncilNodePowerChange, *viewrepo.PaginationResult, error) {
+	args := repo.Called(id, pagination)
+
+	return args.Get(0).([]viewrepo.CouncilNodePowerChange), args.Get(1).(*viewrepo.PaginationResult), args.Error(2)
+}
+
+func (repo *MockCouncilNodeViewRepo) ListEvidencesById(
+	id uint64,
+	pagination *viewrepo.Pagination,
+) ([]viewrepo.BlockEvidence, *viewrepo.PaginationResult, error) {
+	args := repo.Called(id, pagination)
+
+	return args.Get(0).([]viewrepo.BlockEvidence), args.Get(1).(*viewrepo.PaginationResult), args.Error(2)
+}
+
+func (repo *MockCouncilNodeViewRepo) ListValidatorsAtHeight(maybeHeight *uint64) (*viewrepo.ValidatorSet, error) {
+	args := repo.Called(maybeHeight)
+
+	return args.Get(0).(*viewrepo.ValidatorSet), args.Error(1)
+}
+
 func (repo *MockCouncilNodeViewRepo) Stats() (*viewrepo.CouncilNodeStats, error) {
 	args := repo.Called()
 
@@ -0,0 +1,16 @@
+package usecasevewrepomock
+
+import (
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockIndexBootstrapViewRepo struct {
+	mock.Mock
+}
+
+func (repo *MockIndexBootstrapViewRepo) Find() (*viewrepo.IndexBootstrap, error) {
+	args := repo.Called()
+
+	return args.Get(0).(*viewrepo.IndexBootstrap), args.Error(1)
+}
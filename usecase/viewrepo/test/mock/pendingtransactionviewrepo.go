@@ -0,0 +1,24 @@
+package usecasevewrepomock
+
+import (
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPendingTransactionViewRepo struct {
+	mock.Mock
+}
+
+func (repo *MockPendingTransactionViewRepo) ListPendingTransactions(
+	pagination *viewrepo.Pagination,
+) ([]viewrepo.PendingTransaction, *viewrepo.PaginationResult, error) {
+	args := repo.Called(pagination)
+
+	return args.Get(0).([]viewrepo.PendingTransaction), args.Get(1).(*viewrepo.PaginationResult), args.Error(2)
+}
+
+func (repo *MockPendingTransactionViewRepo) FindPendingTransactionByTxId(txid string) (*viewrepo.PendingTransaction, error) {
+	args := repo.Called(txid)
+
+	return args.Get(0).(*viewrepo.PendingTransaction), args.Error(1)
+}
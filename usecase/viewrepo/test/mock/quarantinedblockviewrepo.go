@@ -0,0 +1,24 @@
+package usecasevewrepomock
+
+import (
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockQuarantinedBlockViewRepo struct {
+	mock.Mock
+}
+
+func (repo *MockQuarantinedBlockViewRepo) ListQuarantinedBlocks(
+	pagination *viewrepo.Pagination,
+) ([]viewrepo.QuarantinedBlock, *viewrepo.PaginationResult, error) {
+	args := repo.Called(pagination)
+
+	return args.Get(0).([]viewrepo.QuarantinedBlock), args.Get(1).(*viewrepo.PaginationResult), args.Error(2)
+}
+
+func (repo *MockQuarantinedBlockViewRepo) FindQuarantinedBlock(height uint64) (*viewrepo.QuarantinedBlock, error) {
+	args := repo.Called(height)
+
+	return args.Get(0).(*viewrepo.QuarantinedBlock), args.Error(1)
+}
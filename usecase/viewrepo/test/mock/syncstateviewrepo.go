@@ -0,0 +1,16 @@
+package usecasevewrepomock
+
+import (
+	"github.com/crypto-com/chainindex/usecase/viewrepo"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSyncStateViewRepo struct {
+	mock.Mock
+}
+
+func (repo *MockSyncStateViewRepo) FindSyncState() (*viewrepo.SyncState, error) {
+	args := repo.Called()
+
+	return args.Get(0).(*viewrepo.SyncState), args.Error(1)
+}